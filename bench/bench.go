@@ -0,0 +1,161 @@
+// Package bench defines the operations every ORM under benchmark implements.
+package bench
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnsupported is returned by an Adapter operation that the ORM has no
+// way of performing. The runner reports these rather than omitting them.
+var ErrUnsupported = errors.New("unsupported")
+
+// Operations are the benchmarked operations, each performs one query or
+// write using a single ORM.
+//
+// ORMs that have always been benchmarked without a context are free to
+// ignore ctx so that their numbers stay comparable with older runs.
+type Operations interface {
+	SelectAll(ctx context.Context) error
+	SelectSubset(ctx context.Context) error
+	SelectComplex(ctx context.Context) error
+	Insert(ctx context.Context) error
+	Update(ctx context.Context) error
+	Delete(ctx context.Context) error
+	RawBind(ctx context.Context) error
+	EagerLoad(ctx context.Context) error
+}
+
+// Adapter performs each benchmarked operation once against the jets table
+// using a single ORM. Implementations live alongside the ORM's models.
+type Adapter interface {
+	// Name is the short name of the ORM, used as the sub-benchmark name.
+	Name() string
+	// Open connects to the mimic database behind dsn. It may be called
+	// more than once, each call replacing the previous connection.
+	Open(dsn string) error
+
+	Operations
+
+	// Results returns the jets read by the last select operation,
+	// normalized so that results can be verified against the fixture.
+	// Writes are verified against the statements they run instead.
+	Results() []Jet
+}
+
+// ParallelAdapter is implemented by adapters that can run operations from
+// several goroutines at once
+type ParallelAdapter interface {
+	Adapter
+
+	// Clone returns an adapter for another goroutine. It shares the
+	// connection, and with it any caches the ORM keeps, but has results
+	// of its own.
+	Clone() Adapter
+}
+
+// ColdAdapter is implemented by adapters whose ORM can be made as cold in
+// process as in a new one, so that the first operation after Open pays
+// for everything the ORM caches about the models
+type ColdAdapter interface {
+	Adapter
+
+	// Reset clears the caches the ORM keeps outside of the handle Open
+	// creates, those on the handle go with it.
+	Reset()
+
+	// Close the handle Open created
+	Close() error
+}
+
+// Builder is implemented by adapters whose ORM can build the SQL and args
+// of an operation without running it, so that building can be timed apart
+// from the round trip and scanning. Operations the ORM only builds as it
+// runs them return ErrUnsupported.
+type Builder interface {
+	BuildSelectComplex(context.Context) (string, []interface{}, error)
+	BuildInsert(context.Context) (string, []interface{}, error)
+	BuildUpdate(context.Context) (string, []interface{}, error)
+}
+
+// Binder is implemented by adapters whose ORM can map the rows of a query
+// it didn't build into its models. The query is run with database/sql, or
+// the thinnest wrapper of it the ORM has, so that mapping the rows is what
+// differs between them.
+type Binder interface {
+	// Bind the rows of select * from jets into jets, the results of the
+	// adapter
+	Bind(context.Context) error
+}
+
+// ColumnsKind is how a Columns list picks the columns written, like the
+// kinds of sqlboiler's boil.Columns
+type ColumnsKind int
+
+// The kinds of Columns lists
+const (
+	// Infer the columns as the ORM does by default
+	Infer ColumnsKind = iota
+	// Whitelist writes exactly the listed columns
+	Whitelist
+	// Blacklist writes the inferred columns except the listed ones
+	Blacklist
+	// Greylist writes the inferred columns and the listed ones
+	Greylist
+)
+
+func (k ColumnsKind) String() string {
+	switch k {
+	case Whitelist:
+		return "Whitelist"
+	case Blacklist:
+		return "Blacklist"
+	case Greylist:
+		return "Greylist"
+	}
+	return "Infer"
+}
+
+// Columns are the columns of the jets table an insert or update writes
+type Columns struct {
+	Kind ColumnsKind
+	Cols []string
+}
+
+// ColumnsAdapter is implemented by adapters whose ORM can choose the
+// columns an insert or update writes. Kinds the ORM has no equivalent of
+// return ErrUnsupported.
+type ColumnsAdapter interface {
+	InsertColumns(ctx context.Context, cols Columns) error
+	UpdateColumns(ctx context.Context, cols Columns) error
+}
+
+// UnitAdapter is implemented by adapters whose ORM can run statements in a
+// transaction, for the unit of work a request handler does
+type UnitAdapter interface {
+	// Unit begins a transaction, finds the jet with id 1, updates it,
+	// inserts a license for its pilot and commits. The jet found is the
+	// result of the adapter.
+	Unit(context.Context) error
+}
+
+// RelationAdapter is implemented by adapters that can write the
+// relationships of a pilot, with the ORM's relationship methods where it
+// has them. Relate sets up the pilot with id 1 and n languages, jets and
+// licenses with ids 1 to n that every write works on. The rows a write
+// relates to the pilot are verified against the statements it issues.
+// Writes the ORM can't perform return ErrUnsupported.
+type RelationAdapter interface {
+	Relate(n int)
+
+	// AttachLanguages adds the languages to the pilot, ReplaceLanguages
+	// sets them as its only ones and DetachLanguages removes them
+	AttachLanguages(context.Context) error
+	ReplaceLanguages(context.Context) error
+	DetachLanguages(context.Context) error
+
+	// AttachJets sets the pilot of the jets and ReplaceLicenses sets the
+	// licenses as the only ones of the pilot
+	AttachJets(context.Context) error
+	ReplaceLicenses(context.Context) error
+}
@@ -0,0 +1,122 @@
+package bench
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// FlightAdapter is implemented by adapters that can also run the benchmarked
+// operations against the flights table
+type FlightAdapter interface {
+	Adapter
+
+	// Flights returns the operations against the flights table, sharing
+	// the adapter's connection
+	Flights() Flights
+}
+
+// Flights performs each benchmarked operation once against the flights
+// table. Its columns are of the types ORMs work hardest to decode and
+// encode: timestamptz, numeric, uuid, inet, an enum, text and integer
+// arrays and jsonb. Writes use a flight set up from FlightRow.
+type Flights interface {
+	SelectAll(ctx context.Context) error
+	Insert(ctx context.Context) error
+	Update(ctx context.Context) error
+
+	// Results returns the flights read by the last select or the flight
+	// written by the last insert or update.
+	Results() []Flight
+}
+
+// Flight is the canonical form of a flights row. Numeric, uuid, inet, enum
+// and json columns are held as the text Postgres sends for them, so that
+// ORMs decoding them into decimals, UUIDs or raw JSON can be compared.
+type Flight struct {
+	ID         int64
+	JetID      int64
+	Number     string
+	Status     string
+	DepartsAt  time.Time
+	ArrivesAt  null.Time
+	Fare       string
+	BookingRef string
+	OperatorIP string
+	Crew       []string
+	Seats      []int64
+	Metadata   string
+	Notes      null.String
+}
+
+// FlightRow is the flight that adapters insert and update
+var FlightRow = Flight{
+	ID:         1,
+	JetID:      1,
+	Number:     "BB101",
+	Status:     "scheduled",
+	DepartsAt:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	ArrivesAt:  null.TimeFrom(time.Date(2020, 1, 2, 7, 34, 5, 0, time.UTC)),
+	Fare:       "149.99",
+	BookingRef: "8f14e45f-ceea-467a-9af4-0a9c4e1b8b21",
+	OperatorIP: "10.0.0.1",
+	Crew:       []string{"Amelia", "Bessie", "Jean"},
+	Seats:      []int64{12, 14, 21},
+	Metadata:   `{"gate": "A1", "meal": true, "legs": [1, 2]}`,
+	Notes:      null.StringFrom(`{"late": false}`),
+}
+
+// Diff compares the named columns of two flights and describes every column
+// that differs. All columns are compared when columns is empty.
+func (f Flight) Diff(other Flight, columns ...string) []string {
+	var diffs []string
+	diff := func(column string, equal bool, a, b interface{}) {
+		if !equal && (len(columns) == 0 || indexOf(columns, column) >= 0) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", column, a, b))
+		}
+	}
+
+	diff("id", f.ID == other.ID, f.ID, other.ID)
+	diff("jet_id", f.JetID == other.JetID, f.JetID, other.JetID)
+	diff("number", f.Number == other.Number, f.Number, other.Number)
+	diff("status", f.Status == other.Status, f.Status, other.Status)
+	diff("departs_at", f.DepartsAt.Equal(other.DepartsAt), f.DepartsAt, other.DepartsAt)
+	diff("arrives_at", f.ArrivesAt.Valid == other.ArrivesAt.Valid && f.ArrivesAt.Time.Equal(other.ArrivesAt.Time),
+		f.ArrivesAt, other.ArrivesAt)
+	diff("fare", f.Fare == other.Fare, f.Fare, other.Fare)
+	diff("booking_ref", f.BookingRef == other.BookingRef, f.BookingRef, other.BookingRef)
+	diff("operator_ip", f.OperatorIP == other.OperatorIP, f.OperatorIP, other.OperatorIP)
+	diff("crew", reflect.DeepEqual(f.Crew, other.Crew), f.Crew, other.Crew)
+	diff("seats", reflect.DeepEqual(f.Seats, other.Seats), f.Seats, other.Seats)
+	diff("metadata", f.Metadata == other.Metadata, f.Metadata, other.Metadata)
+	diff("notes", f.Notes == other.Notes, f.Notes, other.Notes)
+
+	return diffs
+}
+
+// String is used in failure messages
+func (f Flight) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "{ID:%d JetID:%d Number:%q Status:%q DepartsAt:%s ArrivesAt:%s Fare:%s BookingRef:%s OperatorIP:%s",
+		f.ID, f.JetID, f.Number, f.Status, f.DepartsAt.Format(time.RFC3339), nullString(f.ArrivesAt.Valid, f.ArrivesAt.Time.Format(time.RFC3339)),
+		f.Fare, f.BookingRef, f.OperatorIP)
+	fmt.Fprintf(&sb, " Crew:%q Seats:%v Metadata:%s Notes:%s}", f.Crew, f.Seats, f.Metadata, nullString(f.Notes.Valid, f.Notes.String))
+	return sb.String()
+}
+
+func nullString(valid bool, s string) string {
+	if !valid {
+		return "null"
+	}
+	return s
+}
+
+// FlightColumns are the columns of the flights table in order
+var FlightColumns = []string{
+	"id", "jet_id", "number", "status", "departs_at", "arrives_at", "fare",
+	"booking_ref", "operator_ip", "crew", "seats", "metadata", "notes",
+}
@@ -0,0 +1,106 @@
+package bench
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Jet is the canonical form of a jets row that every adapter normalizes
+// its results to so that they can be compared across ORMs.
+type Jet struct {
+	ID         int64
+	PilotID    int64
+	AirportID  int64
+	Name       string
+	Color      null.String
+	UUID       string
+	Identifier string
+	Cargo      []byte
+	Manifest   []byte
+
+	// Pilot is only set by operations that load the relationship
+	Pilot *Pilot
+}
+
+// Pilot is the canonical form of a pilots row
+type Pilot struct {
+	ID   int64
+	Name string
+}
+
+// Diff compares the named columns of two jets and describes every column
+// that differs. All columns are compared when columns is empty.
+func (j Jet) Diff(other Jet, columns ...string) []string {
+	if len(columns) == 0 {
+		columns = JetColumns
+	}
+
+	var diffs []string
+	for _, c := range columns {
+		var a, b interface{}
+		switch c {
+		case "id":
+			a, b = j.ID, other.ID
+		case "pilot_id":
+			a, b = j.PilotID, other.PilotID
+		case "airport_id":
+			a, b = j.AirportID, other.AirportID
+		case "name":
+			a, b = j.Name, other.Name
+		case "color":
+			a, b = j.Color, other.Color
+		case "uuid":
+			a, b = j.UUID, other.UUID
+		case "identifier":
+			a, b = j.Identifier, other.Identifier
+		case "cargo":
+			if !bytes.Equal(j.Cargo, other.Cargo) {
+				diffs = append(diffs, fmt.Sprintf("cargo: %q != %q", j.Cargo, other.Cargo))
+			}
+			continue
+		case "manifest":
+			if !bytes.Equal(j.Manifest, other.Manifest) {
+				diffs = append(diffs, fmt.Sprintf("manifest: %q != %q", j.Manifest, other.Manifest))
+			}
+			continue
+		default:
+			panic("unknown jets column: " + c)
+		}
+
+		if a != b {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", c, a, b))
+		}
+	}
+
+	switch {
+	case j.Pilot == nil && other.Pilot == nil:
+	case j.Pilot == nil || other.Pilot == nil:
+		diffs = append(diffs, fmt.Sprintf("pilot: %v != %v", j.Pilot, other.Pilot))
+	case *j.Pilot != *other.Pilot:
+		diffs = append(diffs, fmt.Sprintf("pilot: %+v != %+v", *j.Pilot, *other.Pilot))
+	}
+
+	return diffs
+}
+
+// String is used in failure messages
+func (j Jet) String() string {
+	var sb strings.Builder
+	color := "null"
+	if j.Color.Valid {
+		color = fmt.Sprintf("%q", j.Color.String)
+	}
+	fmt.Fprintf(&sb, "{ID:%d PilotID:%d AirportID:%d Name:%q Color:%s UUID:%q Identifier:%q Cargo:%q Manifest:%q",
+		j.ID, j.PilotID, j.AirportID, j.Name, color, j.UUID, j.Identifier, j.Cargo, j.Manifest)
+	if j.Pilot != nil {
+		fmt.Fprintf(&sb, " Pilot:%+v", *j.Pilot)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// JetColumns are the columns of the jets table in order
+var JetColumns = []string{"id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"}
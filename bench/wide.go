@@ -0,0 +1,276 @@
+package bench
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WideWidths are the numbers of columns of the wide tables in schema.sql,
+// wide10 through wide100
+var WideWidths = []int{10, 25, 50, 100}
+
+// WideAdapter is implemented by adapters that can also run the benchmarked
+// operations against the wide tables
+type WideAdapter interface {
+	Adapter
+
+	// Wide returns the operations against the wide table with the given
+	// number of columns, sharing the adapter's connection
+	Wide(columns int) Wide
+}
+
+// Wide performs each benchmarked operation once against one of the wide
+// tables. Every table has an id primary key, a pilot_id foreign key and
+// columns of mixed types after them, see WideColumns.
+//
+// The operations are those of the jets table: SelectSubset and
+// SelectComplex read WideSubset, the complex query filters on id and
+// text_003 instead of name, and writes use a row set up by FillRow.
+type Wide interface {
+	Operations
+
+	// Results returns the rows read by the last select operation or the
+	// row written by the last insert, update or delete.
+	Results() []Row
+}
+
+// wideTypes are the types the columns after id and pilot_id cycle through,
+// each column is named after its type and position, text_003
+var wideTypes = []string{
+	"text", "integer", "bigint", "boolean", "float", "timestamp", "bytes",
+	"null_text", "null_integer", "null_timestamp",
+}
+
+// WideTable is the name of the wide table with the given number of columns
+func WideTable(columns int) string {
+	return fmt.Sprintf("wide%d", columns)
+}
+
+// WideColumns are the columns of a wide table in order
+func WideColumns(columns int) []string {
+	names := []string{"id", "pilot_id"}
+	for i := 3; i <= columns; i++ {
+		names = append(names, fmt.Sprintf("%s_%03d", wideTypes[(i-3)%len(wideTypes)], i))
+	}
+	return names
+}
+
+// WideSubset are the columns read by SelectSubset and SelectComplex, the
+// first half of the table
+func WideSubset(columns int) []string {
+	return WideColumns(columns)[:columns/2]
+}
+
+// WideTime is the time the timestamps in the wide fixtures are offset from
+var WideTime = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// WideValue is the value of a column of WideColumns in the nth row of the
+// wide fixtures. Every column has values of its own, derived from its
+// position, so that an adapter decoding a column into the field of another
+// of the same type fails to verify. id and pilot_id are n, and nullable
+// columns are NULL in odd rows.
+func WideValue(column string, n int64) interface{} {
+	if column == "id" || column == "pilot_id" {
+		return n
+	}
+
+	i := strings.LastIndexByte(column, '_')
+	pos, err := strconv.ParseInt(column[i+1:], 10, 64)
+	if err != nil {
+		panic("no position in wide column " + column)
+	}
+
+	typ := column[:i]
+	if strings.HasPrefix(typ, "null_") {
+		if n%2 == 1 {
+			return nil
+		}
+		typ = typ[len("null_"):]
+	}
+
+	switch typ {
+	case "text":
+		return fmt.Sprintf("%s-%d", column, n)
+	case "bytes":
+		return []byte(fmt.Sprintf("%s-%d", column, n))
+	case "integer", "bigint":
+		return pos*10 + n
+	case "boolean":
+		return (pos/10+n)%2 == 0
+	case "float":
+		return float64(pos) + float64(n)/4
+	case "timestamp":
+		return WideTime.Add(time.Duration(pos)*time.Hour + time.Duration(n)*time.Minute)
+	}
+	panic("no value for wide column " + column)
+}
+
+// Row is the canonical form of a row of a wide table, Values are in column
+// order and hold int64, float64, bool, string, []byte, time.Time or nil
+type Row struct {
+	Values []interface{}
+
+	// Pilot is only set by operations that load the relationship
+	Pilot *Pilot
+}
+
+// Diff compares the named columns of two rows and describes every column
+// that differs. All columns are compared when columns is empty.
+func (r Row) Diff(other Row, columns ...string) []string {
+	names := WideColumns(len(r.Values))
+	if len(r.Values) != len(other.Values) {
+		return []string{fmt.Sprintf("%d columns != %d columns", len(r.Values), len(other.Values))}
+	}
+	if len(columns) == 0 {
+		columns = names
+	}
+
+	var diffs []string
+	for _, c := range columns {
+		i := indexOf(names, c)
+		if i < 0 {
+			panic("unknown wide column: " + c)
+		}
+
+		a, b := r.Values[i], other.Values[i]
+		if !equalValues(a, b) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", c, a, b))
+		}
+	}
+
+	switch {
+	case r.Pilot == nil && other.Pilot == nil:
+	case r.Pilot == nil || other.Pilot == nil:
+		diffs = append(diffs, fmt.Sprintf("pilot: %v != %v", r.Pilot, other.Pilot))
+	case *r.Pilot != *other.Pilot:
+		diffs = append(diffs, fmt.Sprintf("pilot: %+v != %+v", *r.Pilot, *other.Pilot))
+	}
+
+	return diffs
+}
+
+// String is used in failure messages
+func (r Row) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, v := range r.Values {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		switch v := v.(type) {
+		case []byte:
+			fmt.Fprintf(&sb, "%q", v)
+		case time.Time:
+			sb.WriteString(v.Format(time.RFC3339))
+		default:
+			fmt.Fprintf(&sb, "%#v", v)
+		}
+	}
+	if r.Pilot != nil {
+		fmt.Fprintf(&sb, " Pilot:%+v", *r.Pilot)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func equalValues(a, b interface{}) bool {
+	switch a := a.(type) {
+	case []byte:
+		b, ok := b.([]byte)
+		return ok && bytes.Equal(a, b)
+	case time.Time:
+		b, ok := b.(time.Time)
+		return ok && a.Equal(b)
+	}
+	return a == b
+}
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// RowOf converts the first columns fields of a model, a struct or a pointer
+// to one, to a Row. Nullable fields are pointers or types implementing
+// driver.Valuer, like the null packages' types.
+func RowOf(model interface{}, columns int) Row {
+	v := reflect.Indirect(reflect.ValueOf(model))
+
+	row := Row{Values: make([]interface{}, columns)}
+	for i := range row.Values {
+		row.Values[i] = canonicalValue(v.Field(i))
+	}
+	return row
+}
+
+func canonicalValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() != timeType && v.Type().Implements(valuerType) {
+		value, err := v.Interface().(driver.Valuer).Value()
+		if err != nil {
+			panic(err)
+		}
+		if value == nil {
+			return nil
+		}
+		v = reflect.ValueOf(value)
+	}
+
+	switch {
+	case v.Type() == timeType:
+		return v.Interface().(time.Time).UTC()
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return v.Bytes()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	}
+	panic(fmt.Sprintf("no canonical form of %s", v.Type()))
+}
+
+// FillRow sets the first columns fields of the struct model points to to
+// the values of the first row of the wide fixtures, see WideValue. Nullable
+// fields are left NULL.
+func FillRow(model interface{}, columns int) {
+	v := reflect.ValueOf(model).Elem()
+
+	for i, column := range WideColumns(columns) {
+		value := WideValue(column, 1)
+		if value == nil {
+			continue
+		}
+
+		f := v.Field(i)
+		f.Set(reflect.ValueOf(value).Convert(f.Type()))
+	}
+}
+
+func indexOf(list []string, s string) int {
+	for i, l := range list {
+		if l == s {
+			return i
+		}
+	}
+	return -1
+}
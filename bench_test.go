@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/bobs"
+	"github.com/aarondl/boilbench/gojets"
+	"github.com/aarondl/boilbench/goqus"
+	"github.com/aarondl/boilbench/gorms"
+	"github.com/aarondl/boilbench/gorps"
+	"github.com/aarondl/boilbench/mimic"
+	"github.com/aarondl/boilbench/models"
+	"github.com/aarondl/boilbench/pops"
+	"github.com/aarondl/boilbench/xorms"
+)
+
+// adapters returns a fresh adapter for every ORM under benchmark, in the
+// order they are reported.
+func adapters() []bench.Adapter {
+	return []bench.Adapter{
+		&gorms.Adapter{},
+		&gorps.Adapter{},
+		&xorms.Adapter{},
+		&models.Adapter{},
+		&pops.Adapter{},
+		&gojets.Adapter{},
+		&goqus.Adapter{},
+		&bobs.Adapter{},
+	}
+}
+
+// result is what operations read, compared with what their fixture holds.
+// Only the named columns are compared when columns isn't empty.
+type result[R any] interface {
+	Diff(other R, columns ...string) []string
+}
+
+// subject is what an operation runs against, an adapter or one of its
+// tables, whose results are those of the last run
+type subject[R any] interface {
+	Results() []R
+}
+
+// operation is a single benchmark family run against every adapter, on
+// what open returns of each
+type operation[S subject[R], R result[R]] struct {
+	name string
+	run  func(S, context.Context) error
+
+	// open returns what run runs against of an adapter opened to the
+	// fixture, or bench.ErrUnsupported. It's the adapter itself when nil.
+	open func(bench.Adapter) (S, error)
+
+	// fixture is the mimic script the operation's statements are answered
+	// with, override replaces it for adapters (by name) whose statements
+	// expect differently shaped results.
+	fixture  func() []mimic.QueryResult
+	override map[string]func() []mimic.QueryResult
+
+	// expect is what every adapter's results must decode to, only columns
+	// are compared when it's set.
+	expect  func() []R
+	columns []string
+
+	// sends are the columns a write must send and omits those it must
+	// not. Writes are verified against the statements in the mimic log,
+	// every write to jets must send the values of the jet of expect, so
+	// only jet operations have them.
+	sends, omits []string
+
+	// check, when set, checks the statements of a run after its results,
+	// for operations that both read and write
+	check func(log []mimic.Statement) error
+
+	// match are what the statements answered by each result of a fixture
+	// of more than one contain, so that the results still answer the right
+	// statements when operations run in parallel
+	match []string
+}
+
+// jetOperation is an operation on the jets of an adapter
+type jetOperation = operation[bench.Adapter, bench.Jet]
+
+func (o operation[S, R]) script(name string) []mimic.QueryResult {
+	fixture := o.fixture
+	if f, ok := o.override[name]; ok {
+		fixture = f
+	}
+
+	script := fixture()
+	for i := range script {
+		script[i].NumInput = -1
+		if i < len(o.match) {
+			script[i].Match = o.match[i]
+		}
+	}
+	return script
+}
+
+// fixtures wraps mimic results into a fixture function
+func fixtures(results ...func() mimic.QueryResult) func() []mimic.QueryResult {
+	return func() []mimic.QueryResult {
+		script := make([]mimic.QueryResult, len(results))
+		for i, r := range results {
+			script[i] = r()
+		}
+		return script
+	}
+}
+
+// connect opens a to the fixture of o under dsn and returns what o runs
+// against, bench.ErrUnsupported when a doesn't have it
+func (o operation[S, R]) connect(a bench.Adapter, dsn string) (S, error) {
+	mimic.NewSequenceDSN(dsn, o.script(a.Name())...)
+
+	var s S
+	if err := a.Open(dsn); err != nil {
+		return s, err
+	}
+	if o.open == nil {
+		return any(a).(S), nil
+	}
+	return o.open(a)
+}
+
+// writes is whether o is a write, verified against the statements it ran
+func (o operation[S, R]) writes() bool {
+	return len(o.sends) != 0
+}
+
+// verify that the results of s, of the adapter named name, from the last
+// run of op match what the fixture holds, or for a write that the
+// statements in log sent the jet expected
+func (o operation[S, R]) verify(name string, s S, log []mimic.Statement) error {
+	want := o.expect()
+	if o.writes() {
+		jet, _ := any(want[0]).(bench.Jet)
+		if err := verifyWrites(log, jet, o.sends, o.omits); err != nil {
+			return fmt.Errorf("%s/%s: %w", o.name, name, err)
+		}
+		return nil
+	}
+
+	got := s.Results()
+	if len(want) != len(got) {
+		return fmt.Errorf("%s/%s: want %d results, got %d", o.name, name, len(want), len(got))
+	}
+
+	for i := range want {
+		if diffs := want[i].Diff(got[i], o.columns...); len(diffs) != 0 {
+			return fmt.Errorf("%s/%s: result %d differs: %s\nwant: %v\ngot:  %v",
+				o.name, name, i, strings.Join(diffs, ", "), want[i], got[i])
+		}
+	}
+
+	if o.check != nil {
+		if err := o.check(log); err != nil {
+			return fmt.Errorf("%s/%s: %w", o.name, name, err)
+		}
+	}
+	return nil
+}
+
+// runLogged runs fn and returns the statements it ran against dsn
+func runLogged(dsn string, fn func() error) ([]mimic.Statement, error) {
+	mimic.StartLog(dsn)
+	err := fn()
+	return mimic.StopLog(dsn), err
+}
+
+// runChecked opens a to dsn answered by script and runs op against it once
+// with its statements logged, skipping t when a doesn't support op. The
+// statements are verified, compared with the golden file of a in
+// testdata/sql/<golden> unless golden is empty, and returned.
+func runChecked(t *testing.T, op jetOperation, a bench.Adapter, dsn string, script []mimic.QueryResult, golden string) []mimic.Statement {
+	t.Helper()
+	mimic.NewSequenceDSN(dsn, script...)
+	if err := a.Open(dsn); err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := runLogged(dsn, func() error { return op.run(a, context.Background()) })
+	if errors.Is(err, bench.ErrUnsupported) {
+		t.Skip("unsupported")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	if err := op.verify(a.Name(), a, log); err != nil {
+		t.Error(err)
+	}
+
+	if golden != "" {
+		checkGolden(t, golden, a.Name(), formatStatements(log))
+	}
+	return log
+}
+
+// skip skips b because of reason. Skips are only reported with -v, so it's
+// printed as well so that missing capabilities show up in every run.
+func skip(b *testing.B, reason string) {
+	fmt.Printf("--- SKIP: %s\n    %s\n", b.Name(), reason)
+	b.SkipNow()
+}
+
+// skipUnsupported skips b because its adapter returned bench.ErrUnsupported
+func skipUnsupported(b *testing.B) {
+	skip(b, "unsupported")
+}
+
+// runOperation creates an op/orm sub-benchmark for every adapter, adapters
+// that return bench.ErrUnsupported are skipped with that reason.
+func runOperation[S subject[R], R result[R]](b *testing.B, op operation[S, R]) {
+	for _, a := range adapters() {
+		dsn := "postgres://" + op.name + "-" + a.Name()
+		s, err := op.connect(a, dsn)
+		if err != nil && !errors.Is(err, bench.ErrUnsupported) {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			if err != nil {
+				skipUnsupported(b)
+			}
+			ctx := context.Background()
+			checkOperation(b, op, a.Name(), s, dsn)
+
+			b.ResetTimer()
+			loop(b, func() error { return op.run(s, ctx) })
+		})
+	}
+}
+
+// checkOperation runs op once on s, of the adapter named name, before it's
+// timed, skipping the benchmark when the adapter doesn't support it and
+// failing it when the results, or the statements run against dsn, aren't
+// what the fixture holds
+func checkOperation[S subject[R], R result[R]](b *testing.B, op operation[S, R], name string, s S, dsn string) {
+	log, err := runLogged(dsn, func() error { return op.run(s, context.Background()) })
+	if errors.Is(err, bench.ErrUnsupported) {
+		skipUnsupported(b)
+	} else if err != nil {
+		b.Fatal(err)
+	}
+	if err := op.verify(name, s, log); err != nil {
+		b.Fatal(err)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// bindExtra are columns of the Extra fixture that no model has, like those
+// of a join or of a column added before the models were regenerated. Their
+// values are none of a jet's, so that one mapped onto a field shows.
+var bindExtra = []struct {
+	col string
+	val driver.Value
+}{
+	{"pilot_name", "pilot-name-extra"},
+	{"hangar_id", int64(1000)},
+	{"notes", nil},
+	{"rank", []byte("rank-extra")},
+}
+
+// bindQuery returns the jets of jetQuery with their columns in the order
+// of order, indexes into the columns of jetQuery, followed by extra
+// columns no model has
+func bindQuery(order []int, extra bool) mimic.QueryResult {
+	query := jetQuery()
+
+	cols := make([]string, 0, len(order)+len(bindExtra))
+	for _, i := range order {
+		cols = append(cols, query.Cols[i])
+	}
+	if extra {
+		for _, e := range bindExtra {
+			cols = append(cols, e.col)
+		}
+	}
+
+	vals := make([][]driver.Value, len(query.Vals))
+	for r, row := range query.Vals {
+		for _, i := range order {
+			vals[r] = append(vals[r], row[i])
+		}
+		if extra {
+			for _, e := range bindExtra {
+				vals[r] = append(vals[r], e.val)
+			}
+		}
+	}
+
+	query.Query = &mimic.Query{Cols: cols, Vals: vals}
+	return query
+}
+
+// bindOp maps the rows of a bindQuery with the ORM alone
+func bindOp(name string, order []int, extra bool) jetOperation {
+	query := func() mimic.QueryResult { return bindQuery(order, extra) }
+	return jetOperation{
+		name: name,
+		run: func(a bench.Adapter, ctx context.Context) error {
+			b, ok := a.(bench.Binder)
+			if !ok {
+				return bench.ErrUnsupported
+			}
+			return b.Bind(ctx)
+		},
+		fixture: fixtures(query),
+		override: map[string]func() []mimic.QueryResult{
+			"jet": fixtures(func() mimic.QueryResult { return aliasJets(query()) }),
+		},
+		expect: func() []bench.Jet { return expectJets(jetQuery()) },
+	}
+}
+
+// inOrder and reversed are the orders of the columns of jetQuery that the
+// Bind benchmarks return them in
+var (
+	inOrder  = []int{0, 1, 2, 3, 4, 5, 6, 7, 8}
+	reversed = []int{8, 7, 6, 5, 4, 3, 2, 1, 0}
+)
+
+// bindOperations are the shapes of result the Bind benchmarks map
+var bindOperations = []jetOperation{
+	bindOp("BindInOrder", inOrder, false),
+	bindOp("BindReversed", reversed, false),
+	bindOp("BindExtra", inOrder, true),
+}
+
+// runBind creates an op/orm sub-benchmark for every adapter, after an
+// op/rows one that reads the rows without mapping them. What an ORM takes
+// over rows is what mapping the rows costs it.
+func runBind(b *testing.B, op jetOperation) {
+	dsn := "postgres://" + op.name + "-rows"
+	mimic.NewSequenceDSN(dsn, op.script("")...)
+
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+
+	b.Run("rows", func(b *testing.B) {
+		ctx := context.Background()
+		loop(b, func() error { return scanRows(ctx, db) })
+	})
+
+	runOperation(b, op)
+}
+
+// scanRows reads every value of select * from jets into an interface{}
+func scanRows(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	vals := make([]interface{}, len(cols))
+	for i := range vals {
+		vals[i] = new(interface{})
+	}
+	for rows.Next() {
+		if err := rows.Scan(vals...); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// TestVerifyBind is TestVerify for the Bind benchmarks
+func TestVerifyBind(t *testing.T) {
+	for _, op := range bindOperations {
+		verifyOperation(t, op)
+	}
+}
+
+func BenchmarkBindInOrder(b *testing.B) {
+	runBind(b, bindOperations[0])
+}
+
+func BenchmarkBindReversed(b *testing.B) {
+	runBind(b, bindOperations[1])
+}
+
+func BenchmarkBindExtra(b *testing.B) {
+	runBind(b, bindOperations[2])
+}
@@ -0,0 +1,166 @@
+package bobs
+
+// This file is not generated. bobgen.yaml turns off wiping so that the
+// adapter survives regeneration.
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	optnull "github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+// Adapter implements bench.Adapter for bob
+type Adapter struct {
+	db  bob.DB
+	jet Jet
+
+	// jets are the results of the last select
+	jets []*Jet
+
+	rel relations
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "bob" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Open a connection to the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = bob.NewDB(db)
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      optnull.From("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(ctx context.Context) error {
+	store, err := Jets.Query().All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(ctx context.Context) error {
+	store, err := Jets.Query(
+		sm.Columns("id", "name", "color", "uuid", "identifier", "cargo", "manifest"),
+	).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(ctx context.Context) error {
+	store, err := Jets.Query(
+		sm.Columns("id", "name", "color", "uuid", "identifier", "cargo", "manifest"),
+		sm.Where(JetColumns.ID.GT(psql.Arg(1))),
+		sm.Where(JetColumns.Name.NE(psql.Arg("thing"))),
+		sm.Limit(1),
+		sm.GroupBy(JetColumns.ID),
+		sm.Offset(1),
+	).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(ctx context.Context) error {
+	_, err := Jets.Insert(a.setter()).One(ctx, a.db)
+	return err
+}
+
+// Update a jet
+func (a *Adapter) Update(ctx context.Context) error {
+	return a.jet.Update(ctx, a.db, a.setter())
+}
+
+// Delete a jet
+func (a *Adapter) Delete(ctx context.Context) error {
+	return a.jet.Delete(ctx, a.db)
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(ctx context.Context) error {
+	store, err := bob.All(ctx, a.db, psql.RawQuery("select * from jets"), scan.StructMapper[*Jet]())
+	a.jets = store
+	return err
+}
+
+// EagerLoad jets with their pilots
+func (a *Adapter) EagerLoad(ctx context.Context) error {
+	store, err := Jets.Query(ThenLoadJetPilot()).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// setter sets every non-key column of the adapter's jet
+func (a *Adapter) setter() *JetSetter {
+	return jetSetter(&a.jet)
+}
+
+// jetSetter sets every non-key column of j
+func jetSetter(j *Jet) *JetSetter {
+	return &JetSetter{
+		PilotID:    omit.From(j.PilotID),
+		AirportID:  omit.From(j.AirportID),
+		Name:       omit.From(j.Name),
+		Color:      omitnull.FromNull(j.Color),
+		UUID:       omit.From(j.UUID),
+		Identifier: omit.From(j.Identifier),
+		Cargo:      omit.From(j.Cargo),
+		Manifest:   omit.From(j.Manifest),
+	}
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j *Jet) bench.Jet {
+	c := bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      null.StringFromPtr(j.Color.Ptr()),
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+	if j.R.Pilot != nil {
+		c.Pilot = &bench.Pilot{ID: int64(j.R.Pilot.ID), Name: j.R.Pilot.Name}
+	}
+	return c
+}
@@ -0,0 +1,618 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+)
+
+// Airport is an object representing the database table.
+type Airport struct {
+	ID   int32           `db:"id,pk" `
+	Size null.Val[int32] `db:"size" `
+
+	R airportR `db:"-" `
+}
+
+// AirportSlice is an alias for a slice of pointers to Airport.
+// This should almost always be used instead of []*Airport.
+type AirportSlice []*Airport
+
+// Airports contains methods to work with the airports table
+var Airports = psql.NewTablex[*Airport, AirportSlice, *AirportSetter]("", "airports")
+
+// AirportsQuery is a query on the airports table
+type AirportsQuery = *psql.ViewQuery[*Airport, AirportSlice]
+
+// airportR is where relationships are stored.
+type airportR struct {
+	Jets JetSlice // jets.jets_airport_id_airports_id_foreign
+}
+
+type airportColumnNames struct {
+	ID   string
+	Size string
+}
+
+var AirportColumns = buildAirportColumns("airports")
+
+type airportColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	Size       psql.Expression
+}
+
+func (c airportColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (airportColumns) AliasedAs(alias string) airportColumns {
+	return buildAirportColumns(alias)
+}
+
+func buildAirportColumns(alias string) airportColumns {
+	return airportColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Size:       psql.Quote(alias, "size"),
+	}
+}
+
+type airportWhere[Q psql.Filterable] struct {
+	ID   psql.WhereMod[Q, int32]
+	Size psql.WhereNullMod[Q, int32]
+}
+
+func (airportWhere[Q]) AliasedAs(alias string) airportWhere[Q] {
+	return buildAirportWhere[Q](buildAirportColumns(alias))
+}
+
+func buildAirportWhere[Q psql.Filterable](cols airportColumns) airportWhere[Q] {
+	return airportWhere[Q]{
+		ID:   psql.Where[Q, int32](cols.ID),
+		Size: psql.WhereNull[Q, int32](cols.Size),
+	}
+}
+
+var AirportErrors = &airportErrors{
+	ErrUniqueAirportsPkey: &UniqueConstraintError{s: "airports_pkey"},
+}
+
+type airportErrors struct {
+	ErrUniqueAirportsPkey *UniqueConstraintError
+}
+
+// AirportSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AirportSetter struct {
+	ID   omit.Val[int32]     `db:"id,pk" `
+	Size omitnull.Val[int32] `db:"size" `
+}
+
+func (s AirportSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.Size.IsUnset() {
+		vals = append(vals, "size")
+	}
+
+	return vals
+}
+
+func (s AirportSetter) Overwrite(t *Airport) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.Size.IsUnset() {
+		t.Size, _ = s.Size.GetNull()
+	}
+}
+
+func (s *AirportSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Airports.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.Size.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.Size)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AirportSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AirportSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.Size.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "size")...),
+			psql.Arg(s.Size),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAirport retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAirport(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Airport, error) {
+	if len(cols) == 0 {
+		return Airports.Query(
+			SelectWhere.Airports.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Airports.Query(
+		SelectWhere.Airports.ID.EQ(IDPK),
+		sm.Columns(Airports.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AirportExists checks the presence of a single record by primary key
+func AirportExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Airports.Query(
+		SelectWhere.Airports.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Airport is retrieved from the database
+func (o *Airport) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Airports.AfterSelectHooks.RunHooks(ctx, exec, AirportSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Airports.AfterInsertHooks.RunHooks(ctx, exec, AirportSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Airports.AfterUpdateHooks.RunHooks(ctx, exec, AirportSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Airports.AfterDeleteHooks.RunHooks(ctx, exec, AirportSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Airport
+func (o *Airport) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Airport) pkEQ() dialect.Expression {
+	return psql.Quote("airports", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Airport
+func (o *Airport) Update(ctx context.Context, exec bob.Executor, s *AirportSetter) error {
+	v, err := Airports.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Airport record with an executor
+func (o *Airport) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Airports.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Airport using the executor
+func (o *Airport) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Airports.Query(
+		SelectWhere.Airports.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AirportSlice is retrieved from the database
+func (o AirportSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Airports.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Airports.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Airports.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Airports.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AirportSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("airports", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AirportSlice) copyMatchingRows(from ...*Airport) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AirportSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Airports.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Airport:
+				o.copyMatchingRows(retrieved)
+			case []*Airport:
+				o.copyMatchingRows(retrieved...)
+			case AirportSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Airport or a slice of Airport
+				// then run the AfterUpdateHooks on the slice
+				_, err = Airports.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AirportSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Airports.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Airport:
+				o.copyMatchingRows(retrieved)
+			case []*Airport:
+				o.copyMatchingRows(retrieved...)
+			case AirportSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Airport or a slice of Airport
+				// then run the AfterDeleteHooks on the slice
+				_, err = Airports.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AirportSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AirportSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Airports.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AirportSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Airports.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AirportSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Airports.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type airportJoins[Q dialect.Joinable] struct {
+	typ  string
+	Jets func(context.Context) modAs[Q, jetColumns]
+}
+
+func (j airportJoins[Q]) aliasedAs(alias string) airportJoins[Q] {
+	return buildAirportJoins[Q](buildAirportColumns(alias), j.typ)
+}
+
+func buildAirportJoins[Q dialect.Joinable](cols airportColumns, typ string) airportJoins[Q] {
+	return airportJoins[Q]{
+		typ:  typ,
+		Jets: airportsJoinJets[Q](cols, typ),
+	}
+}
+
+func airportsJoinJets[Q dialect.Joinable](from airportColumns, typ string) func(context.Context) modAs[Q, jetColumns] {
+	return func(ctx context.Context) modAs[Q, jetColumns] {
+		return modAs[Q, jetColumns]{
+			c: JetColumns,
+			f: func(to jetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Jets.Name().As(to.Alias())).On(
+						to.AirportID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Jets starts a query for related objects on jets
+func (o *Airport) Jets(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	return Jets.Query(append(mods,
+		sm.Where(JetColumns.AirportID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os AirportSlice) Jets(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Jets.Query(append(mods,
+		sm.Where(psql.Group(JetColumns.AirportID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Airport) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Jets":
+		rels, ok := retrieved.(JetSlice)
+		if !ok {
+			return fmt.Errorf("airport cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Jets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Airport = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("airport has no relationship %q", name)
+	}
+}
+
+func ThenLoadAirportJets(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadAirportJets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load AirportJets", retrieved)
+		}
+
+		err := loader.LoadAirportJets(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadAirportJets loads the airport's Jets into the .R struct
+func (o *Airport) LoadAirportJets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Jets = nil
+
+	related, err := o.Jets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Airport = o
+	}
+
+	o.R.Jets = related
+	return nil
+}
+
+// LoadAirportJets loads the airport's Jets into the .R struct
+func (os AirportSlice) LoadAirportJets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	jets, err := os.Jets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Jets = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range jets {
+			if o.ID != rel.AirportID {
+				continue
+			}
+
+			rel.R.Airport = o
+
+			o.R.Jets = append(o.R.Jets, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertAirportJets0(ctx context.Context, exec bob.Executor, jets1 []*JetSetter, airport0 *Airport) (JetSlice, error) {
+	for i := range jets1 {
+		jets1[i].AirportID = omit.From(airport0.ID)
+	}
+
+	ret, err := Jets.Insert(bob.ToMods(jets1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertAirportJets0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAirportJets0(ctx context.Context, exec bob.Executor, count int, jets1 JetSlice, airport0 *Airport) (JetSlice, error) {
+	setter := &JetSetter{
+		AirportID: omit.From(airport0.ID),
+	}
+
+	err := jets1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAirportJets0: %w", err)
+	}
+
+	return jets1, nil
+}
+
+func (airport0 *Airport) InsertJets(ctx context.Context, exec bob.Executor, related ...*JetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	jets1, err := insertAirportJets0(ctx, exec, related, airport0)
+	if err != nil {
+		return err
+	}
+
+	airport0.R.Jets = append(airport0.R.Jets, jets1...)
+
+	for _, rel := range jets1 {
+		rel.R.Airport = airport0
+	}
+	return nil
+}
+
+func (airport0 *Airport) AttachJets(ctx context.Context, exec bob.Executor, related ...*Jet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	jets1 := JetSlice(related)
+
+	_, err = attachAirportJets0(ctx, exec, len(related), jets1, airport0)
+	if err != nil {
+		return err
+	}
+
+	airport0.R.Jets = append(airport0.R.Jets, jets1...)
+
+	for _, rel := range related {
+		rel.R.Airport = airport0
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/stephenafamo/scan"
+)
+
+// Bind the rows of a query into jets with the struct mapper of scan, which
+// bob maps its models with. It rejects columns the model doesn't have
+// unless the context allows them.
+func (a *Adapter) Bind(ctx context.Context) error {
+	rows, err := a.db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	ctx = context.WithValue(ctx, scan.CtxKeyAllowUnknownColumns, true)
+	store, err := scan.AllFromRows(ctx, scan.StructMapper[*Jet](), rows)
+	a.jets = store
+	return err
+}
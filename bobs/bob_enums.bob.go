@@ -0,0 +1,86 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Enum values for FlightStatus
+const (
+	FlightStatusScheduled FlightStatus = "scheduled"
+	FlightStatusBoarding  FlightStatus = "boarding"
+	FlightStatusDeparted  FlightStatus = "departed"
+	FlightStatusLanded    FlightStatus = "landed"
+	FlightStatusCancelled FlightStatus = "cancelled"
+)
+
+func AllFlightStatus() []FlightStatus {
+	return []FlightStatus{
+		FlightStatusScheduled,
+		FlightStatusBoarding,
+		FlightStatusDeparted,
+		FlightStatusLanded,
+		FlightStatusCancelled,
+	}
+}
+
+type FlightStatus string
+
+func (e FlightStatus) String() string {
+	return string(e)
+}
+
+func (e FlightStatus) Valid() bool {
+	switch e {
+	case FlightStatusScheduled,
+		FlightStatusBoarding,
+		FlightStatusDeparted,
+		FlightStatusLanded,
+		FlightStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e FlightStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FlightStatus) UnmarshalText(text []byte) error {
+	return e.Scan(text)
+}
+
+func (e FlightStatus) MarshalBinary() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FlightStatus) UnmarshalBinary(data []byte) error {
+	return e.Scan(data)
+}
+
+func (e FlightStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *FlightStatus) Scan(value any) error {
+	switch x := value.(type) {
+	case string:
+		*e = FlightStatus(x)
+	case []byte:
+		*e = FlightStatus(x)
+	case nil:
+		return fmt.Errorf("cannot nil into FlightStatus")
+	default:
+		return fmt.Errorf("cannot scan type %T: %v", value, value)
+	}
+
+	if !e.Valid() {
+		return fmt.Errorf("invalid FlightStatus value: %s", *e)
+	}
+
+	return nil
+}
@@ -0,0 +1,453 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"hash/maphash"
+
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/clause"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+)
+
+var TableNames = struct {
+	Airports       string
+	Flights        string
+	Hangars        string
+	Jets           string
+	Languages      string
+	Licenses       string
+	PilotLanguages string
+	Pilots         string
+	Wide10s        string
+	Wide100s       string
+	Wide25s        string
+	Wide50s        string
+}{
+	Airports:       "airports",
+	Flights:        "flights",
+	Hangars:        "hangars",
+	Jets:           "jets",
+	Languages:      "languages",
+	Licenses:       "licenses",
+	PilotLanguages: "pilot_languages",
+	Pilots:         "pilots",
+	Wide10s:        "wide10",
+	Wide100s:       "wide100",
+	Wide25s:        "wide25",
+	Wide50s:        "wide50",
+}
+
+var ColumnNames = struct {
+	Airports       airportColumnNames
+	Flights        flightColumnNames
+	Hangars        hangarColumnNames
+	Jets           jetColumnNames
+	Languages      languageColumnNames
+	Licenses       licenseColumnNames
+	PilotLanguages pilotLanguageColumnNames
+	Pilots         pilotColumnNames
+	Wide10s        wide10ColumnNames
+	Wide100s       wide100ColumnNames
+	Wide25s        wide25ColumnNames
+	Wide50s        wide50ColumnNames
+}{
+	Airports: airportColumnNames{
+		ID:   "id",
+		Size: "size",
+	},
+	Flights: flightColumnNames{
+		ID:         "id",
+		JetID:      "jet_id",
+		Number:     "number",
+		Status:     "status",
+		DepartsAt:  "departs_at",
+		ArrivesAt:  "arrives_at",
+		Fare:       "fare",
+		BookingRef: "booking_ref",
+		OperatorIP: "operator_ip",
+		Crew:       "crew",
+		Seats:      "seats",
+		Metadata:   "metadata",
+		Notes:      "notes",
+	},
+	Hangars: hangarColumnNames{
+		ID:   "id",
+		Name: "name",
+	},
+	Jets: jetColumnNames{
+		ID:         "id",
+		PilotID:    "pilot_id",
+		AirportID:  "airport_id",
+		Name:       "name",
+		Color:      "color",
+		UUID:       "uuid",
+		Identifier: "identifier",
+		Cargo:      "cargo",
+		Manifest:   "manifest",
+	},
+	Languages: languageColumnNames{
+		ID:       "id",
+		Language: "language",
+	},
+	Licenses: licenseColumnNames{
+		ID:      "id",
+		PilotID: "pilot_id",
+	},
+	PilotLanguages: pilotLanguageColumnNames{
+		PilotID:    "pilot_id",
+		LanguageID: "language_id",
+	},
+	Pilots: pilotColumnNames{
+		ID:   "id",
+		Name: "name",
+	},
+	Wide10s: wide10ColumnNames{
+		ID:           "id",
+		PilotID:      "pilot_id",
+		Text003:      "text_003",
+		Integer004:   "integer_004",
+		Bigint005:    "bigint_005",
+		Boolean006:   "boolean_006",
+		Float007:     "float_007",
+		Timestamp008: "timestamp_008",
+		Bytes009:     "bytes_009",
+		NullText010:  "null_text_010",
+	},
+	Wide100s: wide100ColumnNames{
+		ID:               "id",
+		PilotID:          "pilot_id",
+		Text003:          "text_003",
+		Integer004:       "integer_004",
+		Bigint005:        "bigint_005",
+		Boolean006:       "boolean_006",
+		Float007:         "float_007",
+		Timestamp008:     "timestamp_008",
+		Bytes009:         "bytes_009",
+		NullText010:      "null_text_010",
+		NullInteger011:   "null_integer_011",
+		NullTimestamp012: "null_timestamp_012",
+		Text013:          "text_013",
+		Integer014:       "integer_014",
+		Bigint015:        "bigint_015",
+		Boolean016:       "boolean_016",
+		Float017:         "float_017",
+		Timestamp018:     "timestamp_018",
+		Bytes019:         "bytes_019",
+		NullText020:      "null_text_020",
+		NullInteger021:   "null_integer_021",
+		NullTimestamp022: "null_timestamp_022",
+		Text023:          "text_023",
+		Integer024:       "integer_024",
+		Bigint025:        "bigint_025",
+		Boolean026:       "boolean_026",
+		Float027:         "float_027",
+		Timestamp028:     "timestamp_028",
+		Bytes029:         "bytes_029",
+		NullText030:      "null_text_030",
+		NullInteger031:   "null_integer_031",
+		NullTimestamp032: "null_timestamp_032",
+		Text033:          "text_033",
+		Integer034:       "integer_034",
+		Bigint035:        "bigint_035",
+		Boolean036:       "boolean_036",
+		Float037:         "float_037",
+		Timestamp038:     "timestamp_038",
+		Bytes039:         "bytes_039",
+		NullText040:      "null_text_040",
+		NullInteger041:   "null_integer_041",
+		NullTimestamp042: "null_timestamp_042",
+		Text043:          "text_043",
+		Integer044:       "integer_044",
+		Bigint045:        "bigint_045",
+		Boolean046:       "boolean_046",
+		Float047:         "float_047",
+		Timestamp048:     "timestamp_048",
+		Bytes049:         "bytes_049",
+		NullText050:      "null_text_050",
+		NullInteger051:   "null_integer_051",
+		NullTimestamp052: "null_timestamp_052",
+		Text053:          "text_053",
+		Integer054:       "integer_054",
+		Bigint055:        "bigint_055",
+		Boolean056:       "boolean_056",
+		Float057:         "float_057",
+		Timestamp058:     "timestamp_058",
+		Bytes059:         "bytes_059",
+		NullText060:      "null_text_060",
+		NullInteger061:   "null_integer_061",
+		NullTimestamp062: "null_timestamp_062",
+		Text063:          "text_063",
+		Integer064:       "integer_064",
+		Bigint065:        "bigint_065",
+		Boolean066:       "boolean_066",
+		Float067:         "float_067",
+		Timestamp068:     "timestamp_068",
+		Bytes069:         "bytes_069",
+		NullText070:      "null_text_070",
+		NullInteger071:   "null_integer_071",
+		NullTimestamp072: "null_timestamp_072",
+		Text073:          "text_073",
+		Integer074:       "integer_074",
+		Bigint075:        "bigint_075",
+		Boolean076:       "boolean_076",
+		Float077:         "float_077",
+		Timestamp078:     "timestamp_078",
+		Bytes079:         "bytes_079",
+		NullText080:      "null_text_080",
+		NullInteger081:   "null_integer_081",
+		NullTimestamp082: "null_timestamp_082",
+		Text083:          "text_083",
+		Integer084:       "integer_084",
+		Bigint085:        "bigint_085",
+		Boolean086:       "boolean_086",
+		Float087:         "float_087",
+		Timestamp088:     "timestamp_088",
+		Bytes089:         "bytes_089",
+		NullText090:      "null_text_090",
+		NullInteger091:   "null_integer_091",
+		NullTimestamp092: "null_timestamp_092",
+		Text093:          "text_093",
+		Integer094:       "integer_094",
+		Bigint095:        "bigint_095",
+		Boolean096:       "boolean_096",
+		Float097:         "float_097",
+		Timestamp098:     "timestamp_098",
+		Bytes099:         "bytes_099",
+		NullText100:      "null_text_100",
+	},
+	Wide25s: wide25ColumnNames{
+		ID:               "id",
+		PilotID:          "pilot_id",
+		Text003:          "text_003",
+		Integer004:       "integer_004",
+		Bigint005:        "bigint_005",
+		Boolean006:       "boolean_006",
+		Float007:         "float_007",
+		Timestamp008:     "timestamp_008",
+		Bytes009:         "bytes_009",
+		NullText010:      "null_text_010",
+		NullInteger011:   "null_integer_011",
+		NullTimestamp012: "null_timestamp_012",
+		Text013:          "text_013",
+		Integer014:       "integer_014",
+		Bigint015:        "bigint_015",
+		Boolean016:       "boolean_016",
+		Float017:         "float_017",
+		Timestamp018:     "timestamp_018",
+		Bytes019:         "bytes_019",
+		NullText020:      "null_text_020",
+		NullInteger021:   "null_integer_021",
+		NullTimestamp022: "null_timestamp_022",
+		Text023:          "text_023",
+		Integer024:       "integer_024",
+		Bigint025:        "bigint_025",
+	},
+	Wide50s: wide50ColumnNames{
+		ID:               "id",
+		PilotID:          "pilot_id",
+		Text003:          "text_003",
+		Integer004:       "integer_004",
+		Bigint005:        "bigint_005",
+		Boolean006:       "boolean_006",
+		Float007:         "float_007",
+		Timestamp008:     "timestamp_008",
+		Bytes009:         "bytes_009",
+		NullText010:      "null_text_010",
+		NullInteger011:   "null_integer_011",
+		NullTimestamp012: "null_timestamp_012",
+		Text013:          "text_013",
+		Integer014:       "integer_014",
+		Bigint015:        "bigint_015",
+		Boolean016:       "boolean_016",
+		Float017:         "float_017",
+		Timestamp018:     "timestamp_018",
+		Bytes019:         "bytes_019",
+		NullText020:      "null_text_020",
+		NullInteger021:   "null_integer_021",
+		NullTimestamp022: "null_timestamp_022",
+		Text023:          "text_023",
+		Integer024:       "integer_024",
+		Bigint025:        "bigint_025",
+		Boolean026:       "boolean_026",
+		Float027:         "float_027",
+		Timestamp028:     "timestamp_028",
+		Bytes029:         "bytes_029",
+		NullText030:      "null_text_030",
+		NullInteger031:   "null_integer_031",
+		NullTimestamp032: "null_timestamp_032",
+		Text033:          "text_033",
+		Integer034:       "integer_034",
+		Bigint035:        "bigint_035",
+		Boolean036:       "boolean_036",
+		Float037:         "float_037",
+		Timestamp038:     "timestamp_038",
+		Bytes039:         "bytes_039",
+		NullText040:      "null_text_040",
+		NullInteger041:   "null_integer_041",
+		NullTimestamp042: "null_timestamp_042",
+		Text043:          "text_043",
+		Integer044:       "integer_044",
+		Bigint045:        "bigint_045",
+		Boolean046:       "boolean_046",
+		Float047:         "float_047",
+		Timestamp048:     "timestamp_048",
+		Bytes049:         "bytes_049",
+		NullText050:      "null_text_050",
+	},
+}
+
+var (
+	SelectWhere     = Where[*dialect.SelectQuery]()
+	UpdateWhere     = Where[*dialect.UpdateQuery]()
+	DeleteWhere     = Where[*dialect.DeleteQuery]()
+	OnConflictWhere = Where[*clause.ConflictClause]() // Used in ON CONFLICT DO UPDATE
+)
+
+func Where[Q psql.Filterable]() struct {
+	Airports       airportWhere[Q]
+	Flights        flightWhere[Q]
+	Hangars        hangarWhere[Q]
+	Jets           jetWhere[Q]
+	Languages      languageWhere[Q]
+	Licenses       licenseWhere[Q]
+	PilotLanguages pilotLanguageWhere[Q]
+	Pilots         pilotWhere[Q]
+	Wide10s        wide10Where[Q]
+	Wide100s       wide100Where[Q]
+	Wide25s        wide25Where[Q]
+	Wide50s        wide50Where[Q]
+} {
+	return struct {
+		Airports       airportWhere[Q]
+		Flights        flightWhere[Q]
+		Hangars        hangarWhere[Q]
+		Jets           jetWhere[Q]
+		Languages      languageWhere[Q]
+		Licenses       licenseWhere[Q]
+		PilotLanguages pilotLanguageWhere[Q]
+		Pilots         pilotWhere[Q]
+		Wide10s        wide10Where[Q]
+		Wide100s       wide100Where[Q]
+		Wide25s        wide25Where[Q]
+		Wide50s        wide50Where[Q]
+	}{
+		Airports:       buildAirportWhere[Q](AirportColumns),
+		Flights:        buildFlightWhere[Q](FlightColumns),
+		Hangars:        buildHangarWhere[Q](HangarColumns),
+		Jets:           buildJetWhere[Q](JetColumns),
+		Languages:      buildLanguageWhere[Q](LanguageColumns),
+		Licenses:       buildLicenseWhere[Q](LicenseColumns),
+		PilotLanguages: buildPilotLanguageWhere[Q](PilotLanguageColumns),
+		Pilots:         buildPilotWhere[Q](PilotColumns),
+		Wide10s:        buildWide10Where[Q](Wide10Columns),
+		Wide100s:       buildWide100Where[Q](Wide100Columns),
+		Wide25s:        buildWide25Where[Q](Wide25Columns),
+		Wide50s:        buildWide50Where[Q](Wide50Columns),
+	}
+}
+
+var (
+	SelectJoins = getJoins[*dialect.SelectQuery]()
+	UpdateJoins = getJoins[*dialect.UpdateQuery]()
+	DeleteJoins = getJoins[*dialect.DeleteQuery]()
+)
+
+type joinSet[Q interface{ aliasedAs(string) Q }] struct {
+	InnerJoin Q
+	LeftJoin  Q
+	RightJoin Q
+}
+
+func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
+	return joinSet[Q]{
+		InnerJoin: j.InnerJoin.aliasedAs(alias),
+		LeftJoin:  j.LeftJoin.aliasedAs(alias),
+		RightJoin: j.RightJoin.aliasedAs(alias),
+	}
+}
+
+type joins[Q dialect.Joinable] struct {
+	Airports       joinSet[airportJoins[Q]]
+	Flights        joinSet[flightJoins[Q]]
+	Jets           joinSet[jetJoins[Q]]
+	Languages      joinSet[languageJoins[Q]]
+	Licenses       joinSet[licenseJoins[Q]]
+	PilotLanguages joinSet[pilotLanguageJoins[Q]]
+	Pilots         joinSet[pilotJoins[Q]]
+	Wide10s        joinSet[wide10Joins[Q]]
+	Wide100s       joinSet[wide100Joins[Q]]
+	Wide25s        joinSet[wide25Joins[Q]]
+	Wide50s        joinSet[wide50Joins[Q]]
+}
+
+func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
+	return joinSet[Q]{
+		InnerJoin: f(c, clause.InnerJoin),
+		LeftJoin:  f(c, clause.LeftJoin),
+		RightJoin: f(c, clause.RightJoin),
+	}
+}
+
+func getJoins[Q dialect.Joinable]() joins[Q] {
+	return joins[Q]{
+		Airports:       buildJoinSet[airportJoins[Q]](AirportColumns, buildAirportJoins),
+		Flights:        buildJoinSet[flightJoins[Q]](FlightColumns, buildFlightJoins),
+		Jets:           buildJoinSet[jetJoins[Q]](JetColumns, buildJetJoins),
+		Languages:      buildJoinSet[languageJoins[Q]](LanguageColumns, buildLanguageJoins),
+		Licenses:       buildJoinSet[licenseJoins[Q]](LicenseColumns, buildLicenseJoins),
+		PilotLanguages: buildJoinSet[pilotLanguageJoins[Q]](PilotLanguageColumns, buildPilotLanguageJoins),
+		Pilots:         buildJoinSet[pilotJoins[Q]](PilotColumns, buildPilotJoins),
+		Wide10s:        buildJoinSet[wide10Joins[Q]](Wide10Columns, buildWide10Joins),
+		Wide100s:       buildJoinSet[wide100Joins[Q]](Wide100Columns, buildWide100Joins),
+		Wide25s:        buildJoinSet[wide25Joins[Q]](Wide25Columns, buildWide25Joins),
+		Wide50s:        buildJoinSet[wide50Joins[Q]](Wide50Columns, buildWide50Joins),
+	}
+}
+
+type modAs[Q any, C interface{ AliasedAs(string) C }] struct {
+	c C
+	f func(C) bob.Mod[Q]
+}
+
+func (m modAs[Q, C]) Apply(q Q) {
+	m.f(m.c).Apply(q)
+}
+
+func (m modAs[Q, C]) AliasedAs(alias string) bob.Mod[Q] {
+	m.c = m.c.AliasedAs(alias)
+	return m
+}
+
+func randInt() int64 {
+	out := int64(new(maphash.Hash).Sum64())
+
+	if out < 0 {
+		return -out % 10000
+	}
+
+	return out % 10000
+}
+
+// ErrUniqueConstraint captures all unique constraint errors by explicitly leaving `s` empty.
+var ErrUniqueConstraint = &UniqueConstraintError{s: ""}
+
+type UniqueConstraintError struct {
+	// s is a string uniquely identifying the constraint in the raw error message returned from the database.
+	s string
+}
+
+func (e *UniqueConstraintError) Error() string {
+	return e.s
+}
+
+func (e *UniqueConstraintError) Is(target error) bool {
+	err, ok := target.(*pq.Error)
+	if !ok {
+		return false
+	}
+	return err.Code == "23505" && (e.s == "" || err.Constraint == e.s)
+}
@@ -0,0 +1,34 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+// BuildSelectComplex builds the query of SelectComplex
+func (a *Adapter) BuildSelectComplex(ctx context.Context) (string, []interface{}, error) {
+	return bob.Build(ctx, Jets.Query(
+		sm.Columns("id", "name", "color", "uuid", "identifier", "cargo", "manifest"),
+		sm.Where(JetColumns.ID.GT(psql.Arg(1))),
+		sm.Where(JetColumns.Name.NE(psql.Arg("thing"))),
+		sm.Limit(1),
+		sm.GroupBy(JetColumns.ID),
+		sm.Offset(1),
+	))
+}
+
+// BuildInsert builds the statement of Insert
+func (a *Adapter) BuildInsert(ctx context.Context) (string, []interface{}, error) {
+	return bob.Build(ctx, Jets.Insert(a.setter()))
+}
+
+// BuildUpdate builds the statement of Update
+func (a *Adapter) BuildUpdate(ctx context.Context) (string, []interface{}, error) {
+	return bob.Build(ctx, Jets.Update(a.setter().UpdateMod(), um.Where(a.jet.pkEQ())))
+}
@@ -0,0 +1,137 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+	"encoding/json"
+	"net/netip"
+
+	"github.com/aarondl/boilbench/bench"
+	nullv8 "github.com/aarondl/null/v8"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Flights returns the operations against the flights table
+func (a *Adapter) Flights() bench.Flights {
+	f := &flights{a: a, flight: flightOf(bench.FlightRow)}
+	f.setter = setterOfFlight(&f.flight)
+	return f
+}
+
+// flights implements bench.Flights
+type flights struct {
+	a *Adapter
+
+	// setter sets every column of flight but its key
+	flight Flight
+	setter *FlightSetter
+
+	// flights are the results of the last select, wrote is set when the
+	// last operation wrote flight instead
+	flights FlightSlice
+	wrote   bool
+}
+
+// SelectAll flights
+func (f *flights) SelectAll(ctx context.Context) error {
+	store, err := Flights.Query().All(ctx, f.a.db)
+	f.flights, f.wrote = store, false
+	return err
+}
+
+// Insert a flight
+func (f *flights) Insert(ctx context.Context) error {
+	f.wrote = true
+	_, err := Flights.Insert(f.setter).One(ctx, f.a.db)
+	return err
+}
+
+// Update a flight
+func (f *flights) Update(ctx context.Context) error {
+	f.wrote = true
+	return f.flight.Update(ctx, f.a.db, f.setter)
+}
+
+// Results of the last operation
+func (f *flights) Results() []bench.Flight {
+	if f.wrote {
+		return []bench.Flight{canonicalFlight(&f.flight)}
+	}
+
+	results := make([]bench.Flight, len(f.flights))
+	for i, fl := range f.flights {
+		results[i] = canonicalFlight(fl)
+	}
+	return results
+}
+
+func flightOf(f bench.Flight) Flight {
+	ip := netip.MustParseAddr(f.OperatorIP)
+	m := Flight{
+		ID:         int32(f.ID),
+		JetID:      int32(f.JetID),
+		Number:     f.Number,
+		Status:     FlightStatus(f.Status),
+		DepartsAt:  f.DepartsAt,
+		ArrivesAt:  null.FromCond(f.ArrivesAt.Time, f.ArrivesAt.Valid),
+		Fare:       decimal.RequireFromString(f.Fare),
+		BookingRef: uuid.FromStringOrNil(f.BookingRef),
+		OperatorIP: pgtypes.Inet{Prefix: netip.PrefixFrom(ip, ip.BitLen())},
+		Crew:       f.Crew,
+		Seats:      f.Seats,
+		Metadata:   types.NewJSON(json.RawMessage(f.Metadata)),
+	}
+	if f.Notes.Valid {
+		m.Notes = null.From(types.NewJSON(json.RawMessage(f.Notes.String)))
+	}
+	return m
+}
+
+func setterOfFlight(f *Flight) *FlightSetter {
+	return &FlightSetter{
+		JetID:      omit.From(f.JetID),
+		Number:     omit.From(f.Number),
+		Status:     omit.From(f.Status),
+		DepartsAt:  omit.From(f.DepartsAt),
+		ArrivesAt:  omitnull.FromNull(f.ArrivesAt),
+		Fare:       omit.From(f.Fare),
+		BookingRef: omit.From(f.BookingRef),
+		OperatorIP: omit.From(f.OperatorIP),
+		Crew:       omit.From(f.Crew),
+		Seats:      omit.From(f.Seats),
+		Metadata:   omit.From(f.Metadata),
+		Notes:      omitnull.FromNull(f.Notes),
+	}
+}
+
+func canonicalFlight(f *Flight) bench.Flight {
+	c := bench.Flight{
+		ID:         int64(f.ID),
+		JetID:      int64(f.JetID),
+		Number:     f.Number,
+		Status:     string(f.Status),
+		DepartsAt:  f.DepartsAt,
+		ArrivesAt:  nullv8.TimeFromPtr(f.ArrivesAt.Ptr()),
+		Fare:       f.Fare.String(),
+		BookingRef: f.BookingRef.String(),
+		OperatorIP: f.OperatorIP.String(),
+		Crew:       f.Crew,
+		Seats:      f.Seats,
+		Metadata:   string(f.Metadata.Val),
+	}
+	// Postgres leaves the prefix length off host addresses
+	if f.OperatorIP.IsSingleIP() {
+		c.OperatorIP = f.OperatorIP.Addr().String()
+	}
+	if notes, ok := f.Notes.Get(); ok {
+		c.Notes = nullv8.StringFrom(string(notes.Val))
+	}
+	return c
+}
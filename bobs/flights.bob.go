@@ -0,0 +1,912 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Flight is an object representing the database table.
+type Flight struct {
+	ID         int32                                 `db:"id,pk" `
+	JetID      int32                                 `db:"jet_id" `
+	Number     string                                `db:"number" `
+	Status     FlightStatus                          `db:"status" `
+	DepartsAt  time.Time                             `db:"departs_at" `
+	ArrivesAt  null.Val[time.Time]                   `db:"arrives_at" `
+	Fare       decimal.Decimal                       `db:"fare" `
+	BookingRef uuid.UUID                             `db:"booking_ref" `
+	OperatorIP pgtypes.Inet                          `db:"operator_ip" `
+	Crew       pq.StringArray                        `db:"crew" `
+	Seats      pq.Int64Array                         `db:"seats" `
+	Metadata   types.JSON[json.RawMessage]           `db:"metadata" `
+	Notes      null.Val[types.JSON[json.RawMessage]] `db:"notes" `
+
+	R flightR `db:"-" `
+}
+
+// FlightSlice is an alias for a slice of pointers to Flight.
+// This should almost always be used instead of []*Flight.
+type FlightSlice []*Flight
+
+// Flights contains methods to work with the flights table
+var Flights = psql.NewTablex[*Flight, FlightSlice, *FlightSetter]("", "flights")
+
+// FlightsQuery is a query on the flights table
+type FlightsQuery = *psql.ViewQuery[*Flight, FlightSlice]
+
+// flightR is where relationships are stored.
+type flightR struct {
+	Jet *Jet // flights.flights_jet_id_jets_id_foreign
+}
+
+type flightColumnNames struct {
+	ID         string
+	JetID      string
+	Number     string
+	Status     string
+	DepartsAt  string
+	ArrivesAt  string
+	Fare       string
+	BookingRef string
+	OperatorIP string
+	Crew       string
+	Seats      string
+	Metadata   string
+	Notes      string
+}
+
+var FlightColumns = buildFlightColumns("flights")
+
+type flightColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	JetID      psql.Expression
+	Number     psql.Expression
+	Status     psql.Expression
+	DepartsAt  psql.Expression
+	ArrivesAt  psql.Expression
+	Fare       psql.Expression
+	BookingRef psql.Expression
+	OperatorIP psql.Expression
+	Crew       psql.Expression
+	Seats      psql.Expression
+	Metadata   psql.Expression
+	Notes      psql.Expression
+}
+
+func (c flightColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (flightColumns) AliasedAs(alias string) flightColumns {
+	return buildFlightColumns(alias)
+}
+
+func buildFlightColumns(alias string) flightColumns {
+	return flightColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		JetID:      psql.Quote(alias, "jet_id"),
+		Number:     psql.Quote(alias, "number"),
+		Status:     psql.Quote(alias, "status"),
+		DepartsAt:  psql.Quote(alias, "departs_at"),
+		ArrivesAt:  psql.Quote(alias, "arrives_at"),
+		Fare:       psql.Quote(alias, "fare"),
+		BookingRef: psql.Quote(alias, "booking_ref"),
+		OperatorIP: psql.Quote(alias, "operator_ip"),
+		Crew:       psql.Quote(alias, "crew"),
+		Seats:      psql.Quote(alias, "seats"),
+		Metadata:   psql.Quote(alias, "metadata"),
+		Notes:      psql.Quote(alias, "notes"),
+	}
+}
+
+type flightWhere[Q psql.Filterable] struct {
+	ID         psql.WhereMod[Q, int32]
+	JetID      psql.WhereMod[Q, int32]
+	Number     psql.WhereMod[Q, string]
+	Status     psql.WhereMod[Q, FlightStatus]
+	DepartsAt  psql.WhereMod[Q, time.Time]
+	ArrivesAt  psql.WhereNullMod[Q, time.Time]
+	Fare       psql.WhereMod[Q, decimal.Decimal]
+	BookingRef psql.WhereMod[Q, uuid.UUID]
+	OperatorIP psql.WhereMod[Q, pgtypes.Inet]
+	Crew       psql.WhereMod[Q, pq.StringArray]
+	Seats      psql.WhereMod[Q, pq.Int64Array]
+	Metadata   psql.WhereMod[Q, types.JSON[json.RawMessage]]
+	Notes      psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+}
+
+func (flightWhere[Q]) AliasedAs(alias string) flightWhere[Q] {
+	return buildFlightWhere[Q](buildFlightColumns(alias))
+}
+
+func buildFlightWhere[Q psql.Filterable](cols flightColumns) flightWhere[Q] {
+	return flightWhere[Q]{
+		ID:         psql.Where[Q, int32](cols.ID),
+		JetID:      psql.Where[Q, int32](cols.JetID),
+		Number:     psql.Where[Q, string](cols.Number),
+		Status:     psql.Where[Q, FlightStatus](cols.Status),
+		DepartsAt:  psql.Where[Q, time.Time](cols.DepartsAt),
+		ArrivesAt:  psql.WhereNull[Q, time.Time](cols.ArrivesAt),
+		Fare:       psql.Where[Q, decimal.Decimal](cols.Fare),
+		BookingRef: psql.Where[Q, uuid.UUID](cols.BookingRef),
+		OperatorIP: psql.Where[Q, pgtypes.Inet](cols.OperatorIP),
+		Crew:       psql.Where[Q, pq.StringArray](cols.Crew),
+		Seats:      psql.Where[Q, pq.Int64Array](cols.Seats),
+		Metadata:   psql.Where[Q, types.JSON[json.RawMessage]](cols.Metadata),
+		Notes:      psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Notes),
+	}
+}
+
+var FlightErrors = &flightErrors{
+	ErrUniqueFlightsPkey: &UniqueConstraintError{s: "flights_pkey"},
+}
+
+type flightErrors struct {
+	ErrUniqueFlightsPkey *UniqueConstraintError
+}
+
+// FlightSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type FlightSetter struct {
+	ID         omit.Val[int32]                           `db:"id,pk" `
+	JetID      omit.Val[int32]                           `db:"jet_id" `
+	Number     omit.Val[string]                          `db:"number" `
+	Status     omit.Val[FlightStatus]                    `db:"status" `
+	DepartsAt  omit.Val[time.Time]                       `db:"departs_at" `
+	ArrivesAt  omitnull.Val[time.Time]                   `db:"arrives_at" `
+	Fare       omit.Val[decimal.Decimal]                 `db:"fare" `
+	BookingRef omit.Val[uuid.UUID]                       `db:"booking_ref" `
+	OperatorIP omit.Val[pgtypes.Inet]                    `db:"operator_ip" `
+	Crew       omit.Val[pq.StringArray]                  `db:"crew" `
+	Seats      omit.Val[pq.Int64Array]                   `db:"seats" `
+	Metadata   omit.Val[types.JSON[json.RawMessage]]     `db:"metadata" `
+	Notes      omitnull.Val[types.JSON[json.RawMessage]] `db:"notes" `
+}
+
+func (s FlightSetter) SetColumns() []string {
+	vals := make([]string, 0, 13)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.JetID.IsUnset() {
+		vals = append(vals, "jet_id")
+	}
+
+	if !s.Number.IsUnset() {
+		vals = append(vals, "number")
+	}
+
+	if !s.Status.IsUnset() {
+		vals = append(vals, "status")
+	}
+
+	if !s.DepartsAt.IsUnset() {
+		vals = append(vals, "departs_at")
+	}
+
+	if !s.ArrivesAt.IsUnset() {
+		vals = append(vals, "arrives_at")
+	}
+
+	if !s.Fare.IsUnset() {
+		vals = append(vals, "fare")
+	}
+
+	if !s.BookingRef.IsUnset() {
+		vals = append(vals, "booking_ref")
+	}
+
+	if !s.OperatorIP.IsUnset() {
+		vals = append(vals, "operator_ip")
+	}
+
+	if !s.Crew.IsUnset() {
+		vals = append(vals, "crew")
+	}
+
+	if !s.Seats.IsUnset() {
+		vals = append(vals, "seats")
+	}
+
+	if !s.Metadata.IsUnset() {
+		vals = append(vals, "metadata")
+	}
+
+	if !s.Notes.IsUnset() {
+		vals = append(vals, "notes")
+	}
+
+	return vals
+}
+
+func (s FlightSetter) Overwrite(t *Flight) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.JetID.IsUnset() {
+		t.JetID, _ = s.JetID.Get()
+	}
+	if !s.Number.IsUnset() {
+		t.Number, _ = s.Number.Get()
+	}
+	if !s.Status.IsUnset() {
+		t.Status, _ = s.Status.Get()
+	}
+	if !s.DepartsAt.IsUnset() {
+		t.DepartsAt, _ = s.DepartsAt.Get()
+	}
+	if !s.ArrivesAt.IsUnset() {
+		t.ArrivesAt, _ = s.ArrivesAt.GetNull()
+	}
+	if !s.Fare.IsUnset() {
+		t.Fare, _ = s.Fare.Get()
+	}
+	if !s.BookingRef.IsUnset() {
+		t.BookingRef, _ = s.BookingRef.Get()
+	}
+	if !s.OperatorIP.IsUnset() {
+		t.OperatorIP, _ = s.OperatorIP.Get()
+	}
+	if !s.Crew.IsUnset() {
+		t.Crew, _ = s.Crew.Get()
+	}
+	if !s.Seats.IsUnset() {
+		t.Seats, _ = s.Seats.Get()
+	}
+	if !s.Metadata.IsUnset() {
+		t.Metadata, _ = s.Metadata.Get()
+	}
+	if !s.Notes.IsUnset() {
+		t.Notes, _ = s.Notes.GetNull()
+	}
+}
+
+func (s *FlightSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Flights.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 13)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.JetID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.JetID)
+		}
+
+		if s.Number.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.Number)
+		}
+
+		if s.Status.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Status)
+		}
+
+		if s.DepartsAt.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.DepartsAt)
+		}
+
+		if s.ArrivesAt.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.ArrivesAt)
+		}
+
+		if s.Fare.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Fare)
+		}
+
+		if s.BookingRef.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.BookingRef)
+		}
+
+		if s.OperatorIP.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.OperatorIP)
+		}
+
+		if s.Crew.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.Crew)
+		}
+
+		if s.Seats.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.Seats)
+		}
+
+		if s.Metadata.IsUnset() {
+			vals[11] = psql.Raw("DEFAULT")
+		} else {
+			vals[11] = psql.Arg(s.Metadata)
+		}
+
+		if s.Notes.IsUnset() {
+			vals[12] = psql.Raw("DEFAULT")
+		} else {
+			vals[12] = psql.Arg(s.Notes)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s FlightSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s FlightSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 13)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.JetID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "jet_id")...),
+			psql.Arg(s.JetID),
+		}})
+	}
+
+	if !s.Number.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "number")...),
+			psql.Arg(s.Number),
+		}})
+	}
+
+	if !s.Status.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "status")...),
+			psql.Arg(s.Status),
+		}})
+	}
+
+	if !s.DepartsAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "departs_at")...),
+			psql.Arg(s.DepartsAt),
+		}})
+	}
+
+	if !s.ArrivesAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "arrives_at")...),
+			psql.Arg(s.ArrivesAt),
+		}})
+	}
+
+	if !s.Fare.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "fare")...),
+			psql.Arg(s.Fare),
+		}})
+	}
+
+	if !s.BookingRef.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "booking_ref")...),
+			psql.Arg(s.BookingRef),
+		}})
+	}
+
+	if !s.OperatorIP.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "operator_ip")...),
+			psql.Arg(s.OperatorIP),
+		}})
+	}
+
+	if !s.Crew.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "crew")...),
+			psql.Arg(s.Crew),
+		}})
+	}
+
+	if !s.Seats.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "seats")...),
+			psql.Arg(s.Seats),
+		}})
+	}
+
+	if !s.Metadata.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "metadata")...),
+			psql.Arg(s.Metadata),
+		}})
+	}
+
+	if !s.Notes.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "notes")...),
+			psql.Arg(s.Notes),
+		}})
+	}
+
+	return exprs
+}
+
+// FindFlight retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindFlight(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Flight, error) {
+	if len(cols) == 0 {
+		return Flights.Query(
+			SelectWhere.Flights.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Flights.Query(
+		SelectWhere.Flights.ID.EQ(IDPK),
+		sm.Columns(Flights.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// FlightExists checks the presence of a single record by primary key
+func FlightExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Flights.Query(
+		SelectWhere.Flights.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Flight is retrieved from the database
+func (o *Flight) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Flights.AfterSelectHooks.RunHooks(ctx, exec, FlightSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Flights.AfterInsertHooks.RunHooks(ctx, exec, FlightSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Flights.AfterUpdateHooks.RunHooks(ctx, exec, FlightSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Flights.AfterDeleteHooks.RunHooks(ctx, exec, FlightSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Flight
+func (o *Flight) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Flight) pkEQ() dialect.Expression {
+	return psql.Quote("flights", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Flight
+func (o *Flight) Update(ctx context.Context, exec bob.Executor, s *FlightSetter) error {
+	v, err := Flights.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Flight record with an executor
+func (o *Flight) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Flights.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Flight using the executor
+func (o *Flight) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Flights.Query(
+		SelectWhere.Flights.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after FlightSlice is retrieved from the database
+func (o FlightSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Flights.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Flights.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Flights.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Flights.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o FlightSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("flights", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o FlightSlice) copyMatchingRows(from ...*Flight) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o FlightSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Flights.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Flight:
+				o.copyMatchingRows(retrieved)
+			case []*Flight:
+				o.copyMatchingRows(retrieved...)
+			case FlightSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Flight or a slice of Flight
+				// then run the AfterUpdateHooks on the slice
+				_, err = Flights.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o FlightSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Flights.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Flight:
+				o.copyMatchingRows(retrieved)
+			case []*Flight:
+				o.copyMatchingRows(retrieved...)
+			case FlightSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Flight or a slice of Flight
+				// then run the AfterDeleteHooks on the slice
+				_, err = Flights.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o FlightSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals FlightSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Flights.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o FlightSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Flights.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o FlightSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Flights.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type flightJoins[Q dialect.Joinable] struct {
+	typ string
+	Jet func(context.Context) modAs[Q, jetColumns]
+}
+
+func (j flightJoins[Q]) aliasedAs(alias string) flightJoins[Q] {
+	return buildFlightJoins[Q](buildFlightColumns(alias), j.typ)
+}
+
+func buildFlightJoins[Q dialect.Joinable](cols flightColumns, typ string) flightJoins[Q] {
+	return flightJoins[Q]{
+		typ: typ,
+		Jet: flightsJoinJet[Q](cols, typ),
+	}
+}
+
+func flightsJoinJet[Q dialect.Joinable](from flightColumns, typ string) func(context.Context) modAs[Q, jetColumns] {
+	return func(ctx context.Context) modAs[Q, jetColumns] {
+		return modAs[Q, jetColumns]{
+			c: JetColumns,
+			f: func(to jetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Jets.Name().As(to.Alias())).On(
+						to.ID.EQ(from.JetID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Jet starts a query for related objects on jets
+func (o *Flight) Jet(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	return Jets.Query(append(mods,
+		sm.Where(JetColumns.ID.EQ(psql.Arg(o.JetID))),
+	)...)
+}
+
+func (os FlightSlice) Jet(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.JetID)
+	}
+
+	return Jets.Query(append(mods,
+		sm.Where(psql.Group(JetColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Flight) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Jet":
+		rel, ok := retrieved.(*Jet)
+		if !ok {
+			return fmt.Errorf("flight cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Jet = rel
+
+		if rel != nil {
+			rel.R.Flights = FlightSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("flight has no relationship %q", name)
+	}
+}
+
+func PreloadFlightJet(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Jet, JetSlice](orm.Relationship{
+		Name: "Jet",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Flights,
+				To:   TableNames.Jets,
+				FromColumns: []string{
+					ColumnNames.Flights.JetID,
+				},
+				ToColumns: []string{
+					ColumnNames.Jets.ID,
+				},
+			},
+		},
+	}, Jets.Columns().Names(), opts...)
+}
+
+func ThenLoadFlightJet(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadFlightJet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load FlightJet", retrieved)
+		}
+
+		err := loader.LoadFlightJet(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadFlightJet loads the flight's Jet into the .R struct
+func (o *Flight) LoadFlightJet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Jet = nil
+
+	related, err := o.Jet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Flights = FlightSlice{o}
+
+	o.R.Jet = related
+	return nil
+}
+
+// LoadFlightJet loads the flight's Jet into the .R struct
+func (os FlightSlice) LoadFlightJet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	jets, err := os.Jet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range jets {
+			if o.JetID != rel.ID {
+				continue
+			}
+
+			rel.R.Flights = append(rel.R.Flights, o)
+
+			o.R.Jet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachFlightJet0(ctx context.Context, exec bob.Executor, count int, flight0 *Flight, jet1 *Jet) (*Flight, error) {
+	setter := &FlightSetter{
+		JetID: omit.From(jet1.ID),
+	}
+
+	err := flight0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachFlightJet0: %w", err)
+	}
+
+	return flight0, nil
+}
+
+func (flight0 *Flight) InsertJet(ctx context.Context, exec bob.Executor, related *JetSetter) error {
+	jet1, err := Jets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachFlightJet0(ctx, exec, 1, flight0, jet1)
+	if err != nil {
+		return err
+	}
+
+	flight0.R.Jet = jet1
+
+	jet1.R.Flights = append(jet1.R.Flights, flight0)
+
+	return nil
+}
+
+func (flight0 *Flight) AttachJet(ctx context.Context, exec bob.Executor, jet1 *Jet) error {
+	var err error
+
+	_, err = attachFlightJet0(ctx, exec, 1, flight0, jet1)
+	if err != nil {
+		return err
+	}
+
+	flight0.R.Jet = jet1
+
+	jet1.R.Flights = append(jet1.R.Flights, flight0)
+
+	return nil
+}
@@ -0,0 +1,386 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// Hangar is an object representing the database table.
+type Hangar struct {
+	ID   int32  `db:"id,pk" `
+	Name string `db:"name" `
+}
+
+// HangarSlice is an alias for a slice of pointers to Hangar.
+// This should almost always be used instead of []*Hangar.
+type HangarSlice []*Hangar
+
+// Hangars contains methods to work with the hangars table
+var Hangars = psql.NewTablex[*Hangar, HangarSlice, *HangarSetter]("", "hangars")
+
+// HangarsQuery is a query on the hangars table
+type HangarsQuery = *psql.ViewQuery[*Hangar, HangarSlice]
+
+type hangarColumnNames struct {
+	ID   string
+	Name string
+}
+
+var HangarColumns = buildHangarColumns("hangars")
+
+type hangarColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	Name       psql.Expression
+}
+
+func (c hangarColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (hangarColumns) AliasedAs(alias string) hangarColumns {
+	return buildHangarColumns(alias)
+}
+
+func buildHangarColumns(alias string) hangarColumns {
+	return hangarColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Name:       psql.Quote(alias, "name"),
+	}
+}
+
+type hangarWhere[Q psql.Filterable] struct {
+	ID   psql.WhereMod[Q, int32]
+	Name psql.WhereMod[Q, string]
+}
+
+func (hangarWhere[Q]) AliasedAs(alias string) hangarWhere[Q] {
+	return buildHangarWhere[Q](buildHangarColumns(alias))
+}
+
+func buildHangarWhere[Q psql.Filterable](cols hangarColumns) hangarWhere[Q] {
+	return hangarWhere[Q]{
+		ID:   psql.Where[Q, int32](cols.ID),
+		Name: psql.Where[Q, string](cols.Name),
+	}
+}
+
+var HangarErrors = &hangarErrors{
+	ErrUniqueHangarsPkey: &UniqueConstraintError{s: "hangars_pkey"},
+}
+
+type hangarErrors struct {
+	ErrUniqueHangarsPkey *UniqueConstraintError
+}
+
+// HangarSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type HangarSetter struct {
+	ID   omit.Val[int32]  `db:"id,pk" `
+	Name omit.Val[string] `db:"name" `
+}
+
+func (s HangarSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.Name.IsUnset() {
+		vals = append(vals, "name")
+	}
+
+	return vals
+}
+
+func (s HangarSetter) Overwrite(t *Hangar) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.Name.IsUnset() {
+		t.Name, _ = s.Name.Get()
+	}
+}
+
+func (s *HangarSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Hangars.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.Name.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.Name)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s HangarSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s HangarSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.Name.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	return exprs
+}
+
+// FindHangar retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindHangar(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Hangar, error) {
+	if len(cols) == 0 {
+		return Hangars.Query(
+			SelectWhere.Hangars.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Hangars.Query(
+		SelectWhere.Hangars.ID.EQ(IDPK),
+		sm.Columns(Hangars.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// HangarExists checks the presence of a single record by primary key
+func HangarExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Hangars.Query(
+		SelectWhere.Hangars.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Hangar is retrieved from the database
+func (o *Hangar) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Hangars.AfterSelectHooks.RunHooks(ctx, exec, HangarSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Hangars.AfterInsertHooks.RunHooks(ctx, exec, HangarSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Hangars.AfterUpdateHooks.RunHooks(ctx, exec, HangarSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Hangars.AfterDeleteHooks.RunHooks(ctx, exec, HangarSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Hangar
+func (o *Hangar) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Hangar) pkEQ() dialect.Expression {
+	return psql.Quote("hangars", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Hangar
+func (o *Hangar) Update(ctx context.Context, exec bob.Executor, s *HangarSetter) error {
+	v, err := Hangars.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Hangar record with an executor
+func (o *Hangar) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Hangars.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Hangar using the executor
+func (o *Hangar) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Hangars.Query(
+		SelectWhere.Hangars.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after HangarSlice is retrieved from the database
+func (o HangarSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Hangars.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Hangars.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Hangars.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Hangars.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o HangarSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("hangars", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o HangarSlice) copyMatchingRows(from ...*Hangar) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o HangarSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Hangars.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Hangar:
+				o.copyMatchingRows(retrieved)
+			case []*Hangar:
+				o.copyMatchingRows(retrieved...)
+			case HangarSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Hangar or a slice of Hangar
+				// then run the AfterUpdateHooks on the slice
+				_, err = Hangars.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o HangarSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Hangars.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Hangar:
+				o.copyMatchingRows(retrieved)
+			case []*Hangar:
+				o.copyMatchingRows(retrieved...)
+			case HangarSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Hangar or a slice of Hangar
+				// then run the AfterDeleteHooks on the slice
+				_, err = Hangars.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o HangarSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals HangarSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Hangars.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o HangarSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Hangars.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o HangarSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Hangars.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
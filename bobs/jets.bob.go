@@ -0,0 +1,1174 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Jet is an object representing the database table.
+type Jet struct {
+	ID         int32            `db:"id,pk" `
+	PilotID    int32            `db:"pilot_id" `
+	AirportID  int32            `db:"airport_id" `
+	Name       string           `db:"name" `
+	Color      null.Val[string] `db:"color" `
+	UUID       string           `db:"uuid" `
+	Identifier string           `db:"identifier" `
+	Cargo      []byte           `db:"cargo" `
+	Manifest   []byte           `db:"manifest" `
+
+	R jetR `db:"-" `
+}
+
+// JetSlice is an alias for a slice of pointers to Jet.
+// This should almost always be used instead of []*Jet.
+type JetSlice []*Jet
+
+// Jets contains methods to work with the jets table
+var Jets = psql.NewTablex[*Jet, JetSlice, *JetSetter]("", "jets")
+
+// JetsQuery is a query on the jets table
+type JetsQuery = *psql.ViewQuery[*Jet, JetSlice]
+
+// jetR is where relationships are stored.
+type jetR struct {
+	Flights FlightSlice // flights.flights_jet_id_jets_id_foreign
+	Airport *Airport    // jets.jets_airport_id_airports_id_foreign
+	Pilot   *Pilot      // jets.jets_pilot_id_pilots_id_foreign
+}
+
+type jetColumnNames struct {
+	ID         string
+	PilotID    string
+	AirportID  string
+	Name       string
+	Color      string
+	UUID       string
+	Identifier string
+	Cargo      string
+	Manifest   string
+}
+
+var JetColumns = buildJetColumns("jets")
+
+type jetColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	PilotID    psql.Expression
+	AirportID  psql.Expression
+	Name       psql.Expression
+	Color      psql.Expression
+	UUID       psql.Expression
+	Identifier psql.Expression
+	Cargo      psql.Expression
+	Manifest   psql.Expression
+}
+
+func (c jetColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (jetColumns) AliasedAs(alias string) jetColumns {
+	return buildJetColumns(alias)
+}
+
+func buildJetColumns(alias string) jetColumns {
+	return jetColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		PilotID:    psql.Quote(alias, "pilot_id"),
+		AirportID:  psql.Quote(alias, "airport_id"),
+		Name:       psql.Quote(alias, "name"),
+		Color:      psql.Quote(alias, "color"),
+		UUID:       psql.Quote(alias, "uuid"),
+		Identifier: psql.Quote(alias, "identifier"),
+		Cargo:      psql.Quote(alias, "cargo"),
+		Manifest:   psql.Quote(alias, "manifest"),
+	}
+}
+
+type jetWhere[Q psql.Filterable] struct {
+	ID         psql.WhereMod[Q, int32]
+	PilotID    psql.WhereMod[Q, int32]
+	AirportID  psql.WhereMod[Q, int32]
+	Name       psql.WhereMod[Q, string]
+	Color      psql.WhereNullMod[Q, string]
+	UUID       psql.WhereMod[Q, string]
+	Identifier psql.WhereMod[Q, string]
+	Cargo      psql.WhereMod[Q, []byte]
+	Manifest   psql.WhereMod[Q, []byte]
+}
+
+func (jetWhere[Q]) AliasedAs(alias string) jetWhere[Q] {
+	return buildJetWhere[Q](buildJetColumns(alias))
+}
+
+func buildJetWhere[Q psql.Filterable](cols jetColumns) jetWhere[Q] {
+	return jetWhere[Q]{
+		ID:         psql.Where[Q, int32](cols.ID),
+		PilotID:    psql.Where[Q, int32](cols.PilotID),
+		AirportID:  psql.Where[Q, int32](cols.AirportID),
+		Name:       psql.Where[Q, string](cols.Name),
+		Color:      psql.WhereNull[Q, string](cols.Color),
+		UUID:       psql.Where[Q, string](cols.UUID),
+		Identifier: psql.Where[Q, string](cols.Identifier),
+		Cargo:      psql.Where[Q, []byte](cols.Cargo),
+		Manifest:   psql.Where[Q, []byte](cols.Manifest),
+	}
+}
+
+var JetErrors = &jetErrors{
+	ErrUniqueJetsPkey: &UniqueConstraintError{s: "jets_pkey"},
+}
+
+type jetErrors struct {
+	ErrUniqueJetsPkey *UniqueConstraintError
+}
+
+// JetSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type JetSetter struct {
+	ID         omit.Val[int32]      `db:"id,pk" `
+	PilotID    omit.Val[int32]      `db:"pilot_id" `
+	AirportID  omit.Val[int32]      `db:"airport_id" `
+	Name       omit.Val[string]     `db:"name" `
+	Color      omitnull.Val[string] `db:"color" `
+	UUID       omit.Val[string]     `db:"uuid" `
+	Identifier omit.Val[string]     `db:"identifier" `
+	Cargo      omit.Val[[]byte]     `db:"cargo" `
+	Manifest   omit.Val[[]byte]     `db:"manifest" `
+}
+
+func (s JetSetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.AirportID.IsUnset() {
+		vals = append(vals, "airport_id")
+	}
+
+	if !s.Name.IsUnset() {
+		vals = append(vals, "name")
+	}
+
+	if !s.Color.IsUnset() {
+		vals = append(vals, "color")
+	}
+
+	if !s.UUID.IsUnset() {
+		vals = append(vals, "uuid")
+	}
+
+	if !s.Identifier.IsUnset() {
+		vals = append(vals, "identifier")
+	}
+
+	if !s.Cargo.IsUnset() {
+		vals = append(vals, "cargo")
+	}
+
+	if !s.Manifest.IsUnset() {
+		vals = append(vals, "manifest")
+	}
+
+	return vals
+}
+
+func (s JetSetter) Overwrite(t *Jet) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.AirportID.IsUnset() {
+		t.AirportID, _ = s.AirportID.Get()
+	}
+	if !s.Name.IsUnset() {
+		t.Name, _ = s.Name.Get()
+	}
+	if !s.Color.IsUnset() {
+		t.Color, _ = s.Color.GetNull()
+	}
+	if !s.UUID.IsUnset() {
+		t.UUID, _ = s.UUID.Get()
+	}
+	if !s.Identifier.IsUnset() {
+		t.Identifier, _ = s.Identifier.Get()
+	}
+	if !s.Cargo.IsUnset() {
+		t.Cargo, _ = s.Cargo.Get()
+	}
+	if !s.Manifest.IsUnset() {
+		t.Manifest, _ = s.Manifest.Get()
+	}
+}
+
+func (s *JetSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Jets.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 9)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		if s.AirportID.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.AirportID)
+		}
+
+		if s.Name.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Name)
+		}
+
+		if s.Color.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.Color)
+		}
+
+		if s.UUID.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.UUID)
+		}
+
+		if s.Identifier.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Identifier)
+		}
+
+		if s.Cargo.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Cargo)
+		}
+
+		if s.Manifest.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Manifest)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s JetSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s JetSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.AirportID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "airport_id")...),
+			psql.Arg(s.AirportID),
+		}})
+	}
+
+	if !s.Name.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if !s.Color.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "color")...),
+			psql.Arg(s.Color),
+		}})
+	}
+
+	if !s.UUID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "uuid")...),
+			psql.Arg(s.UUID),
+		}})
+	}
+
+	if !s.Identifier.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "identifier")...),
+			psql.Arg(s.Identifier),
+		}})
+	}
+
+	if !s.Cargo.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "cargo")...),
+			psql.Arg(s.Cargo),
+		}})
+	}
+
+	if !s.Manifest.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "manifest")...),
+			psql.Arg(s.Manifest),
+		}})
+	}
+
+	return exprs
+}
+
+// FindJet retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindJet(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Jet, error) {
+	if len(cols) == 0 {
+		return Jets.Query(
+			SelectWhere.Jets.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Jets.Query(
+		SelectWhere.Jets.ID.EQ(IDPK),
+		sm.Columns(Jets.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// JetExists checks the presence of a single record by primary key
+func JetExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Jets.Query(
+		SelectWhere.Jets.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Jet is retrieved from the database
+func (o *Jet) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Jets.AfterSelectHooks.RunHooks(ctx, exec, JetSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Jets.AfterInsertHooks.RunHooks(ctx, exec, JetSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Jets.AfterUpdateHooks.RunHooks(ctx, exec, JetSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Jets.AfterDeleteHooks.RunHooks(ctx, exec, JetSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Jet
+func (o *Jet) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Jet) pkEQ() dialect.Expression {
+	return psql.Quote("jets", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Jet
+func (o *Jet) Update(ctx context.Context, exec bob.Executor, s *JetSetter) error {
+	v, err := Jets.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Jet record with an executor
+func (o *Jet) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Jets.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Jet using the executor
+func (o *Jet) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Jets.Query(
+		SelectWhere.Jets.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after JetSlice is retrieved from the database
+func (o JetSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Jets.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Jets.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Jets.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Jets.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o JetSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("jets", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o JetSlice) copyMatchingRows(from ...*Jet) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o JetSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Jets.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Jet:
+				o.copyMatchingRows(retrieved)
+			case []*Jet:
+				o.copyMatchingRows(retrieved...)
+			case JetSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Jet or a slice of Jet
+				// then run the AfterUpdateHooks on the slice
+				_, err = Jets.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o JetSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Jets.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Jet:
+				o.copyMatchingRows(retrieved)
+			case []*Jet:
+				o.copyMatchingRows(retrieved...)
+			case JetSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Jet or a slice of Jet
+				// then run the AfterDeleteHooks on the slice
+				_, err = Jets.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o JetSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals JetSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Jets.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o JetSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Jets.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o JetSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Jets.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type jetJoins[Q dialect.Joinable] struct {
+	typ     string
+	Flights func(context.Context) modAs[Q, flightColumns]
+	Airport func(context.Context) modAs[Q, airportColumns]
+	Pilot   func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j jetJoins[Q]) aliasedAs(alias string) jetJoins[Q] {
+	return buildJetJoins[Q](buildJetColumns(alias), j.typ)
+}
+
+func buildJetJoins[Q dialect.Joinable](cols jetColumns, typ string) jetJoins[Q] {
+	return jetJoins[Q]{
+		typ:     typ,
+		Flights: jetsJoinFlights[Q](cols, typ),
+		Airport: jetsJoinAirport[Q](cols, typ),
+		Pilot:   jetsJoinPilot[Q](cols, typ),
+	}
+}
+
+func jetsJoinFlights[Q dialect.Joinable](from jetColumns, typ string) func(context.Context) modAs[Q, flightColumns] {
+	return func(ctx context.Context) modAs[Q, flightColumns] {
+		return modAs[Q, flightColumns]{
+			c: FlightColumns,
+			f: func(to flightColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Flights.Name().As(to.Alias())).On(
+						to.JetID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func jetsJoinAirport[Q dialect.Joinable](from jetColumns, typ string) func(context.Context) modAs[Q, airportColumns] {
+	return func(ctx context.Context) modAs[Q, airportColumns] {
+		return modAs[Q, airportColumns]{
+			c: AirportColumns,
+			f: func(to airportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Airports.Name().As(to.Alias())).On(
+						to.ID.EQ(from.AirportID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func jetsJoinPilot[Q dialect.Joinable](from jetColumns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Flights starts a query for related objects on flights
+func (o *Jet) Flights(mods ...bob.Mod[*dialect.SelectQuery]) FlightsQuery {
+	return Flights.Query(append(mods,
+		sm.Where(FlightColumns.JetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os JetSlice) Flights(mods ...bob.Mod[*dialect.SelectQuery]) FlightsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Flights.Query(append(mods,
+		sm.Where(psql.Group(FlightColumns.JetID).In(PKArgs...)),
+	)...)
+}
+
+// Airport starts a query for related objects on airports
+func (o *Jet) Airport(mods ...bob.Mod[*dialect.SelectQuery]) AirportsQuery {
+	return Airports.Query(append(mods,
+		sm.Where(AirportColumns.ID.EQ(psql.Arg(o.AirportID))),
+	)...)
+}
+
+func (os JetSlice) Airport(mods ...bob.Mod[*dialect.SelectQuery]) AirportsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.AirportID)
+	}
+
+	return Airports.Query(append(mods,
+		sm.Where(psql.Group(AirportColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *Jet) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os JetSlice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Jet) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Flights":
+		rels, ok := retrieved.(FlightSlice)
+		if !ok {
+			return fmt.Errorf("jet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Flights = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Jet = o
+			}
+		}
+		return nil
+	case "Airport":
+		rel, ok := retrieved.(*Airport)
+		if !ok {
+			return fmt.Errorf("jet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Airport = rel
+
+		if rel != nil {
+			rel.R.Jets = JetSlice{o}
+		}
+		return nil
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("jet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Jets = JetSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("jet has no relationship %q", name)
+	}
+}
+
+func ThenLoadJetFlights(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadJetFlights(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load JetFlights", retrieved)
+		}
+
+		err := loader.LoadJetFlights(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadJetFlights loads the jet's Flights into the .R struct
+func (o *Jet) LoadJetFlights(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Flights = nil
+
+	related, err := o.Flights(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Jet = o
+	}
+
+	o.R.Flights = related
+	return nil
+}
+
+// LoadJetFlights loads the jet's Flights into the .R struct
+func (os JetSlice) LoadJetFlights(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	flights, err := os.Flights(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Flights = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range flights {
+			if o.ID != rel.JetID {
+				continue
+			}
+
+			rel.R.Jet = o
+
+			o.R.Flights = append(o.R.Flights, rel)
+		}
+	}
+
+	return nil
+}
+
+func PreloadJetAirport(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Airport, AirportSlice](orm.Relationship{
+		Name: "Airport",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Jets,
+				To:   TableNames.Airports,
+				FromColumns: []string{
+					ColumnNames.Jets.AirportID,
+				},
+				ToColumns: []string{
+					ColumnNames.Airports.ID,
+				},
+			},
+		},
+	}, Airports.Columns().Names(), opts...)
+}
+
+func ThenLoadJetAirport(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadJetAirport(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load JetAirport", retrieved)
+		}
+
+		err := loader.LoadJetAirport(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadJetAirport loads the jet's Airport into the .R struct
+func (o *Jet) LoadJetAirport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Airport = nil
+
+	related, err := o.Airport(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Jets = JetSlice{o}
+
+	o.R.Airport = related
+	return nil
+}
+
+// LoadJetAirport loads the jet's Airport into the .R struct
+func (os JetSlice) LoadJetAirport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	airports, err := os.Airport(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range airports {
+			if o.AirportID != rel.ID {
+				continue
+			}
+
+			rel.R.Jets = append(rel.R.Jets, o)
+
+			o.R.Airport = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func PreloadJetPilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Jets,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Jets.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadJetPilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadJetPilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load JetPilot", retrieved)
+		}
+
+		err := loader.LoadJetPilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadJetPilot loads the jet's Pilot into the .R struct
+func (o *Jet) LoadJetPilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Jets = JetSlice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadJetPilot loads the jet's Pilot into the .R struct
+func (os JetSlice) LoadJetPilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			rel.R.Jets = append(rel.R.Jets, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func insertJetFlights0(ctx context.Context, exec bob.Executor, flights1 []*FlightSetter, jet0 *Jet) (FlightSlice, error) {
+	for i := range flights1 {
+		flights1[i].JetID = omit.From(jet0.ID)
+	}
+
+	ret, err := Flights.Insert(bob.ToMods(flights1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertJetFlights0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachJetFlights0(ctx context.Context, exec bob.Executor, count int, flights1 FlightSlice, jet0 *Jet) (FlightSlice, error) {
+	setter := &FlightSetter{
+		JetID: omit.From(jet0.ID),
+	}
+
+	err := flights1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachJetFlights0: %w", err)
+	}
+
+	return flights1, nil
+}
+
+func (jet0 *Jet) InsertFlights(ctx context.Context, exec bob.Executor, related ...*FlightSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	flights1, err := insertJetFlights0(ctx, exec, related, jet0)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Flights = append(jet0.R.Flights, flights1...)
+
+	for _, rel := range flights1 {
+		rel.R.Jet = jet0
+	}
+	return nil
+}
+
+func (jet0 *Jet) AttachFlights(ctx context.Context, exec bob.Executor, related ...*Flight) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	flights1 := FlightSlice(related)
+
+	_, err = attachJetFlights0(ctx, exec, len(related), flights1, jet0)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Flights = append(jet0.R.Flights, flights1...)
+
+	for _, rel := range related {
+		rel.R.Jet = jet0
+	}
+
+	return nil
+}
+
+func attachJetAirport0(ctx context.Context, exec bob.Executor, count int, jet0 *Jet, airport1 *Airport) (*Jet, error) {
+	setter := &JetSetter{
+		AirportID: omit.From(airport1.ID),
+	}
+
+	err := jet0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachJetAirport0: %w", err)
+	}
+
+	return jet0, nil
+}
+
+func (jet0 *Jet) InsertAirport(ctx context.Context, exec bob.Executor, related *AirportSetter) error {
+	airport1, err := Airports.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachJetAirport0(ctx, exec, 1, jet0, airport1)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Airport = airport1
+
+	airport1.R.Jets = append(airport1.R.Jets, jet0)
+
+	return nil
+}
+
+func (jet0 *Jet) AttachAirport(ctx context.Context, exec bob.Executor, airport1 *Airport) error {
+	var err error
+
+	_, err = attachJetAirport0(ctx, exec, 1, jet0, airport1)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Airport = airport1
+
+	airport1.R.Jets = append(airport1.R.Jets, jet0)
+
+	return nil
+}
+
+func attachJetPilot0(ctx context.Context, exec bob.Executor, count int, jet0 *Jet, pilot1 *Pilot) (*Jet, error) {
+	setter := &JetSetter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := jet0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachJetPilot0: %w", err)
+	}
+
+	return jet0, nil
+}
+
+func (jet0 *Jet) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachJetPilot0(ctx, exec, 1, jet0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Pilot = pilot1
+
+	pilot1.R.Jets = append(pilot1.R.Jets, jet0)
+
+	return nil
+}
+
+func (jet0 *Jet) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachJetPilot0(ctx, exec, 1, jet0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	jet0.R.Pilot = pilot1
+
+	pilot1.R.Jets = append(pilot1.R.Jets, jet0)
+
+	return nil
+}
@@ -0,0 +1,657 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/scan"
+)
+
+// Language is an object representing the database table.
+type Language struct {
+	ID       int32  `db:"id,pk" `
+	Language string `db:"language" `
+
+	R languageR `db:"-" `
+}
+
+// LanguageSlice is an alias for a slice of pointers to Language.
+// This should almost always be used instead of []*Language.
+type LanguageSlice []*Language
+
+// Languages contains methods to work with the languages table
+var Languages = psql.NewTablex[*Language, LanguageSlice, *LanguageSetter]("", "languages")
+
+// LanguagesQuery is a query on the languages table
+type LanguagesQuery = *psql.ViewQuery[*Language, LanguageSlice]
+
+// languageR is where relationships are stored.
+type languageR struct {
+	Pilots PilotSlice // pilot_languages.languages_fkeypilot_languages.pilots_fkey
+}
+
+type languageColumnNames struct {
+	ID       string
+	Language string
+}
+
+var LanguageColumns = buildLanguageColumns("languages")
+
+type languageColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	Language   psql.Expression
+}
+
+func (c languageColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (languageColumns) AliasedAs(alias string) languageColumns {
+	return buildLanguageColumns(alias)
+}
+
+func buildLanguageColumns(alias string) languageColumns {
+	return languageColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Language:   psql.Quote(alias, "language"),
+	}
+}
+
+type languageWhere[Q psql.Filterable] struct {
+	ID       psql.WhereMod[Q, int32]
+	Language psql.WhereMod[Q, string]
+}
+
+func (languageWhere[Q]) AliasedAs(alias string) languageWhere[Q] {
+	return buildLanguageWhere[Q](buildLanguageColumns(alias))
+}
+
+func buildLanguageWhere[Q psql.Filterable](cols languageColumns) languageWhere[Q] {
+	return languageWhere[Q]{
+		ID:       psql.Where[Q, int32](cols.ID),
+		Language: psql.Where[Q, string](cols.Language),
+	}
+}
+
+var LanguageErrors = &languageErrors{
+	ErrUniqueLanguagesPkey: &UniqueConstraintError{s: "languages_pkey"},
+}
+
+type languageErrors struct {
+	ErrUniqueLanguagesPkey *UniqueConstraintError
+}
+
+// LanguageSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LanguageSetter struct {
+	ID       omit.Val[int32]  `db:"id,pk" `
+	Language omit.Val[string] `db:"language" `
+}
+
+func (s LanguageSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.Language.IsUnset() {
+		vals = append(vals, "language")
+	}
+
+	return vals
+}
+
+func (s LanguageSetter) Overwrite(t *Language) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.Language.IsUnset() {
+		t.Language, _ = s.Language.Get()
+	}
+}
+
+func (s *LanguageSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Languages.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.Language.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.Language)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LanguageSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LanguageSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.Language.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "language")...),
+			psql.Arg(s.Language),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLanguage retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLanguage(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Language, error) {
+	if len(cols) == 0 {
+		return Languages.Query(
+			SelectWhere.Languages.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Languages.Query(
+		SelectWhere.Languages.ID.EQ(IDPK),
+		sm.Columns(Languages.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LanguageExists checks the presence of a single record by primary key
+func LanguageExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Languages.Query(
+		SelectWhere.Languages.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Language is retrieved from the database
+func (o *Language) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Languages.AfterSelectHooks.RunHooks(ctx, exec, LanguageSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Languages.AfterInsertHooks.RunHooks(ctx, exec, LanguageSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Languages.AfterUpdateHooks.RunHooks(ctx, exec, LanguageSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Languages.AfterDeleteHooks.RunHooks(ctx, exec, LanguageSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Language
+func (o *Language) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Language) pkEQ() dialect.Expression {
+	return psql.Quote("languages", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Language
+func (o *Language) Update(ctx context.Context, exec bob.Executor, s *LanguageSetter) error {
+	v, err := Languages.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Language record with an executor
+func (o *Language) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Languages.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Language using the executor
+func (o *Language) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Languages.Query(
+		SelectWhere.Languages.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LanguageSlice is retrieved from the database
+func (o LanguageSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Languages.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Languages.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Languages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Languages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LanguageSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("languages", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LanguageSlice) copyMatchingRows(from ...*Language) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LanguageSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Languages.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Language:
+				o.copyMatchingRows(retrieved)
+			case []*Language:
+				o.copyMatchingRows(retrieved...)
+			case LanguageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Language or a slice of Language
+				// then run the AfterUpdateHooks on the slice
+				_, err = Languages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LanguageSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Languages.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Language:
+				o.copyMatchingRows(retrieved)
+			case []*Language:
+				o.copyMatchingRows(retrieved...)
+			case LanguageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Language or a slice of Language
+				// then run the AfterDeleteHooks on the slice
+				_, err = Languages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LanguageSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LanguageSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Languages.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LanguageSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Languages.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LanguageSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Languages.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type languageJoins[Q dialect.Joinable] struct {
+	typ    string
+	Pilots func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j languageJoins[Q]) aliasedAs(alias string) languageJoins[Q] {
+	return buildLanguageJoins[Q](buildLanguageColumns(alias), j.typ)
+}
+
+func buildLanguageJoins[Q dialect.Joinable](cols languageColumns, typ string) languageJoins[Q] {
+	return languageJoins[Q]{
+		typ:    typ,
+		Pilots: languagesJoinPilots[Q](cols, typ),
+	}
+}
+
+func languagesJoinPilots[Q dialect.Joinable](from languageColumns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := PilotLanguageColumns.AliasedAs(PilotLanguageColumns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, PilotLanguages.Name().As(to.Alias())).On(
+						to.LanguageID.EQ(from.ID),
+					))
+				}
+				{
+					from := PilotLanguageColumns.AliasedAs(PilotLanguageColumns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilots starts a query for related objects on pilots
+func (o *Language) Pilots(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.InnerJoin(PilotLanguages.NameAs()).On(
+			PilotColumns.ID.EQ(PilotLanguageColumns.PilotID)),
+		sm.Where(PilotLanguageColumns.LanguageID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os LanguageSlice) Pilots(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.InnerJoin(PilotLanguages.NameAs()).On(
+			PilotColumns.ID.EQ(PilotLanguageColumns.PilotID),
+		),
+		sm.Where(psql.Group(PilotLanguageColumns.LanguageID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Language) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilots":
+		rels, ok := retrieved.(PilotSlice)
+		if !ok {
+			return fmt.Errorf("language cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilots = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Languages = LanguageSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("language has no relationship %q", name)
+	}
+}
+
+func ThenLoadLanguagePilots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadLanguagePilots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load LanguagePilots", retrieved)
+		}
+
+		err := loader.LoadLanguagePilots(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadLanguagePilots loads the language's Pilots into the .R struct
+func (o *Language) LoadLanguagePilots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilots = nil
+
+	related, err := o.Pilots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Languages = LanguageSlice{o}
+	}
+
+	o.R.Pilots = related
+	return nil
+}
+
+// LoadLanguagePilots loads the language's Pilots into the .R struct
+func (os LanguageSlice) LoadLanguagePilots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Pilots.Columns()))
+	}
+
+	q := os.Pilots(append(
+		mods,
+		sm.Columns(PilotLanguageColumns.LanguageID.As("related_languages.ID")),
+	)...)
+
+	IDSlice := []int32{}
+
+	mapper := scan.Mod(scan.StructMapper[*Pilot](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int32))
+				row.ScheduleScan("related_languages.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	pilots, err := bob.Allx[*Pilot, PilotSlice](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Pilots = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range pilots {
+			if o.ID != IDSlice[i] {
+				continue
+			}
+
+			rel.R.Languages = append(rel.R.Languages, o)
+
+			o.R.Pilots = append(o.R.Pilots, rel)
+		}
+	}
+
+	return nil
+}
+
+func attachLanguagePilots0(ctx context.Context, exec bob.Executor, count int, language0 *Language, pilots2 PilotSlice) (PilotLanguageSlice, error) {
+	setters := make([]*PilotLanguageSetter, count)
+	for i := 0; i < count; i++ {
+		setters[i] = &PilotLanguageSetter{
+			LanguageID: omit.From(language0.ID),
+			PilotID:    omit.From(pilots2[i].ID),
+		}
+	}
+
+	pilotLanguages1, err := PilotLanguages.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachLanguagePilots0: %w", err)
+	}
+
+	return pilotLanguages1, nil
+}
+
+func (language0 *Language) InsertPilots(ctx context.Context, exec bob.Executor, related ...*PilotSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Pilots.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	pilots2 := PilotSlice(inserted)
+
+	_, err = attachLanguagePilots0(ctx, exec, len(related), language0, pilots2)
+	if err != nil {
+		return err
+	}
+
+	language0.R.Pilots = append(language0.R.Pilots, pilots2...)
+
+	for _, rel := range pilots2 {
+		rel.R.Languages = append(rel.R.Languages, language0)
+	}
+	return nil
+}
+
+func (language0 *Language) AttachPilots(ctx context.Context, exec bob.Executor, related ...*Pilot) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	pilots2 := PilotSlice(related)
+
+	_, err = attachLanguagePilots0(ctx, exec, len(related), language0, pilots2)
+	if err != nil {
+		return err
+	}
+
+	language0.R.Pilots = append(language0.R.Pilots, pilots2...)
+
+	for _, rel := range related {
+		rel.R.Languages = append(rel.R.Languages, language0)
+	}
+
+	return nil
+}
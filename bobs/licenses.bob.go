@@ -0,0 +1,608 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// License is an object representing the database table.
+type License struct {
+	ID      int32           `db:"id,pk" `
+	PilotID null.Val[int32] `db:"pilot_id" `
+
+	R licenseR `db:"-" `
+}
+
+// LicenseSlice is an alias for a slice of pointers to License.
+// This should almost always be used instead of []*License.
+type LicenseSlice []*License
+
+// Licenses contains methods to work with the licenses table
+var Licenses = psql.NewTablex[*License, LicenseSlice, *LicenseSetter]("", "licenses")
+
+// LicensesQuery is a query on the licenses table
+type LicensesQuery = *psql.ViewQuery[*License, LicenseSlice]
+
+// licenseR is where relationships are stored.
+type licenseR struct {
+	Pilot *Pilot // licenses.licenses_pilot_id_pilots_id_foreign
+}
+
+type licenseColumnNames struct {
+	ID      string
+	PilotID string
+}
+
+var LicenseColumns = buildLicenseColumns("licenses")
+
+type licenseColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	PilotID    psql.Expression
+}
+
+func (c licenseColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (licenseColumns) AliasedAs(alias string) licenseColumns {
+	return buildLicenseColumns(alias)
+}
+
+func buildLicenseColumns(alias string) licenseColumns {
+	return licenseColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		PilotID:    psql.Quote(alias, "pilot_id"),
+	}
+}
+
+type licenseWhere[Q psql.Filterable] struct {
+	ID      psql.WhereMod[Q, int32]
+	PilotID psql.WhereNullMod[Q, int32]
+}
+
+func (licenseWhere[Q]) AliasedAs(alias string) licenseWhere[Q] {
+	return buildLicenseWhere[Q](buildLicenseColumns(alias))
+}
+
+func buildLicenseWhere[Q psql.Filterable](cols licenseColumns) licenseWhere[Q] {
+	return licenseWhere[Q]{
+		ID:      psql.Where[Q, int32](cols.ID),
+		PilotID: psql.WhereNull[Q, int32](cols.PilotID),
+	}
+}
+
+var LicenseErrors = &licenseErrors{
+	ErrUniqueLicensesPkey: &UniqueConstraintError{s: "licenses_pkey"},
+}
+
+type licenseErrors struct {
+	ErrUniqueLicensesPkey *UniqueConstraintError
+}
+
+// LicenseSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LicenseSetter struct {
+	ID      omit.Val[int32]     `db:"id,pk" `
+	PilotID omitnull.Val[int32] `db:"pilot_id" `
+}
+
+func (s LicenseSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	return vals
+}
+
+func (s LicenseSetter) Overwrite(t *License) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.GetNull()
+	}
+}
+
+func (s *LicenseSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Licenses.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LicenseSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LicenseSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLicense retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLicense(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*License, error) {
+	if len(cols) == 0 {
+		return Licenses.Query(
+			SelectWhere.Licenses.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Licenses.Query(
+		SelectWhere.Licenses.ID.EQ(IDPK),
+		sm.Columns(Licenses.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LicenseExists checks the presence of a single record by primary key
+func LicenseExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Licenses.Query(
+		SelectWhere.Licenses.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after License is retrieved from the database
+func (o *License) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Licenses.AfterSelectHooks.RunHooks(ctx, exec, LicenseSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Licenses.AfterInsertHooks.RunHooks(ctx, exec, LicenseSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Licenses.AfterUpdateHooks.RunHooks(ctx, exec, LicenseSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Licenses.AfterDeleteHooks.RunHooks(ctx, exec, LicenseSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the License
+func (o *License) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *License) pkEQ() dialect.Expression {
+	return psql.Quote("licenses", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the License
+func (o *License) Update(ctx context.Context, exec bob.Executor, s *LicenseSetter) error {
+	v, err := Licenses.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single License record with an executor
+func (o *License) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Licenses.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the License using the executor
+func (o *License) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Licenses.Query(
+		SelectWhere.Licenses.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LicenseSlice is retrieved from the database
+func (o LicenseSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Licenses.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Licenses.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Licenses.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Licenses.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LicenseSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("licenses", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LicenseSlice) copyMatchingRows(from ...*License) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LicenseSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Licenses.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *License:
+				o.copyMatchingRows(retrieved)
+			case []*License:
+				o.copyMatchingRows(retrieved...)
+			case LicenseSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a License or a slice of License
+				// then run the AfterUpdateHooks on the slice
+				_, err = Licenses.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LicenseSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Licenses.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *License:
+				o.copyMatchingRows(retrieved)
+			case []*License:
+				o.copyMatchingRows(retrieved...)
+			case LicenseSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a License or a slice of License
+				// then run the AfterDeleteHooks on the slice
+				_, err = Licenses.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LicenseSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LicenseSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Licenses.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LicenseSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Licenses.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LicenseSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Licenses.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type licenseJoins[Q dialect.Joinable] struct {
+	typ   string
+	Pilot func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j licenseJoins[Q]) aliasedAs(alias string) licenseJoins[Q] {
+	return buildLicenseJoins[Q](buildLicenseColumns(alias), j.typ)
+}
+
+func buildLicenseJoins[Q dialect.Joinable](cols licenseColumns, typ string) licenseJoins[Q] {
+	return licenseJoins[Q]{
+		typ:   typ,
+		Pilot: licensesJoinPilot[Q](cols, typ),
+	}
+}
+
+func licensesJoinPilot[Q dialect.Joinable](from licenseColumns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *License) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os LicenseSlice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *License) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("license cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Licenses = LicenseSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("license has no relationship %q", name)
+	}
+}
+
+func PreloadLicensePilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Licenses,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Licenses.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadLicensePilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadLicensePilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load LicensePilot", retrieved)
+		}
+
+		err := loader.LoadLicensePilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadLicensePilot loads the license's Pilot into the .R struct
+func (o *License) LoadLicensePilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Licenses = LicenseSlice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadLicensePilot loads the license's Pilot into the .R struct
+func (os LicenseSlice) LoadLicensePilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID.GetOrZero() != rel.ID {
+				continue
+			}
+
+			rel.R.Licenses = append(rel.R.Licenses, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachLicensePilot0(ctx context.Context, exec bob.Executor, count int, license0 *License, pilot1 *Pilot) (*License, error) {
+	setter := &LicenseSetter{
+		PilotID: omitnull.From(pilot1.ID),
+	}
+
+	err := license0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachLicensePilot0: %w", err)
+	}
+
+	return license0, nil
+}
+
+func (license0 *License) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachLicensePilot0(ctx, exec, 1, license0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	license0.R.Pilot = pilot1
+
+	pilot1.R.Licenses = append(pilot1.R.Licenses, license0)
+
+	return nil
+}
+
+func (license0 *License) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachLicensePilot0(ctx, exec, 1, license0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	license0.R.Pilot = pilot1
+
+	pilot1.R.Licenses = append(pilot1.R.Licenses, license0)
+
+	return nil
+}
@@ -0,0 +1,777 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// PilotLanguage is an object representing the database table.
+type PilotLanguage struct {
+	PilotID    int32 `db:"pilot_id,pk" `
+	LanguageID int32 `db:"language_id,pk" `
+
+	R pilotLanguageR `db:"-" `
+}
+
+// PilotLanguageSlice is an alias for a slice of pointers to PilotLanguage.
+// This should almost always be used instead of []*PilotLanguage.
+type PilotLanguageSlice []*PilotLanguage
+
+// PilotLanguages contains methods to work with the pilot_languages table
+var PilotLanguages = psql.NewTablex[*PilotLanguage, PilotLanguageSlice, *PilotLanguageSetter]("", "pilot_languages")
+
+// PilotLanguagesQuery is a query on the pilot_languages table
+type PilotLanguagesQuery = *psql.ViewQuery[*PilotLanguage, PilotLanguageSlice]
+
+// pilotLanguageR is where relationships are stored.
+type pilotLanguageR struct {
+	Language *Language // pilot_languages.languages_fkey
+	Pilot    *Pilot    // pilot_languages.pilots_fkey
+}
+
+type pilotLanguageColumnNames struct {
+	PilotID    string
+	LanguageID string
+}
+
+var PilotLanguageColumns = buildPilotLanguageColumns("pilot_languages")
+
+type pilotLanguageColumns struct {
+	tableAlias string
+	PilotID    psql.Expression
+	LanguageID psql.Expression
+}
+
+func (c pilotLanguageColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (pilotLanguageColumns) AliasedAs(alias string) pilotLanguageColumns {
+	return buildPilotLanguageColumns(alias)
+}
+
+func buildPilotLanguageColumns(alias string) pilotLanguageColumns {
+	return pilotLanguageColumns{
+		tableAlias: alias,
+		PilotID:    psql.Quote(alias, "pilot_id"),
+		LanguageID: psql.Quote(alias, "language_id"),
+	}
+}
+
+type pilotLanguageWhere[Q psql.Filterable] struct {
+	PilotID    psql.WhereMod[Q, int32]
+	LanguageID psql.WhereMod[Q, int32]
+}
+
+func (pilotLanguageWhere[Q]) AliasedAs(alias string) pilotLanguageWhere[Q] {
+	return buildPilotLanguageWhere[Q](buildPilotLanguageColumns(alias))
+}
+
+func buildPilotLanguageWhere[Q psql.Filterable](cols pilotLanguageColumns) pilotLanguageWhere[Q] {
+	return pilotLanguageWhere[Q]{
+		PilotID:    psql.Where[Q, int32](cols.PilotID),
+		LanguageID: psql.Where[Q, int32](cols.LanguageID),
+	}
+}
+
+var PilotLanguageErrors = &pilotLanguageErrors{
+	ErrUniquePilotLanguagesPkey: &UniqueConstraintError{s: "pilot_languages_pkey"},
+}
+
+type pilotLanguageErrors struct {
+	ErrUniquePilotLanguagesPkey *UniqueConstraintError
+}
+
+// PilotLanguageSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type PilotLanguageSetter struct {
+	PilotID    omit.Val[int32] `db:"pilot_id,pk" `
+	LanguageID omit.Val[int32] `db:"language_id,pk" `
+}
+
+func (s PilotLanguageSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.LanguageID.IsUnset() {
+		vals = append(vals, "language_id")
+	}
+
+	return vals
+}
+
+func (s PilotLanguageSetter) Overwrite(t *PilotLanguage) {
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.LanguageID.IsUnset() {
+		t.LanguageID, _ = s.LanguageID.Get()
+	}
+}
+
+func (s *PilotLanguageSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return PilotLanguages.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.PilotID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.PilotID)
+		}
+
+		if s.LanguageID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.LanguageID)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s PilotLanguageSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s PilotLanguageSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.LanguageID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "language_id")...),
+			psql.Arg(s.LanguageID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindPilotLanguage retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindPilotLanguage(ctx context.Context, exec bob.Executor, PilotIDPK int32, LanguageIDPK int32, cols ...string) (*PilotLanguage, error) {
+	if len(cols) == 0 {
+		return PilotLanguages.Query(
+			SelectWhere.PilotLanguages.PilotID.EQ(PilotIDPK),
+			SelectWhere.PilotLanguages.LanguageID.EQ(LanguageIDPK),
+		).One(ctx, exec)
+	}
+
+	return PilotLanguages.Query(
+		SelectWhere.PilotLanguages.PilotID.EQ(PilotIDPK),
+		SelectWhere.PilotLanguages.LanguageID.EQ(LanguageIDPK),
+		sm.Columns(PilotLanguages.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// PilotLanguageExists checks the presence of a single record by primary key
+func PilotLanguageExists(ctx context.Context, exec bob.Executor, PilotIDPK int32, LanguageIDPK int32) (bool, error) {
+	return PilotLanguages.Query(
+		SelectWhere.PilotLanguages.PilotID.EQ(PilotIDPK),
+		SelectWhere.PilotLanguages.LanguageID.EQ(LanguageIDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after PilotLanguage is retrieved from the database
+func (o *PilotLanguage) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = PilotLanguages.AfterSelectHooks.RunHooks(ctx, exec, PilotLanguageSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = PilotLanguages.AfterInsertHooks.RunHooks(ctx, exec, PilotLanguageSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = PilotLanguages.AfterUpdateHooks.RunHooks(ctx, exec, PilotLanguageSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = PilotLanguages.AfterDeleteHooks.RunHooks(ctx, exec, PilotLanguageSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the PilotLanguage
+func (o *PilotLanguage) PrimaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.PilotID,
+		o.LanguageID,
+	)
+}
+
+func (o *PilotLanguage) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("pilot_languages", "pilot_id"), psql.Quote("pilot_languages", "language_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the PilotLanguage
+func (o *PilotLanguage) Update(ctx context.Context, exec bob.Executor, s *PilotLanguageSetter) error {
+	v, err := PilotLanguages.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single PilotLanguage record with an executor
+func (o *PilotLanguage) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := PilotLanguages.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the PilotLanguage using the executor
+func (o *PilotLanguage) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := PilotLanguages.Query(
+		SelectWhere.PilotLanguages.PilotID.EQ(o.PilotID),
+		SelectWhere.PilotLanguages.LanguageID.EQ(o.LanguageID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after PilotLanguageSlice is retrieved from the database
+func (o PilotLanguageSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = PilotLanguages.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = PilotLanguages.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = PilotLanguages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = PilotLanguages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o PilotLanguageSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("pilot_languages", "pilot_id"), psql.Quote("pilot_languages", "language_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o PilotLanguageSlice) copyMatchingRows(from ...*PilotLanguage) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.PilotID != old.PilotID {
+				continue
+			}
+			if new.LanguageID != old.LanguageID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o PilotLanguageSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return PilotLanguages.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *PilotLanguage:
+				o.copyMatchingRows(retrieved)
+			case []*PilotLanguage:
+				o.copyMatchingRows(retrieved...)
+			case PilotLanguageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a PilotLanguage or a slice of PilotLanguage
+				// then run the AfterUpdateHooks on the slice
+				_, err = PilotLanguages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o PilotLanguageSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return PilotLanguages.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *PilotLanguage:
+				o.copyMatchingRows(retrieved)
+			case []*PilotLanguage:
+				o.copyMatchingRows(retrieved...)
+			case PilotLanguageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a PilotLanguage or a slice of PilotLanguage
+				// then run the AfterDeleteHooks on the slice
+				_, err = PilotLanguages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o PilotLanguageSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals PilotLanguageSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := PilotLanguages.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o PilotLanguageSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := PilotLanguages.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o PilotLanguageSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := PilotLanguages.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type pilotLanguageJoins[Q dialect.Joinable] struct {
+	typ      string
+	Language func(context.Context) modAs[Q, languageColumns]
+	Pilot    func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j pilotLanguageJoins[Q]) aliasedAs(alias string) pilotLanguageJoins[Q] {
+	return buildPilotLanguageJoins[Q](buildPilotLanguageColumns(alias), j.typ)
+}
+
+func buildPilotLanguageJoins[Q dialect.Joinable](cols pilotLanguageColumns, typ string) pilotLanguageJoins[Q] {
+	return pilotLanguageJoins[Q]{
+		typ:      typ,
+		Language: pilotLanguagesJoinLanguage[Q](cols, typ),
+		Pilot:    pilotLanguagesJoinPilot[Q](cols, typ),
+	}
+}
+
+func pilotLanguagesJoinLanguage[Q dialect.Joinable](from pilotLanguageColumns, typ string) func(context.Context) modAs[Q, languageColumns] {
+	return func(ctx context.Context) modAs[Q, languageColumns] {
+		return modAs[Q, languageColumns]{
+			c: LanguageColumns,
+			f: func(to languageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Languages.Name().As(to.Alias())).On(
+						to.ID.EQ(from.LanguageID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotLanguagesJoinPilot[Q dialect.Joinable](from pilotLanguageColumns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Language starts a query for related objects on languages
+func (o *PilotLanguage) Language(mods ...bob.Mod[*dialect.SelectQuery]) LanguagesQuery {
+	return Languages.Query(append(mods,
+		sm.Where(LanguageColumns.ID.EQ(psql.Arg(o.LanguageID))),
+	)...)
+}
+
+func (os PilotLanguageSlice) Language(mods ...bob.Mod[*dialect.SelectQuery]) LanguagesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.LanguageID)
+	}
+
+	return Languages.Query(append(mods,
+		sm.Where(psql.Group(LanguageColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *PilotLanguage) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os PilotLanguageSlice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *PilotLanguage) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Language":
+		rel, ok := retrieved.(*Language)
+		if !ok {
+			return fmt.Errorf("pilotLanguage cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Language = rel
+
+		return nil
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("pilotLanguage cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		return nil
+	default:
+		return fmt.Errorf("pilotLanguage has no relationship %q", name)
+	}
+}
+
+func PreloadPilotLanguageLanguage(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Language, LanguageSlice](orm.Relationship{
+		Name: "Language",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.PilotLanguages,
+				To:   TableNames.Languages,
+				FromColumns: []string{
+					ColumnNames.PilotLanguages.LanguageID,
+				},
+				ToColumns: []string{
+					ColumnNames.Languages.ID,
+				},
+			},
+		},
+	}, Languages.Columns().Names(), opts...)
+}
+
+func ThenLoadPilotLanguageLanguage(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotLanguageLanguage(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotLanguageLanguage", retrieved)
+		}
+
+		err := loader.LoadPilotLanguageLanguage(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotLanguageLanguage loads the pilotLanguage's Language into the .R struct
+func (o *PilotLanguage) LoadPilotLanguageLanguage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Language = nil
+
+	related, err := o.Language(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Language = related
+	return nil
+}
+
+// LoadPilotLanguageLanguage loads the pilotLanguage's Language into the .R struct
+func (os PilotLanguageSlice) LoadPilotLanguageLanguage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	languages, err := os.Language(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range languages {
+			if o.LanguageID != rel.ID {
+				continue
+			}
+
+			o.R.Language = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func PreloadPilotLanguagePilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.PilotLanguages,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.PilotLanguages.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadPilotLanguagePilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotLanguagePilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotLanguagePilot", retrieved)
+		}
+
+		err := loader.LoadPilotLanguagePilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotLanguagePilot loads the pilotLanguage's Pilot into the .R struct
+func (o *PilotLanguage) LoadPilotLanguagePilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadPilotLanguagePilot loads the pilotLanguage's Pilot into the .R struct
+func (os PilotLanguageSlice) LoadPilotLanguagePilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachPilotLanguageLanguage0(ctx context.Context, exec bob.Executor, count int, pilotLanguage0 *PilotLanguage, language1 *Language) (*PilotLanguage, error) {
+	setter := &PilotLanguageSetter{
+		LanguageID: omit.From(language1.ID),
+	}
+
+	err := pilotLanguage0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotLanguageLanguage0: %w", err)
+	}
+
+	return pilotLanguage0, nil
+}
+
+func (pilotLanguage0 *PilotLanguage) InsertLanguage(ctx context.Context, exec bob.Executor, related *LanguageSetter) error {
+	language1, err := Languages.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachPilotLanguageLanguage0(ctx, exec, 1, pilotLanguage0, language1)
+	if err != nil {
+		return err
+	}
+
+	pilotLanguage0.R.Language = language1
+
+	return nil
+}
+
+func (pilotLanguage0 *PilotLanguage) AttachLanguage(ctx context.Context, exec bob.Executor, language1 *Language) error {
+	var err error
+
+	_, err = attachPilotLanguageLanguage0(ctx, exec, 1, pilotLanguage0, language1)
+	if err != nil {
+		return err
+	}
+
+	pilotLanguage0.R.Language = language1
+
+	return nil
+}
+
+func attachPilotLanguagePilot0(ctx context.Context, exec bob.Executor, count int, pilotLanguage0 *PilotLanguage, pilot1 *Pilot) (*PilotLanguage, error) {
+	setter := &PilotLanguageSetter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := pilotLanguage0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotLanguagePilot0: %w", err)
+	}
+
+	return pilotLanguage0, nil
+}
+
+func (pilotLanguage0 *PilotLanguage) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachPilotLanguagePilot0(ctx, exec, 1, pilotLanguage0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	pilotLanguage0.R.Pilot = pilot1
+
+	return nil
+}
+
+func (pilotLanguage0 *PilotLanguage) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachPilotLanguagePilot0(ctx, exec, 1, pilotLanguage0, pilot1)
+	if err != nil {
+		return err
+	}
+
+	pilotLanguage0.R.Pilot = pilot1
+
+	return nil
+}
@@ -0,0 +1,1822 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/scan"
+)
+
+// Pilot is an object representing the database table.
+type Pilot struct {
+	ID   int32  `db:"id,pk" `
+	Name string `db:"name" `
+
+	R pilotR `db:"-" `
+}
+
+// PilotSlice is an alias for a slice of pointers to Pilot.
+// This should almost always be used instead of []*Pilot.
+type PilotSlice []*Pilot
+
+// Pilots contains methods to work with the pilots table
+var Pilots = psql.NewTablex[*Pilot, PilotSlice, *PilotSetter]("", "pilots")
+
+// PilotsQuery is a query on the pilots table
+type PilotsQuery = *psql.ViewQuery[*Pilot, PilotSlice]
+
+// pilotR is where relationships are stored.
+type pilotR struct {
+	Jets      JetSlice      // jets.jets_pilot_id_pilots_id_foreign
+	Licenses  LicenseSlice  // licenses.licenses_pilot_id_pilots_id_foreign
+	Languages LanguageSlice // pilot_languages.languages_fkeypilot_languages.pilots_fkey
+	Wide10s   Wide10Slice   // wide10.wide10_pilot_id_pilots_id_foreign
+	Wide100s  Wide100Slice  // wide100.wide100_pilot_id_pilots_id_foreign
+	Wide25s   Wide25Slice   // wide25.wide25_pilot_id_pilots_id_foreign
+	Wide50s   Wide50Slice   // wide50.wide50_pilot_id_pilots_id_foreign
+}
+
+type pilotColumnNames struct {
+	ID   string
+	Name string
+}
+
+var PilotColumns = buildPilotColumns("pilots")
+
+type pilotColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	Name       psql.Expression
+}
+
+func (c pilotColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (pilotColumns) AliasedAs(alias string) pilotColumns {
+	return buildPilotColumns(alias)
+}
+
+func buildPilotColumns(alias string) pilotColumns {
+	return pilotColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Name:       psql.Quote(alias, "name"),
+	}
+}
+
+type pilotWhere[Q psql.Filterable] struct {
+	ID   psql.WhereMod[Q, int32]
+	Name psql.WhereMod[Q, string]
+}
+
+func (pilotWhere[Q]) AliasedAs(alias string) pilotWhere[Q] {
+	return buildPilotWhere[Q](buildPilotColumns(alias))
+}
+
+func buildPilotWhere[Q psql.Filterable](cols pilotColumns) pilotWhere[Q] {
+	return pilotWhere[Q]{
+		ID:   psql.Where[Q, int32](cols.ID),
+		Name: psql.Where[Q, string](cols.Name),
+	}
+}
+
+var PilotErrors = &pilotErrors{
+	ErrUniquePilotsPkey: &UniqueConstraintError{s: "pilots_pkey"},
+}
+
+type pilotErrors struct {
+	ErrUniquePilotsPkey *UniqueConstraintError
+}
+
+// PilotSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type PilotSetter struct {
+	ID   omit.Val[int32]  `db:"id,pk" `
+	Name omit.Val[string] `db:"name" `
+}
+
+func (s PilotSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.Name.IsUnset() {
+		vals = append(vals, "name")
+	}
+
+	return vals
+}
+
+func (s PilotSetter) Overwrite(t *Pilot) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.Name.IsUnset() {
+		t.Name, _ = s.Name.Get()
+	}
+}
+
+func (s *PilotSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Pilots.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.Name.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.Name)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s PilotSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s PilotSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.Name.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	return exprs
+}
+
+// FindPilot retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindPilot(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Pilot, error) {
+	if len(cols) == 0 {
+		return Pilots.Query(
+			SelectWhere.Pilots.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Pilots.Query(
+		SelectWhere.Pilots.ID.EQ(IDPK),
+		sm.Columns(Pilots.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// PilotExists checks the presence of a single record by primary key
+func PilotExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Pilots.Query(
+		SelectWhere.Pilots.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Pilot is retrieved from the database
+func (o *Pilot) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Pilots.AfterSelectHooks.RunHooks(ctx, exec, PilotSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Pilots.AfterInsertHooks.RunHooks(ctx, exec, PilotSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Pilots.AfterUpdateHooks.RunHooks(ctx, exec, PilotSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Pilots.AfterDeleteHooks.RunHooks(ctx, exec, PilotSlice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Pilot
+func (o *Pilot) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Pilot) pkEQ() dialect.Expression {
+	return psql.Quote("pilots", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Pilot
+func (o *Pilot) Update(ctx context.Context, exec bob.Executor, s *PilotSetter) error {
+	v, err := Pilots.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Pilot record with an executor
+func (o *Pilot) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Pilots.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Pilot using the executor
+func (o *Pilot) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Pilots.Query(
+		SelectWhere.Pilots.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after PilotSlice is retrieved from the database
+func (o PilotSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Pilots.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Pilots.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Pilots.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Pilots.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o PilotSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("pilots", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o PilotSlice) copyMatchingRows(from ...*Pilot) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o PilotSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Pilots.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Pilot:
+				o.copyMatchingRows(retrieved)
+			case []*Pilot:
+				o.copyMatchingRows(retrieved...)
+			case PilotSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Pilot or a slice of Pilot
+				// then run the AfterUpdateHooks on the slice
+				_, err = Pilots.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o PilotSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Pilots.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Pilot:
+				o.copyMatchingRows(retrieved)
+			case []*Pilot:
+				o.copyMatchingRows(retrieved...)
+			case PilotSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Pilot or a slice of Pilot
+				// then run the AfterDeleteHooks on the slice
+				_, err = Pilots.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o PilotSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals PilotSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Pilots.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o PilotSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Pilots.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o PilotSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Pilots.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type pilotJoins[Q dialect.Joinable] struct {
+	typ       string
+	Jets      func(context.Context) modAs[Q, jetColumns]
+	Licenses  func(context.Context) modAs[Q, licenseColumns]
+	Languages func(context.Context) modAs[Q, languageColumns]
+	Wide10s   func(context.Context) modAs[Q, wide10Columns]
+	Wide100s  func(context.Context) modAs[Q, wide100Columns]
+	Wide25s   func(context.Context) modAs[Q, wide25Columns]
+	Wide50s   func(context.Context) modAs[Q, wide50Columns]
+}
+
+func (j pilotJoins[Q]) aliasedAs(alias string) pilotJoins[Q] {
+	return buildPilotJoins[Q](buildPilotColumns(alias), j.typ)
+}
+
+func buildPilotJoins[Q dialect.Joinable](cols pilotColumns, typ string) pilotJoins[Q] {
+	return pilotJoins[Q]{
+		typ:       typ,
+		Jets:      pilotsJoinJets[Q](cols, typ),
+		Licenses:  pilotsJoinLicenses[Q](cols, typ),
+		Languages: pilotsJoinLanguages[Q](cols, typ),
+		Wide10s:   pilotsJoinWide10s[Q](cols, typ),
+		Wide100s:  pilotsJoinWide100s[Q](cols, typ),
+		Wide25s:   pilotsJoinWide25s[Q](cols, typ),
+		Wide50s:   pilotsJoinWide50s[Q](cols, typ),
+	}
+}
+
+func pilotsJoinJets[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, jetColumns] {
+	return func(ctx context.Context) modAs[Q, jetColumns] {
+		return modAs[Q, jetColumns]{
+			c: JetColumns,
+			f: func(to jetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Jets.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinLicenses[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, licenseColumns] {
+	return func(ctx context.Context) modAs[Q, licenseColumns] {
+		return modAs[Q, licenseColumns]{
+			c: LicenseColumns,
+			f: func(to licenseColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Licenses.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinLanguages[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, languageColumns] {
+	return func(ctx context.Context) modAs[Q, languageColumns] {
+		return modAs[Q, languageColumns]{
+			c: LanguageColumns,
+			f: func(to languageColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := PilotLanguageColumns.AliasedAs(PilotLanguageColumns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, PilotLanguages.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+				{
+					from := PilotLanguageColumns.AliasedAs(PilotLanguageColumns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Languages.Name().As(to.Alias())).On(
+						to.ID.EQ(from.LanguageID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinWide10s[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, wide10Columns] {
+	return func(ctx context.Context) modAs[Q, wide10Columns] {
+		return modAs[Q, wide10Columns]{
+			c: Wide10Columns,
+			f: func(to wide10Columns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Wide10s.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinWide100s[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, wide100Columns] {
+	return func(ctx context.Context) modAs[Q, wide100Columns] {
+		return modAs[Q, wide100Columns]{
+			c: Wide100Columns,
+			f: func(to wide100Columns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Wide100s.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinWide25s[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, wide25Columns] {
+	return func(ctx context.Context) modAs[Q, wide25Columns] {
+		return modAs[Q, wide25Columns]{
+			c: Wide25Columns,
+			f: func(to wide25Columns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Wide25s.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func pilotsJoinWide50s[Q dialect.Joinable](from pilotColumns, typ string) func(context.Context) modAs[Q, wide50Columns] {
+	return func(ctx context.Context) modAs[Q, wide50Columns] {
+		return modAs[Q, wide50Columns]{
+			c: Wide50Columns,
+			f: func(to wide50Columns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Wide50s.Name().As(to.Alias())).On(
+						to.PilotID.EQ(from.ID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Jets starts a query for related objects on jets
+func (o *Pilot) Jets(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	return Jets.Query(append(mods,
+		sm.Where(JetColumns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Jets(mods ...bob.Mod[*dialect.SelectQuery]) JetsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Jets.Query(append(mods,
+		sm.Where(psql.Group(JetColumns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Licenses starts a query for related objects on licenses
+func (o *Pilot) Licenses(mods ...bob.Mod[*dialect.SelectQuery]) LicensesQuery {
+	return Licenses.Query(append(mods,
+		sm.Where(LicenseColumns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Licenses(mods ...bob.Mod[*dialect.SelectQuery]) LicensesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Licenses.Query(append(mods,
+		sm.Where(psql.Group(LicenseColumns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Languages starts a query for related objects on languages
+func (o *Pilot) Languages(mods ...bob.Mod[*dialect.SelectQuery]) LanguagesQuery {
+	return Languages.Query(append(mods,
+		sm.InnerJoin(PilotLanguages.NameAs()).On(
+			LanguageColumns.ID.EQ(PilotLanguageColumns.LanguageID)),
+		sm.Where(PilotLanguageColumns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Languages(mods ...bob.Mod[*dialect.SelectQuery]) LanguagesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Languages.Query(append(mods,
+		sm.InnerJoin(PilotLanguages.NameAs()).On(
+			LanguageColumns.ID.EQ(PilotLanguageColumns.LanguageID),
+		),
+		sm.Where(psql.Group(PilotLanguageColumns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Wide10s starts a query for related objects on wide10
+func (o *Pilot) Wide10s(mods ...bob.Mod[*dialect.SelectQuery]) Wide10sQuery {
+	return Wide10s.Query(append(mods,
+		sm.Where(Wide10Columns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Wide10s(mods ...bob.Mod[*dialect.SelectQuery]) Wide10sQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Wide10s.Query(append(mods,
+		sm.Where(psql.Group(Wide10Columns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Wide100s starts a query for related objects on wide100
+func (o *Pilot) Wide100s(mods ...bob.Mod[*dialect.SelectQuery]) Wide100sQuery {
+	return Wide100s.Query(append(mods,
+		sm.Where(Wide100Columns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Wide100s(mods ...bob.Mod[*dialect.SelectQuery]) Wide100sQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Wide100s.Query(append(mods,
+		sm.Where(psql.Group(Wide100Columns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Wide25s starts a query for related objects on wide25
+func (o *Pilot) Wide25s(mods ...bob.Mod[*dialect.SelectQuery]) Wide25sQuery {
+	return Wide25s.Query(append(mods,
+		sm.Where(Wide25Columns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Wide25s(mods ...bob.Mod[*dialect.SelectQuery]) Wide25sQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Wide25s.Query(append(mods,
+		sm.Where(psql.Group(Wide25Columns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+// Wide50s starts a query for related objects on wide50
+func (o *Pilot) Wide50s(mods ...bob.Mod[*dialect.SelectQuery]) Wide50sQuery {
+	return Wide50s.Query(append(mods,
+		sm.Where(Wide50Columns.PilotID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os PilotSlice) Wide50s(mods ...bob.Mod[*dialect.SelectQuery]) Wide50sQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.ID)
+	}
+
+	return Wide50s.Query(append(mods,
+		sm.Where(psql.Group(Wide50Columns.PilotID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Pilot) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Jets":
+		rels, ok := retrieved.(JetSlice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Jets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	case "Licenses":
+		rels, ok := retrieved.(LicenseSlice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Licenses = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	case "Languages":
+		rels, ok := retrieved.(LanguageSlice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Languages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilots = PilotSlice{o}
+			}
+		}
+		return nil
+	case "Wide10s":
+		rels, ok := retrieved.(Wide10Slice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Wide10s = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	case "Wide100s":
+		rels, ok := retrieved.(Wide100Slice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Wide100s = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	case "Wide25s":
+		rels, ok := retrieved.(Wide25Slice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Wide25s = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	case "Wide50s":
+		rels, ok := retrieved.(Wide50Slice)
+		if !ok {
+			return fmt.Errorf("pilot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Wide50s = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Pilot = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("pilot has no relationship %q", name)
+	}
+}
+
+func ThenLoadPilotJets(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotJets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotJets", retrieved)
+		}
+
+		err := loader.LoadPilotJets(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotJets loads the pilot's Jets into the .R struct
+func (o *Pilot) LoadPilotJets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Jets = nil
+
+	related, err := o.Jets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Jets = related
+	return nil
+}
+
+// LoadPilotJets loads the pilot's Jets into the .R struct
+func (os PilotSlice) LoadPilotJets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	jets, err := os.Jets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Jets = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range jets {
+			if o.ID != rel.PilotID {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Jets = append(o.R.Jets, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotLicenses(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotLicenses(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotLicenses", retrieved)
+		}
+
+		err := loader.LoadPilotLicenses(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotLicenses loads the pilot's Licenses into the .R struct
+func (o *Pilot) LoadPilotLicenses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Licenses = nil
+
+	related, err := o.Licenses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Licenses = related
+	return nil
+}
+
+// LoadPilotLicenses loads the pilot's Licenses into the .R struct
+func (os PilotSlice) LoadPilotLicenses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	licenses, err := os.Licenses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Licenses = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range licenses {
+			if o.ID != rel.PilotID.GetOrZero() {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Licenses = append(o.R.Licenses, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotLanguages(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotLanguages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotLanguages", retrieved)
+		}
+
+		err := loader.LoadPilotLanguages(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotLanguages loads the pilot's Languages into the .R struct
+func (o *Pilot) LoadPilotLanguages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Languages = nil
+
+	related, err := o.Languages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilots = PilotSlice{o}
+	}
+
+	o.R.Languages = related
+	return nil
+}
+
+// LoadPilotLanguages loads the pilot's Languages into the .R struct
+func (os PilotSlice) LoadPilotLanguages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Languages.Columns()))
+	}
+
+	q := os.Languages(append(
+		mods,
+		sm.Columns(PilotLanguageColumns.PilotID.As("related_pilots.ID")),
+	)...)
+
+	IDSlice := []int32{}
+
+	mapper := scan.Mod(scan.StructMapper[*Language](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int32))
+				row.ScheduleScan("related_pilots.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	languages, err := bob.Allx[*Language, LanguageSlice](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Languages = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range languages {
+			if o.ID != IDSlice[i] {
+				continue
+			}
+
+			rel.R.Pilots = append(rel.R.Pilots, o)
+
+			o.R.Languages = append(o.R.Languages, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotWide10s(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotWide10s(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotWide10s", retrieved)
+		}
+
+		err := loader.LoadPilotWide10s(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotWide10s loads the pilot's Wide10s into the .R struct
+func (o *Pilot) LoadPilotWide10s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Wide10s = nil
+
+	related, err := o.Wide10s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Wide10s = related
+	return nil
+}
+
+// LoadPilotWide10s loads the pilot's Wide10s into the .R struct
+func (os PilotSlice) LoadPilotWide10s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	wide10s, err := os.Wide10s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Wide10s = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range wide10s {
+			if o.ID != rel.PilotID {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Wide10s = append(o.R.Wide10s, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotWide100s(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotWide100s(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotWide100s", retrieved)
+		}
+
+		err := loader.LoadPilotWide100s(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotWide100s loads the pilot's Wide100s into the .R struct
+func (o *Pilot) LoadPilotWide100s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Wide100s = nil
+
+	related, err := o.Wide100s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Wide100s = related
+	return nil
+}
+
+// LoadPilotWide100s loads the pilot's Wide100s into the .R struct
+func (os PilotSlice) LoadPilotWide100s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	wide100s, err := os.Wide100s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Wide100s = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range wide100s {
+			if o.ID != rel.PilotID {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Wide100s = append(o.R.Wide100s, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotWide25s(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotWide25s(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotWide25s", retrieved)
+		}
+
+		err := loader.LoadPilotWide25s(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotWide25s loads the pilot's Wide25s into the .R struct
+func (o *Pilot) LoadPilotWide25s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Wide25s = nil
+
+	related, err := o.Wide25s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Wide25s = related
+	return nil
+}
+
+// LoadPilotWide25s loads the pilot's Wide25s into the .R struct
+func (os PilotSlice) LoadPilotWide25s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	wide25s, err := os.Wide25s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Wide25s = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range wide25s {
+			if o.ID != rel.PilotID {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Wide25s = append(o.R.Wide25s, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadPilotWide50s(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadPilotWide50s(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load PilotWide50s", retrieved)
+		}
+
+		err := loader.LoadPilotWide50s(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadPilotWide50s loads the pilot's Wide50s into the .R struct
+func (o *Pilot) LoadPilotWide50s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Wide50s = nil
+
+	related, err := o.Wide50s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Pilot = o
+	}
+
+	o.R.Wide50s = related
+	return nil
+}
+
+// LoadPilotWide50s loads the pilot's Wide50s into the .R struct
+func (os PilotSlice) LoadPilotWide50s(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	wide50s, err := os.Wide50s(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Wide50s = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range wide50s {
+			if o.ID != rel.PilotID {
+				continue
+			}
+
+			rel.R.Pilot = o
+
+			o.R.Wide50s = append(o.R.Wide50s, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertPilotJets0(ctx context.Context, exec bob.Executor, jets1 []*JetSetter, pilot0 *Pilot) (JetSlice, error) {
+	for i := range jets1 {
+		jets1[i].PilotID = omit.From(pilot0.ID)
+	}
+
+	ret, err := Jets.Insert(bob.ToMods(jets1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotJets0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotJets0(ctx context.Context, exec bob.Executor, count int, jets1 JetSlice, pilot0 *Pilot) (JetSlice, error) {
+	setter := &JetSetter{
+		PilotID: omit.From(pilot0.ID),
+	}
+
+	err := jets1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotJets0: %w", err)
+	}
+
+	return jets1, nil
+}
+
+func (pilot0 *Pilot) InsertJets(ctx context.Context, exec bob.Executor, related ...*JetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	jets1, err := insertPilotJets0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Jets = append(pilot0.R.Jets, jets1...)
+
+	for _, rel := range jets1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachJets(ctx context.Context, exec bob.Executor, related ...*Jet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	jets1 := JetSlice(related)
+
+	_, err = attachPilotJets0(ctx, exec, len(related), jets1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Jets = append(pilot0.R.Jets, jets1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
+
+func insertPilotLicenses0(ctx context.Context, exec bob.Executor, licenses1 []*LicenseSetter, pilot0 *Pilot) (LicenseSlice, error) {
+	for i := range licenses1 {
+		licenses1[i].PilotID = omitnull.From(pilot0.ID)
+	}
+
+	ret, err := Licenses.Insert(bob.ToMods(licenses1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotLicenses0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotLicenses0(ctx context.Context, exec bob.Executor, count int, licenses1 LicenseSlice, pilot0 *Pilot) (LicenseSlice, error) {
+	setter := &LicenseSetter{
+		PilotID: omitnull.From(pilot0.ID),
+	}
+
+	err := licenses1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotLicenses0: %w", err)
+	}
+
+	return licenses1, nil
+}
+
+func (pilot0 *Pilot) InsertLicenses(ctx context.Context, exec bob.Executor, related ...*LicenseSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	licenses1, err := insertPilotLicenses0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Licenses = append(pilot0.R.Licenses, licenses1...)
+
+	for _, rel := range licenses1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachLicenses(ctx context.Context, exec bob.Executor, related ...*License) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	licenses1 := LicenseSlice(related)
+
+	_, err = attachPilotLicenses0(ctx, exec, len(related), licenses1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Licenses = append(pilot0.R.Licenses, licenses1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
+
+func attachPilotLanguages0(ctx context.Context, exec bob.Executor, count int, pilot0 *Pilot, languages2 LanguageSlice) (PilotLanguageSlice, error) {
+	setters := make([]*PilotLanguageSetter, count)
+	for i := 0; i < count; i++ {
+		setters[i] = &PilotLanguageSetter{
+			PilotID:    omit.From(pilot0.ID),
+			LanguageID: omit.From(languages2[i].ID),
+		}
+	}
+
+	pilotLanguages1, err := PilotLanguages.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotLanguages0: %w", err)
+	}
+
+	return pilotLanguages1, nil
+}
+
+func (pilot0 *Pilot) InsertLanguages(ctx context.Context, exec bob.Executor, related ...*LanguageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Languages.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	languages2 := LanguageSlice(inserted)
+
+	_, err = attachPilotLanguages0(ctx, exec, len(related), pilot0, languages2)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Languages = append(pilot0.R.Languages, languages2...)
+
+	for _, rel := range languages2 {
+		rel.R.Pilots = append(rel.R.Pilots, pilot0)
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachLanguages(ctx context.Context, exec bob.Executor, related ...*Language) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	languages2 := LanguageSlice(related)
+
+	_, err = attachPilotLanguages0(ctx, exec, len(related), pilot0, languages2)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Languages = append(pilot0.R.Languages, languages2...)
+
+	for _, rel := range related {
+		rel.R.Pilots = append(rel.R.Pilots, pilot0)
+	}
+
+	return nil
+}
+
+func insertPilotWide10s0(ctx context.Context, exec bob.Executor, wide10s1 []*Wide10Setter, pilot0 *Pilot) (Wide10Slice, error) {
+	for i := range wide10s1 {
+		wide10s1[i].PilotID = omit.From(pilot0.ID)
+	}
+
+	ret, err := Wide10s.Insert(bob.ToMods(wide10s1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotWide10s0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotWide10s0(ctx context.Context, exec bob.Executor, count int, wide10s1 Wide10Slice, pilot0 *Pilot) (Wide10Slice, error) {
+	setter := &Wide10Setter{
+		PilotID: omit.From(pilot0.ID),
+	}
+
+	err := wide10s1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotWide10s0: %w", err)
+	}
+
+	return wide10s1, nil
+}
+
+func (pilot0 *Pilot) InsertWide10s(ctx context.Context, exec bob.Executor, related ...*Wide10Setter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	wide10s1, err := insertPilotWide10s0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide10s = append(pilot0.R.Wide10s, wide10s1...)
+
+	for _, rel := range wide10s1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachWide10s(ctx context.Context, exec bob.Executor, related ...*Wide10) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	wide10s1 := Wide10Slice(related)
+
+	_, err = attachPilotWide10s0(ctx, exec, len(related), wide10s1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide10s = append(pilot0.R.Wide10s, wide10s1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
+
+func insertPilotWide100s0(ctx context.Context, exec bob.Executor, wide100s1 []*Wide100Setter, pilot0 *Pilot) (Wide100Slice, error) {
+	for i := range wide100s1 {
+		wide100s1[i].PilotID = omit.From(pilot0.ID)
+	}
+
+	ret, err := Wide100s.Insert(bob.ToMods(wide100s1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotWide100s0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotWide100s0(ctx context.Context, exec bob.Executor, count int, wide100s1 Wide100Slice, pilot0 *Pilot) (Wide100Slice, error) {
+	setter := &Wide100Setter{
+		PilotID: omit.From(pilot0.ID),
+	}
+
+	err := wide100s1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotWide100s0: %w", err)
+	}
+
+	return wide100s1, nil
+}
+
+func (pilot0 *Pilot) InsertWide100s(ctx context.Context, exec bob.Executor, related ...*Wide100Setter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	wide100s1, err := insertPilotWide100s0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide100s = append(pilot0.R.Wide100s, wide100s1...)
+
+	for _, rel := range wide100s1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachWide100s(ctx context.Context, exec bob.Executor, related ...*Wide100) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	wide100s1 := Wide100Slice(related)
+
+	_, err = attachPilotWide100s0(ctx, exec, len(related), wide100s1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide100s = append(pilot0.R.Wide100s, wide100s1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
+
+func insertPilotWide25s0(ctx context.Context, exec bob.Executor, wide25s1 []*Wide25Setter, pilot0 *Pilot) (Wide25Slice, error) {
+	for i := range wide25s1 {
+		wide25s1[i].PilotID = omit.From(pilot0.ID)
+	}
+
+	ret, err := Wide25s.Insert(bob.ToMods(wide25s1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotWide25s0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotWide25s0(ctx context.Context, exec bob.Executor, count int, wide25s1 Wide25Slice, pilot0 *Pilot) (Wide25Slice, error) {
+	setter := &Wide25Setter{
+		PilotID: omit.From(pilot0.ID),
+	}
+
+	err := wide25s1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotWide25s0: %w", err)
+	}
+
+	return wide25s1, nil
+}
+
+func (pilot0 *Pilot) InsertWide25s(ctx context.Context, exec bob.Executor, related ...*Wide25Setter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	wide25s1, err := insertPilotWide25s0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide25s = append(pilot0.R.Wide25s, wide25s1...)
+
+	for _, rel := range wide25s1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachWide25s(ctx context.Context, exec bob.Executor, related ...*Wide25) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	wide25s1 := Wide25Slice(related)
+
+	_, err = attachPilotWide25s0(ctx, exec, len(related), wide25s1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide25s = append(pilot0.R.Wide25s, wide25s1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
+
+func insertPilotWide50s0(ctx context.Context, exec bob.Executor, wide50s1 []*Wide50Setter, pilot0 *Pilot) (Wide50Slice, error) {
+	for i := range wide50s1 {
+		wide50s1[i].PilotID = omit.From(pilot0.ID)
+	}
+
+	ret, err := Wide50s.Insert(bob.ToMods(wide50s1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertPilotWide50s0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachPilotWide50s0(ctx context.Context, exec bob.Executor, count int, wide50s1 Wide50Slice, pilot0 *Pilot) (Wide50Slice, error) {
+	setter := &Wide50Setter{
+		PilotID: omit.From(pilot0.ID),
+	}
+
+	err := wide50s1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPilotWide50s0: %w", err)
+	}
+
+	return wide50s1, nil
+}
+
+func (pilot0 *Pilot) InsertWide50s(ctx context.Context, exec bob.Executor, related ...*Wide50Setter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	wide50s1, err := insertPilotWide50s0(ctx, exec, related, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide50s = append(pilot0.R.Wide50s, wide50s1...)
+
+	for _, rel := range wide50s1 {
+		rel.R.Pilot = pilot0
+	}
+	return nil
+}
+
+func (pilot0 *Pilot) AttachWide50s(ctx context.Context, exec bob.Executor, related ...*Wide50) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	wide50s1 := Wide50Slice(related)
+
+	_, err = attachPilotWide50s0(ctx, exec, len(related), wide50s1, pilot0)
+	if err != nil {
+		return err
+	}
+
+	pilot0.R.Wide50s = append(pilot0.R.Wide50s, wide50s1...)
+
+	for _, rel := range related {
+		rel.R.Pilot = pilot0
+	}
+
+	return nil
+}
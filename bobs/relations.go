@@ -0,0 +1,65 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+// relations are the pilot and the rows the relationship writes work on
+type relations struct {
+	pilot     *Pilot
+	languages LanguageSlice
+	jets      JetSlice
+}
+
+// Relate sets up a pilot and n languages and jets, bob only attaches so
+// licenses are never written
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: &Pilot{ID: 1, Name: "test"}}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, &Language{ID: int32(i), Language: "test"})
+		r.jets = append(r.jets, &Jet{ID: int32(i), PilotID: 2, AirportID: 1, Name: "test", UUID: "test"})
+	}
+	a.rel = r
+}
+
+// AttachLanguages adds the languages to the pilot
+func (a *Adapter) AttachLanguages(ctx context.Context) error {
+	r := &a.rel
+	r.pilot.R = pilotR{}
+	for _, l := range r.languages {
+		l.R = languageR{}
+	}
+	if err := r.pilot.AttachLanguages(ctx, a.db, r.languages...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReplaceLanguages is unsupported, bob only attaches
+func (a *Adapter) ReplaceLanguages(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// DetachLanguages is unsupported, bob only attaches
+func (a *Adapter) DetachLanguages(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(ctx context.Context) error {
+	r := &a.rel
+	r.pilot.R = pilotR{}
+	if err := r.pilot.AttachJets(ctx, a.db, r.jets...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReplaceLicenses is unsupported, bob only attaches
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	return bench.ErrUnsupported
+}
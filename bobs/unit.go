@@ -0,0 +1,33 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omitnull"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(ctx context.Context) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	jet, err := FindJet(ctx, tx, 1)
+	if err != nil {
+		return err
+	}
+	if err := jet.Update(ctx, tx, jetSetter(jet)); err != nil {
+		return err
+	}
+	if _, err := Licenses.Insert(&LicenseSetter{PilotID: omitnull.From(jet.PilotID)}).Exec(ctx, tx); err != nil {
+		return err
+	}
+
+	a.jets = []*Jet{jet}
+	return tx.Commit()
+}
@@ -0,0 +1,193 @@
+package bobs
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/scan"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide(a, columns, Wide10s, ThenLoadWide10Pilot, func(w *Wide10) *Pilot { return w.R.Pilot })
+	case 25:
+		return newWide(a, columns, Wide25s, ThenLoadWide25Pilot, func(w *Wide25) *Pilot { return w.R.Pilot })
+	case 50:
+		return newWide(a, columns, Wide50s, ThenLoadWide50Pilot, func(w *Wide50) *Pilot { return w.R.Pilot })
+	case 100:
+		return newWide(a, columns, Wide100s, ThenLoadWide100Pilot, func(w *Wide100) *Pilot { return w.R.Pilot })
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wideModel is implemented by the models of the wide tables
+type wideModel[T, S any] interface {
+	*T
+	orm.Model
+	Update(ctx context.Context, exec bob.Executor, s S) error
+	Delete(ctx context.Context, exec bob.Executor) error
+}
+
+// wideSetter is implemented by the setters of the wide tables
+type wideSetter[P any] interface {
+	orm.Setter[P, *dialect.InsertQuery, *dialect.UpdateQuery]
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any, P wideModel[T, S], Slice ~[]P, S wideSetter[P]] struct {
+	a       *Adapter
+	columns int
+	table   *psql.Table[P, Slice, S]
+	load    func(...bob.Mod[*dialect.SelectQuery]) psql.Loader
+	pilot   func(P) *Pilot
+
+	// setter sets every non-key column of row
+	setter S
+
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  Slice
+	wrote bool
+}
+
+func newWide[T any, P wideModel[T, S], Slice ~[]P, S wideSetter[P]](
+	a *Adapter, columns int, table *psql.Table[P, Slice, S],
+	load func(...bob.Mod[*dialect.SelectQuery]) psql.Loader, pilot func(P) *Pilot,
+) *wide[T, P, Slice, S] {
+	w := &wide[T, P, Slice, S]{a: a, columns: columns, table: table, load: load, pilot: pilot}
+	bench.FillRow(&w.row, columns)
+	w.setter = setterOf[S](&w.row, columns)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T, P, Slice, S]) SelectAll(ctx context.Context) error {
+	store, err := w.table.Query().All(ctx, w.a.db)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T, P, Slice, S]) SelectSubset(ctx context.Context) error {
+	store, err := w.table.Query(
+		sm.Columns(w.subset()...),
+	).All(ctx, w.a.db)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T, P, Slice, S]) SelectComplex(ctx context.Context) error {
+	store, err := w.table.Query(
+		sm.Columns(w.subset()...),
+		sm.Where(psql.Quote("id").GT(psql.Arg(1))),
+		sm.Where(psql.Quote("text_003").NE(psql.Arg("thing"))),
+		sm.Limit(1),
+		sm.GroupBy(psql.Quote("id")),
+		sm.Offset(1),
+	).All(ctx, w.a.db)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T, P, Slice, S]) Insert(ctx context.Context) error {
+	w.wrote = true
+	_, err := w.table.Insert(w.setter).One(ctx, w.a.db)
+	return err
+}
+
+// Update a row
+func (w *wide[T, P, Slice, S]) Update(ctx context.Context) error {
+	w.wrote = true
+	return P(&w.row).Update(ctx, w.a.db, w.setter)
+}
+
+// Delete a row
+func (w *wide[T, P, Slice, S]) Delete(ctx context.Context) error {
+	w.wrote = true
+	return P(&w.row).Delete(ctx, w.a.db)
+}
+
+// RawBind a raw query into rows
+func (w *wide[T, P, Slice, S]) RawBind(ctx context.Context) error {
+	store, err := bob.All(ctx, w.a.db, psql.RawQuery("select * from "+bench.WideTable(w.columns)), scan.StructMapper[P]())
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad rows with their pilots
+func (w *wide[T, P, Slice, S]) EagerLoad(ctx context.Context) error {
+	store, err := w.table.Query(w.load()).All(ctx, w.a.db)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Results of the last operation
+func (w *wide[T, P, Slice, S]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{w.canonical(&w.row)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = w.canonical(r)
+	}
+	return results
+}
+
+func (w *wide[T, P, Slice, S]) canonical(r P) bench.Row {
+	row := bench.RowOf(r, w.columns)
+	if p := w.pilot(r); p != nil {
+		row.Pilot = &bench.Pilot{ID: int64(p.ID), Name: p.Name}
+	}
+	return row
+}
+
+// subset are the columns read by SelectSubset and SelectComplex
+func (w *wide[T, P, Slice, S]) subset() []any {
+	var columns []any
+	for _, c := range bench.WideSubset(w.columns) {
+		columns = append(columns, c)
+	}
+	return columns
+}
+
+// setterOf builds the setter of every column of a wide model but its key.
+// The fields of the models and setters are in the same order, the model's
+// null.Val fields are set on the setter's omitnull.Val fields.
+func setterOf[S any](model any, columns int) S {
+	var s S
+	setter := reflect.New(reflect.TypeOf(s).Elem())
+	m := reflect.ValueOf(model).Elem()
+
+	for i := 1; i < columns; i++ {
+		field := setter.Elem().Field(i).Addr()
+		value := m.Field(i)
+
+		if get := value.MethodByName("Get"); get.IsValid() {
+			out := get.Call(nil)
+			if !out[1].Bool() {
+				field.MethodByName("Null").Call(nil)
+				continue
+			}
+			value = out[0]
+		}
+		field.MethodByName("Set").Call([]reflect.Value{value})
+	}
+
+	return setter.Interface().(S)
+}
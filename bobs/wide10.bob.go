@@ -0,0 +1,825 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Wide10 is an object representing the database table.
+type Wide10 struct {
+	ID           int32            `db:"id,pk" `
+	PilotID      int32            `db:"pilot_id" `
+	Text003      string           `db:"text_003" `
+	Integer004   int32            `db:"integer_004" `
+	Bigint005    int64            `db:"bigint_005" `
+	Boolean006   bool             `db:"boolean_006" `
+	Float007     float64          `db:"float_007" `
+	Timestamp008 time.Time        `db:"timestamp_008" `
+	Bytes009     []byte           `db:"bytes_009" `
+	NullText010  null.Val[string] `db:"null_text_010" `
+
+	R wide10R `db:"-" `
+}
+
+// Wide10Slice is an alias for a slice of pointers to Wide10.
+// This should almost always be used instead of []*Wide10.
+type Wide10Slice []*Wide10
+
+// Wide10s contains methods to work with the wide10 table
+var Wide10s = psql.NewTablex[*Wide10, Wide10Slice, *Wide10Setter]("", "wide10")
+
+// Wide10sQuery is a query on the wide10 table
+type Wide10sQuery = *psql.ViewQuery[*Wide10, Wide10Slice]
+
+// wide10R is where relationships are stored.
+type wide10R struct {
+	Pilot *Pilot // wide10.wide10_pilot_id_pilots_id_foreign
+}
+
+type wide10ColumnNames struct {
+	ID           string
+	PilotID      string
+	Text003      string
+	Integer004   string
+	Bigint005    string
+	Boolean006   string
+	Float007     string
+	Timestamp008 string
+	Bytes009     string
+	NullText010  string
+}
+
+var Wide10Columns = buildWide10Columns("wide10")
+
+type wide10Columns struct {
+	tableAlias   string
+	ID           psql.Expression
+	PilotID      psql.Expression
+	Text003      psql.Expression
+	Integer004   psql.Expression
+	Bigint005    psql.Expression
+	Boolean006   psql.Expression
+	Float007     psql.Expression
+	Timestamp008 psql.Expression
+	Bytes009     psql.Expression
+	NullText010  psql.Expression
+}
+
+func (c wide10Columns) Alias() string {
+	return c.tableAlias
+}
+
+func (wide10Columns) AliasedAs(alias string) wide10Columns {
+	return buildWide10Columns(alias)
+}
+
+func buildWide10Columns(alias string) wide10Columns {
+	return wide10Columns{
+		tableAlias:   alias,
+		ID:           psql.Quote(alias, "id"),
+		PilotID:      psql.Quote(alias, "pilot_id"),
+		Text003:      psql.Quote(alias, "text_003"),
+		Integer004:   psql.Quote(alias, "integer_004"),
+		Bigint005:    psql.Quote(alias, "bigint_005"),
+		Boolean006:   psql.Quote(alias, "boolean_006"),
+		Float007:     psql.Quote(alias, "float_007"),
+		Timestamp008: psql.Quote(alias, "timestamp_008"),
+		Bytes009:     psql.Quote(alias, "bytes_009"),
+		NullText010:  psql.Quote(alias, "null_text_010"),
+	}
+}
+
+type wide10Where[Q psql.Filterable] struct {
+	ID           psql.WhereMod[Q, int32]
+	PilotID      psql.WhereMod[Q, int32]
+	Text003      psql.WhereMod[Q, string]
+	Integer004   psql.WhereMod[Q, int32]
+	Bigint005    psql.WhereMod[Q, int64]
+	Boolean006   psql.WhereMod[Q, bool]
+	Float007     psql.WhereMod[Q, float64]
+	Timestamp008 psql.WhereMod[Q, time.Time]
+	Bytes009     psql.WhereMod[Q, []byte]
+	NullText010  psql.WhereNullMod[Q, string]
+}
+
+func (wide10Where[Q]) AliasedAs(alias string) wide10Where[Q] {
+	return buildWide10Where[Q](buildWide10Columns(alias))
+}
+
+func buildWide10Where[Q psql.Filterable](cols wide10Columns) wide10Where[Q] {
+	return wide10Where[Q]{
+		ID:           psql.Where[Q, int32](cols.ID),
+		PilotID:      psql.Where[Q, int32](cols.PilotID),
+		Text003:      psql.Where[Q, string](cols.Text003),
+		Integer004:   psql.Where[Q, int32](cols.Integer004),
+		Bigint005:    psql.Where[Q, int64](cols.Bigint005),
+		Boolean006:   psql.Where[Q, bool](cols.Boolean006),
+		Float007:     psql.Where[Q, float64](cols.Float007),
+		Timestamp008: psql.Where[Q, time.Time](cols.Timestamp008),
+		Bytes009:     psql.Where[Q, []byte](cols.Bytes009),
+		NullText010:  psql.WhereNull[Q, string](cols.NullText010),
+	}
+}
+
+var Wide10Errors = &wide10Errors{
+	ErrUniqueWide10Pkey: &UniqueConstraintError{s: "wide10_pkey"},
+}
+
+type wide10Errors struct {
+	ErrUniqueWide10Pkey *UniqueConstraintError
+}
+
+// Wide10Setter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type Wide10Setter struct {
+	ID           omit.Val[int32]      `db:"id,pk" `
+	PilotID      omit.Val[int32]      `db:"pilot_id" `
+	Text003      omit.Val[string]     `db:"text_003" `
+	Integer004   omit.Val[int32]      `db:"integer_004" `
+	Bigint005    omit.Val[int64]      `db:"bigint_005" `
+	Boolean006   omit.Val[bool]       `db:"boolean_006" `
+	Float007     omit.Val[float64]    `db:"float_007" `
+	Timestamp008 omit.Val[time.Time]  `db:"timestamp_008" `
+	Bytes009     omit.Val[[]byte]     `db:"bytes_009" `
+	NullText010  omitnull.Val[string] `db:"null_text_010" `
+}
+
+func (s Wide10Setter) SetColumns() []string {
+	vals := make([]string, 0, 10)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.Text003.IsUnset() {
+		vals = append(vals, "text_003")
+	}
+
+	if !s.Integer004.IsUnset() {
+		vals = append(vals, "integer_004")
+	}
+
+	if !s.Bigint005.IsUnset() {
+		vals = append(vals, "bigint_005")
+	}
+
+	if !s.Boolean006.IsUnset() {
+		vals = append(vals, "boolean_006")
+	}
+
+	if !s.Float007.IsUnset() {
+		vals = append(vals, "float_007")
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		vals = append(vals, "timestamp_008")
+	}
+
+	if !s.Bytes009.IsUnset() {
+		vals = append(vals, "bytes_009")
+	}
+
+	if !s.NullText010.IsUnset() {
+		vals = append(vals, "null_text_010")
+	}
+
+	return vals
+}
+
+func (s Wide10Setter) Overwrite(t *Wide10) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.Text003.IsUnset() {
+		t.Text003, _ = s.Text003.Get()
+	}
+	if !s.Integer004.IsUnset() {
+		t.Integer004, _ = s.Integer004.Get()
+	}
+	if !s.Bigint005.IsUnset() {
+		t.Bigint005, _ = s.Bigint005.Get()
+	}
+	if !s.Boolean006.IsUnset() {
+		t.Boolean006, _ = s.Boolean006.Get()
+	}
+	if !s.Float007.IsUnset() {
+		t.Float007, _ = s.Float007.Get()
+	}
+	if !s.Timestamp008.IsUnset() {
+		t.Timestamp008, _ = s.Timestamp008.Get()
+	}
+	if !s.Bytes009.IsUnset() {
+		t.Bytes009, _ = s.Bytes009.Get()
+	}
+	if !s.NullText010.IsUnset() {
+		t.NullText010, _ = s.NullText010.GetNull()
+	}
+}
+
+func (s *Wide10Setter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Wide10s.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 10)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		if s.Text003.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.Text003)
+		}
+
+		if s.Integer004.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Integer004)
+		}
+
+		if s.Bigint005.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.Bigint005)
+		}
+
+		if s.Boolean006.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.Boolean006)
+		}
+
+		if s.Float007.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Float007)
+		}
+
+		if s.Timestamp008.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Timestamp008)
+		}
+
+		if s.Bytes009.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Bytes009)
+		}
+
+		if s.NullText010.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.NullText010)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s Wide10Setter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s Wide10Setter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 10)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.Text003.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_003")...),
+			psql.Arg(s.Text003),
+		}})
+	}
+
+	if !s.Integer004.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_004")...),
+			psql.Arg(s.Integer004),
+		}})
+	}
+
+	if !s.Bigint005.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_005")...),
+			psql.Arg(s.Bigint005),
+		}})
+	}
+
+	if !s.Boolean006.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_006")...),
+			psql.Arg(s.Boolean006),
+		}})
+	}
+
+	if !s.Float007.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_007")...),
+			psql.Arg(s.Float007),
+		}})
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_008")...),
+			psql.Arg(s.Timestamp008),
+		}})
+	}
+
+	if !s.Bytes009.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_009")...),
+			psql.Arg(s.Bytes009),
+		}})
+	}
+
+	if !s.NullText010.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_010")...),
+			psql.Arg(s.NullText010),
+		}})
+	}
+
+	return exprs
+}
+
+// FindWide10 retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindWide10(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Wide10, error) {
+	if len(cols) == 0 {
+		return Wide10s.Query(
+			SelectWhere.Wide10s.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Wide10s.Query(
+		SelectWhere.Wide10s.ID.EQ(IDPK),
+		sm.Columns(Wide10s.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// Wide10Exists checks the presence of a single record by primary key
+func Wide10Exists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Wide10s.Query(
+		SelectWhere.Wide10s.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Wide10 is retrieved from the database
+func (o *Wide10) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide10s.AfterSelectHooks.RunHooks(ctx, exec, Wide10Slice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Wide10s.AfterInsertHooks.RunHooks(ctx, exec, Wide10Slice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide10s.AfterUpdateHooks.RunHooks(ctx, exec, Wide10Slice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Wide10s.AfterDeleteHooks.RunHooks(ctx, exec, Wide10Slice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Wide10
+func (o *Wide10) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Wide10) pkEQ() dialect.Expression {
+	return psql.Quote("wide10", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Wide10
+func (o *Wide10) Update(ctx context.Context, exec bob.Executor, s *Wide10Setter) error {
+	v, err := Wide10s.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Wide10 record with an executor
+func (o *Wide10) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Wide10s.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Wide10 using the executor
+func (o *Wide10) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Wide10s.Query(
+		SelectWhere.Wide10s.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after Wide10Slice is retrieved from the database
+func (o Wide10Slice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide10s.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Wide10s.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide10s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Wide10s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o Wide10Slice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("wide10", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o Wide10Slice) copyMatchingRows(from ...*Wide10) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o Wide10Slice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide10s.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide10:
+				o.copyMatchingRows(retrieved)
+			case []*Wide10:
+				o.copyMatchingRows(retrieved...)
+			case Wide10Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide10 or a slice of Wide10
+				// then run the AfterUpdateHooks on the slice
+				_, err = Wide10s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o Wide10Slice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide10s.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide10:
+				o.copyMatchingRows(retrieved)
+			case []*Wide10:
+				o.copyMatchingRows(retrieved...)
+			case Wide10Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide10 or a slice of Wide10
+				// then run the AfterDeleteHooks on the slice
+				_, err = Wide10s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o Wide10Slice) UpdateAll(ctx context.Context, exec bob.Executor, vals Wide10Setter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide10s.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o Wide10Slice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide10s.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o Wide10Slice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Wide10s.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type wide10Joins[Q dialect.Joinable] struct {
+	typ   string
+	Pilot func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j wide10Joins[Q]) aliasedAs(alias string) wide10Joins[Q] {
+	return buildWide10Joins[Q](buildWide10Columns(alias), j.typ)
+}
+
+func buildWide10Joins[Q dialect.Joinable](cols wide10Columns, typ string) wide10Joins[Q] {
+	return wide10Joins[Q]{
+		typ:   typ,
+		Pilot: wide10sJoinPilot[Q](cols, typ),
+	}
+}
+
+func wide10sJoinPilot[Q dialect.Joinable](from wide10Columns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *Wide10) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os Wide10Slice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Wide10) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("wide10 cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Wide10s = Wide10Slice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("wide10 has no relationship %q", name)
+	}
+}
+
+func PreloadWide10Pilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Wide10s,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Wide10s.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadWide10Pilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadWide10Pilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load Wide10Pilot", retrieved)
+		}
+
+		err := loader.LoadWide10Pilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadWide10Pilot loads the wide10's Pilot into the .R struct
+func (o *Wide10) LoadWide10Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Wide10s = Wide10Slice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadWide10Pilot loads the wide10's Pilot into the .R struct
+func (os Wide10Slice) LoadWide10Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			rel.R.Wide10s = append(rel.R.Wide10s, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachWide10Pilot0(ctx context.Context, exec bob.Executor, count int, wide100 *Wide10, pilot1 *Pilot) (*Wide10, error) {
+	setter := &Wide10Setter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := wide100.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachWide10Pilot0: %w", err)
+	}
+
+	return wide100, nil
+}
+
+func (wide100 *Wide10) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachWide10Pilot0(ctx, exec, 1, wide100, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide100.R.Pilot = pilot1
+
+	pilot1.R.Wide10s = append(pilot1.R.Wide10s, wide100)
+
+	return nil
+}
+
+func (wide100 *Wide10) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachWide10Pilot0(ctx, exec, 1, wide100, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide100.R.Pilot = pilot1
+
+	pilot1.R.Wide10s = append(pilot1.R.Wide10s, wide100)
+
+	return nil
+}
@@ -0,0 +1,3255 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Wide100 is an object representing the database table.
+type Wide100 struct {
+	ID               int32               `db:"id,pk" `
+	PilotID          int32               `db:"pilot_id" `
+	Text003          string              `db:"text_003" `
+	Integer004       int32               `db:"integer_004" `
+	Bigint005        int64               `db:"bigint_005" `
+	Boolean006       bool                `db:"boolean_006" `
+	Float007         float64             `db:"float_007" `
+	Timestamp008     time.Time           `db:"timestamp_008" `
+	Bytes009         []byte              `db:"bytes_009" `
+	NullText010      null.Val[string]    `db:"null_text_010" `
+	NullInteger011   null.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 null.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          string              `db:"text_013" `
+	Integer014       int32               `db:"integer_014" `
+	Bigint015        int64               `db:"bigint_015" `
+	Boolean016       bool                `db:"boolean_016" `
+	Float017         float64             `db:"float_017" `
+	Timestamp018     time.Time           `db:"timestamp_018" `
+	Bytes019         []byte              `db:"bytes_019" `
+	NullText020      null.Val[string]    `db:"null_text_020" `
+	NullInteger021   null.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 null.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          string              `db:"text_023" `
+	Integer024       int32               `db:"integer_024" `
+	Bigint025        int64               `db:"bigint_025" `
+	Boolean026       bool                `db:"boolean_026" `
+	Float027         float64             `db:"float_027" `
+	Timestamp028     time.Time           `db:"timestamp_028" `
+	Bytes029         []byte              `db:"bytes_029" `
+	NullText030      null.Val[string]    `db:"null_text_030" `
+	NullInteger031   null.Val[int32]     `db:"null_integer_031" `
+	NullTimestamp032 null.Val[time.Time] `db:"null_timestamp_032" `
+	Text033          string              `db:"text_033" `
+	Integer034       int32               `db:"integer_034" `
+	Bigint035        int64               `db:"bigint_035" `
+	Boolean036       bool                `db:"boolean_036" `
+	Float037         float64             `db:"float_037" `
+	Timestamp038     time.Time           `db:"timestamp_038" `
+	Bytes039         []byte              `db:"bytes_039" `
+	NullText040      null.Val[string]    `db:"null_text_040" `
+	NullInteger041   null.Val[int32]     `db:"null_integer_041" `
+	NullTimestamp042 null.Val[time.Time] `db:"null_timestamp_042" `
+	Text043          string              `db:"text_043" `
+	Integer044       int32               `db:"integer_044" `
+	Bigint045        int64               `db:"bigint_045" `
+	Boolean046       bool                `db:"boolean_046" `
+	Float047         float64             `db:"float_047" `
+	Timestamp048     time.Time           `db:"timestamp_048" `
+	Bytes049         []byte              `db:"bytes_049" `
+	NullText050      null.Val[string]    `db:"null_text_050" `
+	NullInteger051   null.Val[int32]     `db:"null_integer_051" `
+	NullTimestamp052 null.Val[time.Time] `db:"null_timestamp_052" `
+	Text053          string              `db:"text_053" `
+	Integer054       int32               `db:"integer_054" `
+	Bigint055        int64               `db:"bigint_055" `
+	Boolean056       bool                `db:"boolean_056" `
+	Float057         float64             `db:"float_057" `
+	Timestamp058     time.Time           `db:"timestamp_058" `
+	Bytes059         []byte              `db:"bytes_059" `
+	NullText060      null.Val[string]    `db:"null_text_060" `
+	NullInteger061   null.Val[int32]     `db:"null_integer_061" `
+	NullTimestamp062 null.Val[time.Time] `db:"null_timestamp_062" `
+	Text063          string              `db:"text_063" `
+	Integer064       int32               `db:"integer_064" `
+	Bigint065        int64               `db:"bigint_065" `
+	Boolean066       bool                `db:"boolean_066" `
+	Float067         float64             `db:"float_067" `
+	Timestamp068     time.Time           `db:"timestamp_068" `
+	Bytes069         []byte              `db:"bytes_069" `
+	NullText070      null.Val[string]    `db:"null_text_070" `
+	NullInteger071   null.Val[int32]     `db:"null_integer_071" `
+	NullTimestamp072 null.Val[time.Time] `db:"null_timestamp_072" `
+	Text073          string              `db:"text_073" `
+	Integer074       int32               `db:"integer_074" `
+	Bigint075        int64               `db:"bigint_075" `
+	Boolean076       bool                `db:"boolean_076" `
+	Float077         float64             `db:"float_077" `
+	Timestamp078     time.Time           `db:"timestamp_078" `
+	Bytes079         []byte              `db:"bytes_079" `
+	NullText080      null.Val[string]    `db:"null_text_080" `
+	NullInteger081   null.Val[int32]     `db:"null_integer_081" `
+	NullTimestamp082 null.Val[time.Time] `db:"null_timestamp_082" `
+	Text083          string              `db:"text_083" `
+	Integer084       int32               `db:"integer_084" `
+	Bigint085        int64               `db:"bigint_085" `
+	Boolean086       bool                `db:"boolean_086" `
+	Float087         float64             `db:"float_087" `
+	Timestamp088     time.Time           `db:"timestamp_088" `
+	Bytes089         []byte              `db:"bytes_089" `
+	NullText090      null.Val[string]    `db:"null_text_090" `
+	NullInteger091   null.Val[int32]     `db:"null_integer_091" `
+	NullTimestamp092 null.Val[time.Time] `db:"null_timestamp_092" `
+	Text093          string              `db:"text_093" `
+	Integer094       int32               `db:"integer_094" `
+	Bigint095        int64               `db:"bigint_095" `
+	Boolean096       bool                `db:"boolean_096" `
+	Float097         float64             `db:"float_097" `
+	Timestamp098     time.Time           `db:"timestamp_098" `
+	Bytes099         []byte              `db:"bytes_099" `
+	NullText100      null.Val[string]    `db:"null_text_100" `
+
+	R wide100R `db:"-" `
+}
+
+// Wide100Slice is an alias for a slice of pointers to Wide100.
+// This should almost always be used instead of []*Wide100.
+type Wide100Slice []*Wide100
+
+// Wide100s contains methods to work with the wide100 table
+var Wide100s = psql.NewTablex[*Wide100, Wide100Slice, *Wide100Setter]("", "wide100")
+
+// Wide100sQuery is a query on the wide100 table
+type Wide100sQuery = *psql.ViewQuery[*Wide100, Wide100Slice]
+
+// wide100R is where relationships are stored.
+type wide100R struct {
+	Pilot *Pilot // wide100.wide100_pilot_id_pilots_id_foreign
+}
+
+type wide100ColumnNames struct {
+	ID               string
+	PilotID          string
+	Text003          string
+	Integer004       string
+	Bigint005        string
+	Boolean006       string
+	Float007         string
+	Timestamp008     string
+	Bytes009         string
+	NullText010      string
+	NullInteger011   string
+	NullTimestamp012 string
+	Text013          string
+	Integer014       string
+	Bigint015        string
+	Boolean016       string
+	Float017         string
+	Timestamp018     string
+	Bytes019         string
+	NullText020      string
+	NullInteger021   string
+	NullTimestamp022 string
+	Text023          string
+	Integer024       string
+	Bigint025        string
+	Boolean026       string
+	Float027         string
+	Timestamp028     string
+	Bytes029         string
+	NullText030      string
+	NullInteger031   string
+	NullTimestamp032 string
+	Text033          string
+	Integer034       string
+	Bigint035        string
+	Boolean036       string
+	Float037         string
+	Timestamp038     string
+	Bytes039         string
+	NullText040      string
+	NullInteger041   string
+	NullTimestamp042 string
+	Text043          string
+	Integer044       string
+	Bigint045        string
+	Boolean046       string
+	Float047         string
+	Timestamp048     string
+	Bytes049         string
+	NullText050      string
+	NullInteger051   string
+	NullTimestamp052 string
+	Text053          string
+	Integer054       string
+	Bigint055        string
+	Boolean056       string
+	Float057         string
+	Timestamp058     string
+	Bytes059         string
+	NullText060      string
+	NullInteger061   string
+	NullTimestamp062 string
+	Text063          string
+	Integer064       string
+	Bigint065        string
+	Boolean066       string
+	Float067         string
+	Timestamp068     string
+	Bytes069         string
+	NullText070      string
+	NullInteger071   string
+	NullTimestamp072 string
+	Text073          string
+	Integer074       string
+	Bigint075        string
+	Boolean076       string
+	Float077         string
+	Timestamp078     string
+	Bytes079         string
+	NullText080      string
+	NullInteger081   string
+	NullTimestamp082 string
+	Text083          string
+	Integer084       string
+	Bigint085        string
+	Boolean086       string
+	Float087         string
+	Timestamp088     string
+	Bytes089         string
+	NullText090      string
+	NullInteger091   string
+	NullTimestamp092 string
+	Text093          string
+	Integer094       string
+	Bigint095        string
+	Boolean096       string
+	Float097         string
+	Timestamp098     string
+	Bytes099         string
+	NullText100      string
+}
+
+var Wide100Columns = buildWide100Columns("wide100")
+
+type wide100Columns struct {
+	tableAlias       string
+	ID               psql.Expression
+	PilotID          psql.Expression
+	Text003          psql.Expression
+	Integer004       psql.Expression
+	Bigint005        psql.Expression
+	Boolean006       psql.Expression
+	Float007         psql.Expression
+	Timestamp008     psql.Expression
+	Bytes009         psql.Expression
+	NullText010      psql.Expression
+	NullInteger011   psql.Expression
+	NullTimestamp012 psql.Expression
+	Text013          psql.Expression
+	Integer014       psql.Expression
+	Bigint015        psql.Expression
+	Boolean016       psql.Expression
+	Float017         psql.Expression
+	Timestamp018     psql.Expression
+	Bytes019         psql.Expression
+	NullText020      psql.Expression
+	NullInteger021   psql.Expression
+	NullTimestamp022 psql.Expression
+	Text023          psql.Expression
+	Integer024       psql.Expression
+	Bigint025        psql.Expression
+	Boolean026       psql.Expression
+	Float027         psql.Expression
+	Timestamp028     psql.Expression
+	Bytes029         psql.Expression
+	NullText030      psql.Expression
+	NullInteger031   psql.Expression
+	NullTimestamp032 psql.Expression
+	Text033          psql.Expression
+	Integer034       psql.Expression
+	Bigint035        psql.Expression
+	Boolean036       psql.Expression
+	Float037         psql.Expression
+	Timestamp038     psql.Expression
+	Bytes039         psql.Expression
+	NullText040      psql.Expression
+	NullInteger041   psql.Expression
+	NullTimestamp042 psql.Expression
+	Text043          psql.Expression
+	Integer044       psql.Expression
+	Bigint045        psql.Expression
+	Boolean046       psql.Expression
+	Float047         psql.Expression
+	Timestamp048     psql.Expression
+	Bytes049         psql.Expression
+	NullText050      psql.Expression
+	NullInteger051   psql.Expression
+	NullTimestamp052 psql.Expression
+	Text053          psql.Expression
+	Integer054       psql.Expression
+	Bigint055        psql.Expression
+	Boolean056       psql.Expression
+	Float057         psql.Expression
+	Timestamp058     psql.Expression
+	Bytes059         psql.Expression
+	NullText060      psql.Expression
+	NullInteger061   psql.Expression
+	NullTimestamp062 psql.Expression
+	Text063          psql.Expression
+	Integer064       psql.Expression
+	Bigint065        psql.Expression
+	Boolean066       psql.Expression
+	Float067         psql.Expression
+	Timestamp068     psql.Expression
+	Bytes069         psql.Expression
+	NullText070      psql.Expression
+	NullInteger071   psql.Expression
+	NullTimestamp072 psql.Expression
+	Text073          psql.Expression
+	Integer074       psql.Expression
+	Bigint075        psql.Expression
+	Boolean076       psql.Expression
+	Float077         psql.Expression
+	Timestamp078     psql.Expression
+	Bytes079         psql.Expression
+	NullText080      psql.Expression
+	NullInteger081   psql.Expression
+	NullTimestamp082 psql.Expression
+	Text083          psql.Expression
+	Integer084       psql.Expression
+	Bigint085        psql.Expression
+	Boolean086       psql.Expression
+	Float087         psql.Expression
+	Timestamp088     psql.Expression
+	Bytes089         psql.Expression
+	NullText090      psql.Expression
+	NullInteger091   psql.Expression
+	NullTimestamp092 psql.Expression
+	Text093          psql.Expression
+	Integer094       psql.Expression
+	Bigint095        psql.Expression
+	Boolean096       psql.Expression
+	Float097         psql.Expression
+	Timestamp098     psql.Expression
+	Bytes099         psql.Expression
+	NullText100      psql.Expression
+}
+
+func (c wide100Columns) Alias() string {
+	return c.tableAlias
+}
+
+func (wide100Columns) AliasedAs(alias string) wide100Columns {
+	return buildWide100Columns(alias)
+}
+
+func buildWide100Columns(alias string) wide100Columns {
+	return wide100Columns{
+		tableAlias:       alias,
+		ID:               psql.Quote(alias, "id"),
+		PilotID:          psql.Quote(alias, "pilot_id"),
+		Text003:          psql.Quote(alias, "text_003"),
+		Integer004:       psql.Quote(alias, "integer_004"),
+		Bigint005:        psql.Quote(alias, "bigint_005"),
+		Boolean006:       psql.Quote(alias, "boolean_006"),
+		Float007:         psql.Quote(alias, "float_007"),
+		Timestamp008:     psql.Quote(alias, "timestamp_008"),
+		Bytes009:         psql.Quote(alias, "bytes_009"),
+		NullText010:      psql.Quote(alias, "null_text_010"),
+		NullInteger011:   psql.Quote(alias, "null_integer_011"),
+		NullTimestamp012: psql.Quote(alias, "null_timestamp_012"),
+		Text013:          psql.Quote(alias, "text_013"),
+		Integer014:       psql.Quote(alias, "integer_014"),
+		Bigint015:        psql.Quote(alias, "bigint_015"),
+		Boolean016:       psql.Quote(alias, "boolean_016"),
+		Float017:         psql.Quote(alias, "float_017"),
+		Timestamp018:     psql.Quote(alias, "timestamp_018"),
+		Bytes019:         psql.Quote(alias, "bytes_019"),
+		NullText020:      psql.Quote(alias, "null_text_020"),
+		NullInteger021:   psql.Quote(alias, "null_integer_021"),
+		NullTimestamp022: psql.Quote(alias, "null_timestamp_022"),
+		Text023:          psql.Quote(alias, "text_023"),
+		Integer024:       psql.Quote(alias, "integer_024"),
+		Bigint025:        psql.Quote(alias, "bigint_025"),
+		Boolean026:       psql.Quote(alias, "boolean_026"),
+		Float027:         psql.Quote(alias, "float_027"),
+		Timestamp028:     psql.Quote(alias, "timestamp_028"),
+		Bytes029:         psql.Quote(alias, "bytes_029"),
+		NullText030:      psql.Quote(alias, "null_text_030"),
+		NullInteger031:   psql.Quote(alias, "null_integer_031"),
+		NullTimestamp032: psql.Quote(alias, "null_timestamp_032"),
+		Text033:          psql.Quote(alias, "text_033"),
+		Integer034:       psql.Quote(alias, "integer_034"),
+		Bigint035:        psql.Quote(alias, "bigint_035"),
+		Boolean036:       psql.Quote(alias, "boolean_036"),
+		Float037:         psql.Quote(alias, "float_037"),
+		Timestamp038:     psql.Quote(alias, "timestamp_038"),
+		Bytes039:         psql.Quote(alias, "bytes_039"),
+		NullText040:      psql.Quote(alias, "null_text_040"),
+		NullInteger041:   psql.Quote(alias, "null_integer_041"),
+		NullTimestamp042: psql.Quote(alias, "null_timestamp_042"),
+		Text043:          psql.Quote(alias, "text_043"),
+		Integer044:       psql.Quote(alias, "integer_044"),
+		Bigint045:        psql.Quote(alias, "bigint_045"),
+		Boolean046:       psql.Quote(alias, "boolean_046"),
+		Float047:         psql.Quote(alias, "float_047"),
+		Timestamp048:     psql.Quote(alias, "timestamp_048"),
+		Bytes049:         psql.Quote(alias, "bytes_049"),
+		NullText050:      psql.Quote(alias, "null_text_050"),
+		NullInteger051:   psql.Quote(alias, "null_integer_051"),
+		NullTimestamp052: psql.Quote(alias, "null_timestamp_052"),
+		Text053:          psql.Quote(alias, "text_053"),
+		Integer054:       psql.Quote(alias, "integer_054"),
+		Bigint055:        psql.Quote(alias, "bigint_055"),
+		Boolean056:       psql.Quote(alias, "boolean_056"),
+		Float057:         psql.Quote(alias, "float_057"),
+		Timestamp058:     psql.Quote(alias, "timestamp_058"),
+		Bytes059:         psql.Quote(alias, "bytes_059"),
+		NullText060:      psql.Quote(alias, "null_text_060"),
+		NullInteger061:   psql.Quote(alias, "null_integer_061"),
+		NullTimestamp062: psql.Quote(alias, "null_timestamp_062"),
+		Text063:          psql.Quote(alias, "text_063"),
+		Integer064:       psql.Quote(alias, "integer_064"),
+		Bigint065:        psql.Quote(alias, "bigint_065"),
+		Boolean066:       psql.Quote(alias, "boolean_066"),
+		Float067:         psql.Quote(alias, "float_067"),
+		Timestamp068:     psql.Quote(alias, "timestamp_068"),
+		Bytes069:         psql.Quote(alias, "bytes_069"),
+		NullText070:      psql.Quote(alias, "null_text_070"),
+		NullInteger071:   psql.Quote(alias, "null_integer_071"),
+		NullTimestamp072: psql.Quote(alias, "null_timestamp_072"),
+		Text073:          psql.Quote(alias, "text_073"),
+		Integer074:       psql.Quote(alias, "integer_074"),
+		Bigint075:        psql.Quote(alias, "bigint_075"),
+		Boolean076:       psql.Quote(alias, "boolean_076"),
+		Float077:         psql.Quote(alias, "float_077"),
+		Timestamp078:     psql.Quote(alias, "timestamp_078"),
+		Bytes079:         psql.Quote(alias, "bytes_079"),
+		NullText080:      psql.Quote(alias, "null_text_080"),
+		NullInteger081:   psql.Quote(alias, "null_integer_081"),
+		NullTimestamp082: psql.Quote(alias, "null_timestamp_082"),
+		Text083:          psql.Quote(alias, "text_083"),
+		Integer084:       psql.Quote(alias, "integer_084"),
+		Bigint085:        psql.Quote(alias, "bigint_085"),
+		Boolean086:       psql.Quote(alias, "boolean_086"),
+		Float087:         psql.Quote(alias, "float_087"),
+		Timestamp088:     psql.Quote(alias, "timestamp_088"),
+		Bytes089:         psql.Quote(alias, "bytes_089"),
+		NullText090:      psql.Quote(alias, "null_text_090"),
+		NullInteger091:   psql.Quote(alias, "null_integer_091"),
+		NullTimestamp092: psql.Quote(alias, "null_timestamp_092"),
+		Text093:          psql.Quote(alias, "text_093"),
+		Integer094:       psql.Quote(alias, "integer_094"),
+		Bigint095:        psql.Quote(alias, "bigint_095"),
+		Boolean096:       psql.Quote(alias, "boolean_096"),
+		Float097:         psql.Quote(alias, "float_097"),
+		Timestamp098:     psql.Quote(alias, "timestamp_098"),
+		Bytes099:         psql.Quote(alias, "bytes_099"),
+		NullText100:      psql.Quote(alias, "null_text_100"),
+	}
+}
+
+type wide100Where[Q psql.Filterable] struct {
+	ID               psql.WhereMod[Q, int32]
+	PilotID          psql.WhereMod[Q, int32]
+	Text003          psql.WhereMod[Q, string]
+	Integer004       psql.WhereMod[Q, int32]
+	Bigint005        psql.WhereMod[Q, int64]
+	Boolean006       psql.WhereMod[Q, bool]
+	Float007         psql.WhereMod[Q, float64]
+	Timestamp008     psql.WhereMod[Q, time.Time]
+	Bytes009         psql.WhereMod[Q, []byte]
+	NullText010      psql.WhereNullMod[Q, string]
+	NullInteger011   psql.WhereNullMod[Q, int32]
+	NullTimestamp012 psql.WhereNullMod[Q, time.Time]
+	Text013          psql.WhereMod[Q, string]
+	Integer014       psql.WhereMod[Q, int32]
+	Bigint015        psql.WhereMod[Q, int64]
+	Boolean016       psql.WhereMod[Q, bool]
+	Float017         psql.WhereMod[Q, float64]
+	Timestamp018     psql.WhereMod[Q, time.Time]
+	Bytes019         psql.WhereMod[Q, []byte]
+	NullText020      psql.WhereNullMod[Q, string]
+	NullInteger021   psql.WhereNullMod[Q, int32]
+	NullTimestamp022 psql.WhereNullMod[Q, time.Time]
+	Text023          psql.WhereMod[Q, string]
+	Integer024       psql.WhereMod[Q, int32]
+	Bigint025        psql.WhereMod[Q, int64]
+	Boolean026       psql.WhereMod[Q, bool]
+	Float027         psql.WhereMod[Q, float64]
+	Timestamp028     psql.WhereMod[Q, time.Time]
+	Bytes029         psql.WhereMod[Q, []byte]
+	NullText030      psql.WhereNullMod[Q, string]
+	NullInteger031   psql.WhereNullMod[Q, int32]
+	NullTimestamp032 psql.WhereNullMod[Q, time.Time]
+	Text033          psql.WhereMod[Q, string]
+	Integer034       psql.WhereMod[Q, int32]
+	Bigint035        psql.WhereMod[Q, int64]
+	Boolean036       psql.WhereMod[Q, bool]
+	Float037         psql.WhereMod[Q, float64]
+	Timestamp038     psql.WhereMod[Q, time.Time]
+	Bytes039         psql.WhereMod[Q, []byte]
+	NullText040      psql.WhereNullMod[Q, string]
+	NullInteger041   psql.WhereNullMod[Q, int32]
+	NullTimestamp042 psql.WhereNullMod[Q, time.Time]
+	Text043          psql.WhereMod[Q, string]
+	Integer044       psql.WhereMod[Q, int32]
+	Bigint045        psql.WhereMod[Q, int64]
+	Boolean046       psql.WhereMod[Q, bool]
+	Float047         psql.WhereMod[Q, float64]
+	Timestamp048     psql.WhereMod[Q, time.Time]
+	Bytes049         psql.WhereMod[Q, []byte]
+	NullText050      psql.WhereNullMod[Q, string]
+	NullInteger051   psql.WhereNullMod[Q, int32]
+	NullTimestamp052 psql.WhereNullMod[Q, time.Time]
+	Text053          psql.WhereMod[Q, string]
+	Integer054       psql.WhereMod[Q, int32]
+	Bigint055        psql.WhereMod[Q, int64]
+	Boolean056       psql.WhereMod[Q, bool]
+	Float057         psql.WhereMod[Q, float64]
+	Timestamp058     psql.WhereMod[Q, time.Time]
+	Bytes059         psql.WhereMod[Q, []byte]
+	NullText060      psql.WhereNullMod[Q, string]
+	NullInteger061   psql.WhereNullMod[Q, int32]
+	NullTimestamp062 psql.WhereNullMod[Q, time.Time]
+	Text063          psql.WhereMod[Q, string]
+	Integer064       psql.WhereMod[Q, int32]
+	Bigint065        psql.WhereMod[Q, int64]
+	Boolean066       psql.WhereMod[Q, bool]
+	Float067         psql.WhereMod[Q, float64]
+	Timestamp068     psql.WhereMod[Q, time.Time]
+	Bytes069         psql.WhereMod[Q, []byte]
+	NullText070      psql.WhereNullMod[Q, string]
+	NullInteger071   psql.WhereNullMod[Q, int32]
+	NullTimestamp072 psql.WhereNullMod[Q, time.Time]
+	Text073          psql.WhereMod[Q, string]
+	Integer074       psql.WhereMod[Q, int32]
+	Bigint075        psql.WhereMod[Q, int64]
+	Boolean076       psql.WhereMod[Q, bool]
+	Float077         psql.WhereMod[Q, float64]
+	Timestamp078     psql.WhereMod[Q, time.Time]
+	Bytes079         psql.WhereMod[Q, []byte]
+	NullText080      psql.WhereNullMod[Q, string]
+	NullInteger081   psql.WhereNullMod[Q, int32]
+	NullTimestamp082 psql.WhereNullMod[Q, time.Time]
+	Text083          psql.WhereMod[Q, string]
+	Integer084       psql.WhereMod[Q, int32]
+	Bigint085        psql.WhereMod[Q, int64]
+	Boolean086       psql.WhereMod[Q, bool]
+	Float087         psql.WhereMod[Q, float64]
+	Timestamp088     psql.WhereMod[Q, time.Time]
+	Bytes089         psql.WhereMod[Q, []byte]
+	NullText090      psql.WhereNullMod[Q, string]
+	NullInteger091   psql.WhereNullMod[Q, int32]
+	NullTimestamp092 psql.WhereNullMod[Q, time.Time]
+	Text093          psql.WhereMod[Q, string]
+	Integer094       psql.WhereMod[Q, int32]
+	Bigint095        psql.WhereMod[Q, int64]
+	Boolean096       psql.WhereMod[Q, bool]
+	Float097         psql.WhereMod[Q, float64]
+	Timestamp098     psql.WhereMod[Q, time.Time]
+	Bytes099         psql.WhereMod[Q, []byte]
+	NullText100      psql.WhereNullMod[Q, string]
+}
+
+func (wide100Where[Q]) AliasedAs(alias string) wide100Where[Q] {
+	return buildWide100Where[Q](buildWide100Columns(alias))
+}
+
+func buildWide100Where[Q psql.Filterable](cols wide100Columns) wide100Where[Q] {
+	return wide100Where[Q]{
+		ID:               psql.Where[Q, int32](cols.ID),
+		PilotID:          psql.Where[Q, int32](cols.PilotID),
+		Text003:          psql.Where[Q, string](cols.Text003),
+		Integer004:       psql.Where[Q, int32](cols.Integer004),
+		Bigint005:        psql.Where[Q, int64](cols.Bigint005),
+		Boolean006:       psql.Where[Q, bool](cols.Boolean006),
+		Float007:         psql.Where[Q, float64](cols.Float007),
+		Timestamp008:     psql.Where[Q, time.Time](cols.Timestamp008),
+		Bytes009:         psql.Where[Q, []byte](cols.Bytes009),
+		NullText010:      psql.WhereNull[Q, string](cols.NullText010),
+		NullInteger011:   psql.WhereNull[Q, int32](cols.NullInteger011),
+		NullTimestamp012: psql.WhereNull[Q, time.Time](cols.NullTimestamp012),
+		Text013:          psql.Where[Q, string](cols.Text013),
+		Integer014:       psql.Where[Q, int32](cols.Integer014),
+		Bigint015:        psql.Where[Q, int64](cols.Bigint015),
+		Boolean016:       psql.Where[Q, bool](cols.Boolean016),
+		Float017:         psql.Where[Q, float64](cols.Float017),
+		Timestamp018:     psql.Where[Q, time.Time](cols.Timestamp018),
+		Bytes019:         psql.Where[Q, []byte](cols.Bytes019),
+		NullText020:      psql.WhereNull[Q, string](cols.NullText020),
+		NullInteger021:   psql.WhereNull[Q, int32](cols.NullInteger021),
+		NullTimestamp022: psql.WhereNull[Q, time.Time](cols.NullTimestamp022),
+		Text023:          psql.Where[Q, string](cols.Text023),
+		Integer024:       psql.Where[Q, int32](cols.Integer024),
+		Bigint025:        psql.Where[Q, int64](cols.Bigint025),
+		Boolean026:       psql.Where[Q, bool](cols.Boolean026),
+		Float027:         psql.Where[Q, float64](cols.Float027),
+		Timestamp028:     psql.Where[Q, time.Time](cols.Timestamp028),
+		Bytes029:         psql.Where[Q, []byte](cols.Bytes029),
+		NullText030:      psql.WhereNull[Q, string](cols.NullText030),
+		NullInteger031:   psql.WhereNull[Q, int32](cols.NullInteger031),
+		NullTimestamp032: psql.WhereNull[Q, time.Time](cols.NullTimestamp032),
+		Text033:          psql.Where[Q, string](cols.Text033),
+		Integer034:       psql.Where[Q, int32](cols.Integer034),
+		Bigint035:        psql.Where[Q, int64](cols.Bigint035),
+		Boolean036:       psql.Where[Q, bool](cols.Boolean036),
+		Float037:         psql.Where[Q, float64](cols.Float037),
+		Timestamp038:     psql.Where[Q, time.Time](cols.Timestamp038),
+		Bytes039:         psql.Where[Q, []byte](cols.Bytes039),
+		NullText040:      psql.WhereNull[Q, string](cols.NullText040),
+		NullInteger041:   psql.WhereNull[Q, int32](cols.NullInteger041),
+		NullTimestamp042: psql.WhereNull[Q, time.Time](cols.NullTimestamp042),
+		Text043:          psql.Where[Q, string](cols.Text043),
+		Integer044:       psql.Where[Q, int32](cols.Integer044),
+		Bigint045:        psql.Where[Q, int64](cols.Bigint045),
+		Boolean046:       psql.Where[Q, bool](cols.Boolean046),
+		Float047:         psql.Where[Q, float64](cols.Float047),
+		Timestamp048:     psql.Where[Q, time.Time](cols.Timestamp048),
+		Bytes049:         psql.Where[Q, []byte](cols.Bytes049),
+		NullText050:      psql.WhereNull[Q, string](cols.NullText050),
+		NullInteger051:   psql.WhereNull[Q, int32](cols.NullInteger051),
+		NullTimestamp052: psql.WhereNull[Q, time.Time](cols.NullTimestamp052),
+		Text053:          psql.Where[Q, string](cols.Text053),
+		Integer054:       psql.Where[Q, int32](cols.Integer054),
+		Bigint055:        psql.Where[Q, int64](cols.Bigint055),
+		Boolean056:       psql.Where[Q, bool](cols.Boolean056),
+		Float057:         psql.Where[Q, float64](cols.Float057),
+		Timestamp058:     psql.Where[Q, time.Time](cols.Timestamp058),
+		Bytes059:         psql.Where[Q, []byte](cols.Bytes059),
+		NullText060:      psql.WhereNull[Q, string](cols.NullText060),
+		NullInteger061:   psql.WhereNull[Q, int32](cols.NullInteger061),
+		NullTimestamp062: psql.WhereNull[Q, time.Time](cols.NullTimestamp062),
+		Text063:          psql.Where[Q, string](cols.Text063),
+		Integer064:       psql.Where[Q, int32](cols.Integer064),
+		Bigint065:        psql.Where[Q, int64](cols.Bigint065),
+		Boolean066:       psql.Where[Q, bool](cols.Boolean066),
+		Float067:         psql.Where[Q, float64](cols.Float067),
+		Timestamp068:     psql.Where[Q, time.Time](cols.Timestamp068),
+		Bytes069:         psql.Where[Q, []byte](cols.Bytes069),
+		NullText070:      psql.WhereNull[Q, string](cols.NullText070),
+		NullInteger071:   psql.WhereNull[Q, int32](cols.NullInteger071),
+		NullTimestamp072: psql.WhereNull[Q, time.Time](cols.NullTimestamp072),
+		Text073:          psql.Where[Q, string](cols.Text073),
+		Integer074:       psql.Where[Q, int32](cols.Integer074),
+		Bigint075:        psql.Where[Q, int64](cols.Bigint075),
+		Boolean076:       psql.Where[Q, bool](cols.Boolean076),
+		Float077:         psql.Where[Q, float64](cols.Float077),
+		Timestamp078:     psql.Where[Q, time.Time](cols.Timestamp078),
+		Bytes079:         psql.Where[Q, []byte](cols.Bytes079),
+		NullText080:      psql.WhereNull[Q, string](cols.NullText080),
+		NullInteger081:   psql.WhereNull[Q, int32](cols.NullInteger081),
+		NullTimestamp082: psql.WhereNull[Q, time.Time](cols.NullTimestamp082),
+		Text083:          psql.Where[Q, string](cols.Text083),
+		Integer084:       psql.Where[Q, int32](cols.Integer084),
+		Bigint085:        psql.Where[Q, int64](cols.Bigint085),
+		Boolean086:       psql.Where[Q, bool](cols.Boolean086),
+		Float087:         psql.Where[Q, float64](cols.Float087),
+		Timestamp088:     psql.Where[Q, time.Time](cols.Timestamp088),
+		Bytes089:         psql.Where[Q, []byte](cols.Bytes089),
+		NullText090:      psql.WhereNull[Q, string](cols.NullText090),
+		NullInteger091:   psql.WhereNull[Q, int32](cols.NullInteger091),
+		NullTimestamp092: psql.WhereNull[Q, time.Time](cols.NullTimestamp092),
+		Text093:          psql.Where[Q, string](cols.Text093),
+		Integer094:       psql.Where[Q, int32](cols.Integer094),
+		Bigint095:        psql.Where[Q, int64](cols.Bigint095),
+		Boolean096:       psql.Where[Q, bool](cols.Boolean096),
+		Float097:         psql.Where[Q, float64](cols.Float097),
+		Timestamp098:     psql.Where[Q, time.Time](cols.Timestamp098),
+		Bytes099:         psql.Where[Q, []byte](cols.Bytes099),
+		NullText100:      psql.WhereNull[Q, string](cols.NullText100),
+	}
+}
+
+var Wide100Errors = &wide100Errors{
+	ErrUniqueWide100Pkey: &UniqueConstraintError{s: "wide100_pkey"},
+}
+
+type wide100Errors struct {
+	ErrUniqueWide100Pkey *UniqueConstraintError
+}
+
+// Wide100Setter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type Wide100Setter struct {
+	ID               omit.Val[int32]         `db:"id,pk" `
+	PilotID          omit.Val[int32]         `db:"pilot_id" `
+	Text003          omit.Val[string]        `db:"text_003" `
+	Integer004       omit.Val[int32]         `db:"integer_004" `
+	Bigint005        omit.Val[int64]         `db:"bigint_005" `
+	Boolean006       omit.Val[bool]          `db:"boolean_006" `
+	Float007         omit.Val[float64]       `db:"float_007" `
+	Timestamp008     omit.Val[time.Time]     `db:"timestamp_008" `
+	Bytes009         omit.Val[[]byte]        `db:"bytes_009" `
+	NullText010      omitnull.Val[string]    `db:"null_text_010" `
+	NullInteger011   omitnull.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 omitnull.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          omit.Val[string]        `db:"text_013" `
+	Integer014       omit.Val[int32]         `db:"integer_014" `
+	Bigint015        omit.Val[int64]         `db:"bigint_015" `
+	Boolean016       omit.Val[bool]          `db:"boolean_016" `
+	Float017         omit.Val[float64]       `db:"float_017" `
+	Timestamp018     omit.Val[time.Time]     `db:"timestamp_018" `
+	Bytes019         omit.Val[[]byte]        `db:"bytes_019" `
+	NullText020      omitnull.Val[string]    `db:"null_text_020" `
+	NullInteger021   omitnull.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 omitnull.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          omit.Val[string]        `db:"text_023" `
+	Integer024       omit.Val[int32]         `db:"integer_024" `
+	Bigint025        omit.Val[int64]         `db:"bigint_025" `
+	Boolean026       omit.Val[bool]          `db:"boolean_026" `
+	Float027         omit.Val[float64]       `db:"float_027" `
+	Timestamp028     omit.Val[time.Time]     `db:"timestamp_028" `
+	Bytes029         omit.Val[[]byte]        `db:"bytes_029" `
+	NullText030      omitnull.Val[string]    `db:"null_text_030" `
+	NullInteger031   omitnull.Val[int32]     `db:"null_integer_031" `
+	NullTimestamp032 omitnull.Val[time.Time] `db:"null_timestamp_032" `
+	Text033          omit.Val[string]        `db:"text_033" `
+	Integer034       omit.Val[int32]         `db:"integer_034" `
+	Bigint035        omit.Val[int64]         `db:"bigint_035" `
+	Boolean036       omit.Val[bool]          `db:"boolean_036" `
+	Float037         omit.Val[float64]       `db:"float_037" `
+	Timestamp038     omit.Val[time.Time]     `db:"timestamp_038" `
+	Bytes039         omit.Val[[]byte]        `db:"bytes_039" `
+	NullText040      omitnull.Val[string]    `db:"null_text_040" `
+	NullInteger041   omitnull.Val[int32]     `db:"null_integer_041" `
+	NullTimestamp042 omitnull.Val[time.Time] `db:"null_timestamp_042" `
+	Text043          omit.Val[string]        `db:"text_043" `
+	Integer044       omit.Val[int32]         `db:"integer_044" `
+	Bigint045        omit.Val[int64]         `db:"bigint_045" `
+	Boolean046       omit.Val[bool]          `db:"boolean_046" `
+	Float047         omit.Val[float64]       `db:"float_047" `
+	Timestamp048     omit.Val[time.Time]     `db:"timestamp_048" `
+	Bytes049         omit.Val[[]byte]        `db:"bytes_049" `
+	NullText050      omitnull.Val[string]    `db:"null_text_050" `
+	NullInteger051   omitnull.Val[int32]     `db:"null_integer_051" `
+	NullTimestamp052 omitnull.Val[time.Time] `db:"null_timestamp_052" `
+	Text053          omit.Val[string]        `db:"text_053" `
+	Integer054       omit.Val[int32]         `db:"integer_054" `
+	Bigint055        omit.Val[int64]         `db:"bigint_055" `
+	Boolean056       omit.Val[bool]          `db:"boolean_056" `
+	Float057         omit.Val[float64]       `db:"float_057" `
+	Timestamp058     omit.Val[time.Time]     `db:"timestamp_058" `
+	Bytes059         omit.Val[[]byte]        `db:"bytes_059" `
+	NullText060      omitnull.Val[string]    `db:"null_text_060" `
+	NullInteger061   omitnull.Val[int32]     `db:"null_integer_061" `
+	NullTimestamp062 omitnull.Val[time.Time] `db:"null_timestamp_062" `
+	Text063          omit.Val[string]        `db:"text_063" `
+	Integer064       omit.Val[int32]         `db:"integer_064" `
+	Bigint065        omit.Val[int64]         `db:"bigint_065" `
+	Boolean066       omit.Val[bool]          `db:"boolean_066" `
+	Float067         omit.Val[float64]       `db:"float_067" `
+	Timestamp068     omit.Val[time.Time]     `db:"timestamp_068" `
+	Bytes069         omit.Val[[]byte]        `db:"bytes_069" `
+	NullText070      omitnull.Val[string]    `db:"null_text_070" `
+	NullInteger071   omitnull.Val[int32]     `db:"null_integer_071" `
+	NullTimestamp072 omitnull.Val[time.Time] `db:"null_timestamp_072" `
+	Text073          omit.Val[string]        `db:"text_073" `
+	Integer074       omit.Val[int32]         `db:"integer_074" `
+	Bigint075        omit.Val[int64]         `db:"bigint_075" `
+	Boolean076       omit.Val[bool]          `db:"boolean_076" `
+	Float077         omit.Val[float64]       `db:"float_077" `
+	Timestamp078     omit.Val[time.Time]     `db:"timestamp_078" `
+	Bytes079         omit.Val[[]byte]        `db:"bytes_079" `
+	NullText080      omitnull.Val[string]    `db:"null_text_080" `
+	NullInteger081   omitnull.Val[int32]     `db:"null_integer_081" `
+	NullTimestamp082 omitnull.Val[time.Time] `db:"null_timestamp_082" `
+	Text083          omit.Val[string]        `db:"text_083" `
+	Integer084       omit.Val[int32]         `db:"integer_084" `
+	Bigint085        omit.Val[int64]         `db:"bigint_085" `
+	Boolean086       omit.Val[bool]          `db:"boolean_086" `
+	Float087         omit.Val[float64]       `db:"float_087" `
+	Timestamp088     omit.Val[time.Time]     `db:"timestamp_088" `
+	Bytes089         omit.Val[[]byte]        `db:"bytes_089" `
+	NullText090      omitnull.Val[string]    `db:"null_text_090" `
+	NullInteger091   omitnull.Val[int32]     `db:"null_integer_091" `
+	NullTimestamp092 omitnull.Val[time.Time] `db:"null_timestamp_092" `
+	Text093          omit.Val[string]        `db:"text_093" `
+	Integer094       omit.Val[int32]         `db:"integer_094" `
+	Bigint095        omit.Val[int64]         `db:"bigint_095" `
+	Boolean096       omit.Val[bool]          `db:"boolean_096" `
+	Float097         omit.Val[float64]       `db:"float_097" `
+	Timestamp098     omit.Val[time.Time]     `db:"timestamp_098" `
+	Bytes099         omit.Val[[]byte]        `db:"bytes_099" `
+	NullText100      omitnull.Val[string]    `db:"null_text_100" `
+}
+
+func (s Wide100Setter) SetColumns() []string {
+	vals := make([]string, 0, 100)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.Text003.IsUnset() {
+		vals = append(vals, "text_003")
+	}
+
+	if !s.Integer004.IsUnset() {
+		vals = append(vals, "integer_004")
+	}
+
+	if !s.Bigint005.IsUnset() {
+		vals = append(vals, "bigint_005")
+	}
+
+	if !s.Boolean006.IsUnset() {
+		vals = append(vals, "boolean_006")
+	}
+
+	if !s.Float007.IsUnset() {
+		vals = append(vals, "float_007")
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		vals = append(vals, "timestamp_008")
+	}
+
+	if !s.Bytes009.IsUnset() {
+		vals = append(vals, "bytes_009")
+	}
+
+	if !s.NullText010.IsUnset() {
+		vals = append(vals, "null_text_010")
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		vals = append(vals, "null_integer_011")
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		vals = append(vals, "null_timestamp_012")
+	}
+
+	if !s.Text013.IsUnset() {
+		vals = append(vals, "text_013")
+	}
+
+	if !s.Integer014.IsUnset() {
+		vals = append(vals, "integer_014")
+	}
+
+	if !s.Bigint015.IsUnset() {
+		vals = append(vals, "bigint_015")
+	}
+
+	if !s.Boolean016.IsUnset() {
+		vals = append(vals, "boolean_016")
+	}
+
+	if !s.Float017.IsUnset() {
+		vals = append(vals, "float_017")
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		vals = append(vals, "timestamp_018")
+	}
+
+	if !s.Bytes019.IsUnset() {
+		vals = append(vals, "bytes_019")
+	}
+
+	if !s.NullText020.IsUnset() {
+		vals = append(vals, "null_text_020")
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		vals = append(vals, "null_integer_021")
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		vals = append(vals, "null_timestamp_022")
+	}
+
+	if !s.Text023.IsUnset() {
+		vals = append(vals, "text_023")
+	}
+
+	if !s.Integer024.IsUnset() {
+		vals = append(vals, "integer_024")
+	}
+
+	if !s.Bigint025.IsUnset() {
+		vals = append(vals, "bigint_025")
+	}
+
+	if !s.Boolean026.IsUnset() {
+		vals = append(vals, "boolean_026")
+	}
+
+	if !s.Float027.IsUnset() {
+		vals = append(vals, "float_027")
+	}
+
+	if !s.Timestamp028.IsUnset() {
+		vals = append(vals, "timestamp_028")
+	}
+
+	if !s.Bytes029.IsUnset() {
+		vals = append(vals, "bytes_029")
+	}
+
+	if !s.NullText030.IsUnset() {
+		vals = append(vals, "null_text_030")
+	}
+
+	if !s.NullInteger031.IsUnset() {
+		vals = append(vals, "null_integer_031")
+	}
+
+	if !s.NullTimestamp032.IsUnset() {
+		vals = append(vals, "null_timestamp_032")
+	}
+
+	if !s.Text033.IsUnset() {
+		vals = append(vals, "text_033")
+	}
+
+	if !s.Integer034.IsUnset() {
+		vals = append(vals, "integer_034")
+	}
+
+	if !s.Bigint035.IsUnset() {
+		vals = append(vals, "bigint_035")
+	}
+
+	if !s.Boolean036.IsUnset() {
+		vals = append(vals, "boolean_036")
+	}
+
+	if !s.Float037.IsUnset() {
+		vals = append(vals, "float_037")
+	}
+
+	if !s.Timestamp038.IsUnset() {
+		vals = append(vals, "timestamp_038")
+	}
+
+	if !s.Bytes039.IsUnset() {
+		vals = append(vals, "bytes_039")
+	}
+
+	if !s.NullText040.IsUnset() {
+		vals = append(vals, "null_text_040")
+	}
+
+	if !s.NullInteger041.IsUnset() {
+		vals = append(vals, "null_integer_041")
+	}
+
+	if !s.NullTimestamp042.IsUnset() {
+		vals = append(vals, "null_timestamp_042")
+	}
+
+	if !s.Text043.IsUnset() {
+		vals = append(vals, "text_043")
+	}
+
+	if !s.Integer044.IsUnset() {
+		vals = append(vals, "integer_044")
+	}
+
+	if !s.Bigint045.IsUnset() {
+		vals = append(vals, "bigint_045")
+	}
+
+	if !s.Boolean046.IsUnset() {
+		vals = append(vals, "boolean_046")
+	}
+
+	if !s.Float047.IsUnset() {
+		vals = append(vals, "float_047")
+	}
+
+	if !s.Timestamp048.IsUnset() {
+		vals = append(vals, "timestamp_048")
+	}
+
+	if !s.Bytes049.IsUnset() {
+		vals = append(vals, "bytes_049")
+	}
+
+	if !s.NullText050.IsUnset() {
+		vals = append(vals, "null_text_050")
+	}
+
+	if !s.NullInteger051.IsUnset() {
+		vals = append(vals, "null_integer_051")
+	}
+
+	if !s.NullTimestamp052.IsUnset() {
+		vals = append(vals, "null_timestamp_052")
+	}
+
+	if !s.Text053.IsUnset() {
+		vals = append(vals, "text_053")
+	}
+
+	if !s.Integer054.IsUnset() {
+		vals = append(vals, "integer_054")
+	}
+
+	if !s.Bigint055.IsUnset() {
+		vals = append(vals, "bigint_055")
+	}
+
+	if !s.Boolean056.IsUnset() {
+		vals = append(vals, "boolean_056")
+	}
+
+	if !s.Float057.IsUnset() {
+		vals = append(vals, "float_057")
+	}
+
+	if !s.Timestamp058.IsUnset() {
+		vals = append(vals, "timestamp_058")
+	}
+
+	if !s.Bytes059.IsUnset() {
+		vals = append(vals, "bytes_059")
+	}
+
+	if !s.NullText060.IsUnset() {
+		vals = append(vals, "null_text_060")
+	}
+
+	if !s.NullInteger061.IsUnset() {
+		vals = append(vals, "null_integer_061")
+	}
+
+	if !s.NullTimestamp062.IsUnset() {
+		vals = append(vals, "null_timestamp_062")
+	}
+
+	if !s.Text063.IsUnset() {
+		vals = append(vals, "text_063")
+	}
+
+	if !s.Integer064.IsUnset() {
+		vals = append(vals, "integer_064")
+	}
+
+	if !s.Bigint065.IsUnset() {
+		vals = append(vals, "bigint_065")
+	}
+
+	if !s.Boolean066.IsUnset() {
+		vals = append(vals, "boolean_066")
+	}
+
+	if !s.Float067.IsUnset() {
+		vals = append(vals, "float_067")
+	}
+
+	if !s.Timestamp068.IsUnset() {
+		vals = append(vals, "timestamp_068")
+	}
+
+	if !s.Bytes069.IsUnset() {
+		vals = append(vals, "bytes_069")
+	}
+
+	if !s.NullText070.IsUnset() {
+		vals = append(vals, "null_text_070")
+	}
+
+	if !s.NullInteger071.IsUnset() {
+		vals = append(vals, "null_integer_071")
+	}
+
+	if !s.NullTimestamp072.IsUnset() {
+		vals = append(vals, "null_timestamp_072")
+	}
+
+	if !s.Text073.IsUnset() {
+		vals = append(vals, "text_073")
+	}
+
+	if !s.Integer074.IsUnset() {
+		vals = append(vals, "integer_074")
+	}
+
+	if !s.Bigint075.IsUnset() {
+		vals = append(vals, "bigint_075")
+	}
+
+	if !s.Boolean076.IsUnset() {
+		vals = append(vals, "boolean_076")
+	}
+
+	if !s.Float077.IsUnset() {
+		vals = append(vals, "float_077")
+	}
+
+	if !s.Timestamp078.IsUnset() {
+		vals = append(vals, "timestamp_078")
+	}
+
+	if !s.Bytes079.IsUnset() {
+		vals = append(vals, "bytes_079")
+	}
+
+	if !s.NullText080.IsUnset() {
+		vals = append(vals, "null_text_080")
+	}
+
+	if !s.NullInteger081.IsUnset() {
+		vals = append(vals, "null_integer_081")
+	}
+
+	if !s.NullTimestamp082.IsUnset() {
+		vals = append(vals, "null_timestamp_082")
+	}
+
+	if !s.Text083.IsUnset() {
+		vals = append(vals, "text_083")
+	}
+
+	if !s.Integer084.IsUnset() {
+		vals = append(vals, "integer_084")
+	}
+
+	if !s.Bigint085.IsUnset() {
+		vals = append(vals, "bigint_085")
+	}
+
+	if !s.Boolean086.IsUnset() {
+		vals = append(vals, "boolean_086")
+	}
+
+	if !s.Float087.IsUnset() {
+		vals = append(vals, "float_087")
+	}
+
+	if !s.Timestamp088.IsUnset() {
+		vals = append(vals, "timestamp_088")
+	}
+
+	if !s.Bytes089.IsUnset() {
+		vals = append(vals, "bytes_089")
+	}
+
+	if !s.NullText090.IsUnset() {
+		vals = append(vals, "null_text_090")
+	}
+
+	if !s.NullInteger091.IsUnset() {
+		vals = append(vals, "null_integer_091")
+	}
+
+	if !s.NullTimestamp092.IsUnset() {
+		vals = append(vals, "null_timestamp_092")
+	}
+
+	if !s.Text093.IsUnset() {
+		vals = append(vals, "text_093")
+	}
+
+	if !s.Integer094.IsUnset() {
+		vals = append(vals, "integer_094")
+	}
+
+	if !s.Bigint095.IsUnset() {
+		vals = append(vals, "bigint_095")
+	}
+
+	if !s.Boolean096.IsUnset() {
+		vals = append(vals, "boolean_096")
+	}
+
+	if !s.Float097.IsUnset() {
+		vals = append(vals, "float_097")
+	}
+
+	if !s.Timestamp098.IsUnset() {
+		vals = append(vals, "timestamp_098")
+	}
+
+	if !s.Bytes099.IsUnset() {
+		vals = append(vals, "bytes_099")
+	}
+
+	if !s.NullText100.IsUnset() {
+		vals = append(vals, "null_text_100")
+	}
+
+	return vals
+}
+
+func (s Wide100Setter) Overwrite(t *Wide100) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.Text003.IsUnset() {
+		t.Text003, _ = s.Text003.Get()
+	}
+	if !s.Integer004.IsUnset() {
+		t.Integer004, _ = s.Integer004.Get()
+	}
+	if !s.Bigint005.IsUnset() {
+		t.Bigint005, _ = s.Bigint005.Get()
+	}
+	if !s.Boolean006.IsUnset() {
+		t.Boolean006, _ = s.Boolean006.Get()
+	}
+	if !s.Float007.IsUnset() {
+		t.Float007, _ = s.Float007.Get()
+	}
+	if !s.Timestamp008.IsUnset() {
+		t.Timestamp008, _ = s.Timestamp008.Get()
+	}
+	if !s.Bytes009.IsUnset() {
+		t.Bytes009, _ = s.Bytes009.Get()
+	}
+	if !s.NullText010.IsUnset() {
+		t.NullText010, _ = s.NullText010.GetNull()
+	}
+	if !s.NullInteger011.IsUnset() {
+		t.NullInteger011, _ = s.NullInteger011.GetNull()
+	}
+	if !s.NullTimestamp012.IsUnset() {
+		t.NullTimestamp012, _ = s.NullTimestamp012.GetNull()
+	}
+	if !s.Text013.IsUnset() {
+		t.Text013, _ = s.Text013.Get()
+	}
+	if !s.Integer014.IsUnset() {
+		t.Integer014, _ = s.Integer014.Get()
+	}
+	if !s.Bigint015.IsUnset() {
+		t.Bigint015, _ = s.Bigint015.Get()
+	}
+	if !s.Boolean016.IsUnset() {
+		t.Boolean016, _ = s.Boolean016.Get()
+	}
+	if !s.Float017.IsUnset() {
+		t.Float017, _ = s.Float017.Get()
+	}
+	if !s.Timestamp018.IsUnset() {
+		t.Timestamp018, _ = s.Timestamp018.Get()
+	}
+	if !s.Bytes019.IsUnset() {
+		t.Bytes019, _ = s.Bytes019.Get()
+	}
+	if !s.NullText020.IsUnset() {
+		t.NullText020, _ = s.NullText020.GetNull()
+	}
+	if !s.NullInteger021.IsUnset() {
+		t.NullInteger021, _ = s.NullInteger021.GetNull()
+	}
+	if !s.NullTimestamp022.IsUnset() {
+		t.NullTimestamp022, _ = s.NullTimestamp022.GetNull()
+	}
+	if !s.Text023.IsUnset() {
+		t.Text023, _ = s.Text023.Get()
+	}
+	if !s.Integer024.IsUnset() {
+		t.Integer024, _ = s.Integer024.Get()
+	}
+	if !s.Bigint025.IsUnset() {
+		t.Bigint025, _ = s.Bigint025.Get()
+	}
+	if !s.Boolean026.IsUnset() {
+		t.Boolean026, _ = s.Boolean026.Get()
+	}
+	if !s.Float027.IsUnset() {
+		t.Float027, _ = s.Float027.Get()
+	}
+	if !s.Timestamp028.IsUnset() {
+		t.Timestamp028, _ = s.Timestamp028.Get()
+	}
+	if !s.Bytes029.IsUnset() {
+		t.Bytes029, _ = s.Bytes029.Get()
+	}
+	if !s.NullText030.IsUnset() {
+		t.NullText030, _ = s.NullText030.GetNull()
+	}
+	if !s.NullInteger031.IsUnset() {
+		t.NullInteger031, _ = s.NullInteger031.GetNull()
+	}
+	if !s.NullTimestamp032.IsUnset() {
+		t.NullTimestamp032, _ = s.NullTimestamp032.GetNull()
+	}
+	if !s.Text033.IsUnset() {
+		t.Text033, _ = s.Text033.Get()
+	}
+	if !s.Integer034.IsUnset() {
+		t.Integer034, _ = s.Integer034.Get()
+	}
+	if !s.Bigint035.IsUnset() {
+		t.Bigint035, _ = s.Bigint035.Get()
+	}
+	if !s.Boolean036.IsUnset() {
+		t.Boolean036, _ = s.Boolean036.Get()
+	}
+	if !s.Float037.IsUnset() {
+		t.Float037, _ = s.Float037.Get()
+	}
+	if !s.Timestamp038.IsUnset() {
+		t.Timestamp038, _ = s.Timestamp038.Get()
+	}
+	if !s.Bytes039.IsUnset() {
+		t.Bytes039, _ = s.Bytes039.Get()
+	}
+	if !s.NullText040.IsUnset() {
+		t.NullText040, _ = s.NullText040.GetNull()
+	}
+	if !s.NullInteger041.IsUnset() {
+		t.NullInteger041, _ = s.NullInteger041.GetNull()
+	}
+	if !s.NullTimestamp042.IsUnset() {
+		t.NullTimestamp042, _ = s.NullTimestamp042.GetNull()
+	}
+	if !s.Text043.IsUnset() {
+		t.Text043, _ = s.Text043.Get()
+	}
+	if !s.Integer044.IsUnset() {
+		t.Integer044, _ = s.Integer044.Get()
+	}
+	if !s.Bigint045.IsUnset() {
+		t.Bigint045, _ = s.Bigint045.Get()
+	}
+	if !s.Boolean046.IsUnset() {
+		t.Boolean046, _ = s.Boolean046.Get()
+	}
+	if !s.Float047.IsUnset() {
+		t.Float047, _ = s.Float047.Get()
+	}
+	if !s.Timestamp048.IsUnset() {
+		t.Timestamp048, _ = s.Timestamp048.Get()
+	}
+	if !s.Bytes049.IsUnset() {
+		t.Bytes049, _ = s.Bytes049.Get()
+	}
+	if !s.NullText050.IsUnset() {
+		t.NullText050, _ = s.NullText050.GetNull()
+	}
+	if !s.NullInteger051.IsUnset() {
+		t.NullInteger051, _ = s.NullInteger051.GetNull()
+	}
+	if !s.NullTimestamp052.IsUnset() {
+		t.NullTimestamp052, _ = s.NullTimestamp052.GetNull()
+	}
+	if !s.Text053.IsUnset() {
+		t.Text053, _ = s.Text053.Get()
+	}
+	if !s.Integer054.IsUnset() {
+		t.Integer054, _ = s.Integer054.Get()
+	}
+	if !s.Bigint055.IsUnset() {
+		t.Bigint055, _ = s.Bigint055.Get()
+	}
+	if !s.Boolean056.IsUnset() {
+		t.Boolean056, _ = s.Boolean056.Get()
+	}
+	if !s.Float057.IsUnset() {
+		t.Float057, _ = s.Float057.Get()
+	}
+	if !s.Timestamp058.IsUnset() {
+		t.Timestamp058, _ = s.Timestamp058.Get()
+	}
+	if !s.Bytes059.IsUnset() {
+		t.Bytes059, _ = s.Bytes059.Get()
+	}
+	if !s.NullText060.IsUnset() {
+		t.NullText060, _ = s.NullText060.GetNull()
+	}
+	if !s.NullInteger061.IsUnset() {
+		t.NullInteger061, _ = s.NullInteger061.GetNull()
+	}
+	if !s.NullTimestamp062.IsUnset() {
+		t.NullTimestamp062, _ = s.NullTimestamp062.GetNull()
+	}
+	if !s.Text063.IsUnset() {
+		t.Text063, _ = s.Text063.Get()
+	}
+	if !s.Integer064.IsUnset() {
+		t.Integer064, _ = s.Integer064.Get()
+	}
+	if !s.Bigint065.IsUnset() {
+		t.Bigint065, _ = s.Bigint065.Get()
+	}
+	if !s.Boolean066.IsUnset() {
+		t.Boolean066, _ = s.Boolean066.Get()
+	}
+	if !s.Float067.IsUnset() {
+		t.Float067, _ = s.Float067.Get()
+	}
+	if !s.Timestamp068.IsUnset() {
+		t.Timestamp068, _ = s.Timestamp068.Get()
+	}
+	if !s.Bytes069.IsUnset() {
+		t.Bytes069, _ = s.Bytes069.Get()
+	}
+	if !s.NullText070.IsUnset() {
+		t.NullText070, _ = s.NullText070.GetNull()
+	}
+	if !s.NullInteger071.IsUnset() {
+		t.NullInteger071, _ = s.NullInteger071.GetNull()
+	}
+	if !s.NullTimestamp072.IsUnset() {
+		t.NullTimestamp072, _ = s.NullTimestamp072.GetNull()
+	}
+	if !s.Text073.IsUnset() {
+		t.Text073, _ = s.Text073.Get()
+	}
+	if !s.Integer074.IsUnset() {
+		t.Integer074, _ = s.Integer074.Get()
+	}
+	if !s.Bigint075.IsUnset() {
+		t.Bigint075, _ = s.Bigint075.Get()
+	}
+	if !s.Boolean076.IsUnset() {
+		t.Boolean076, _ = s.Boolean076.Get()
+	}
+	if !s.Float077.IsUnset() {
+		t.Float077, _ = s.Float077.Get()
+	}
+	if !s.Timestamp078.IsUnset() {
+		t.Timestamp078, _ = s.Timestamp078.Get()
+	}
+	if !s.Bytes079.IsUnset() {
+		t.Bytes079, _ = s.Bytes079.Get()
+	}
+	if !s.NullText080.IsUnset() {
+		t.NullText080, _ = s.NullText080.GetNull()
+	}
+	if !s.NullInteger081.IsUnset() {
+		t.NullInteger081, _ = s.NullInteger081.GetNull()
+	}
+	if !s.NullTimestamp082.IsUnset() {
+		t.NullTimestamp082, _ = s.NullTimestamp082.GetNull()
+	}
+	if !s.Text083.IsUnset() {
+		t.Text083, _ = s.Text083.Get()
+	}
+	if !s.Integer084.IsUnset() {
+		t.Integer084, _ = s.Integer084.Get()
+	}
+	if !s.Bigint085.IsUnset() {
+		t.Bigint085, _ = s.Bigint085.Get()
+	}
+	if !s.Boolean086.IsUnset() {
+		t.Boolean086, _ = s.Boolean086.Get()
+	}
+	if !s.Float087.IsUnset() {
+		t.Float087, _ = s.Float087.Get()
+	}
+	if !s.Timestamp088.IsUnset() {
+		t.Timestamp088, _ = s.Timestamp088.Get()
+	}
+	if !s.Bytes089.IsUnset() {
+		t.Bytes089, _ = s.Bytes089.Get()
+	}
+	if !s.NullText090.IsUnset() {
+		t.NullText090, _ = s.NullText090.GetNull()
+	}
+	if !s.NullInteger091.IsUnset() {
+		t.NullInteger091, _ = s.NullInteger091.GetNull()
+	}
+	if !s.NullTimestamp092.IsUnset() {
+		t.NullTimestamp092, _ = s.NullTimestamp092.GetNull()
+	}
+	if !s.Text093.IsUnset() {
+		t.Text093, _ = s.Text093.Get()
+	}
+	if !s.Integer094.IsUnset() {
+		t.Integer094, _ = s.Integer094.Get()
+	}
+	if !s.Bigint095.IsUnset() {
+		t.Bigint095, _ = s.Bigint095.Get()
+	}
+	if !s.Boolean096.IsUnset() {
+		t.Boolean096, _ = s.Boolean096.Get()
+	}
+	if !s.Float097.IsUnset() {
+		t.Float097, _ = s.Float097.Get()
+	}
+	if !s.Timestamp098.IsUnset() {
+		t.Timestamp098, _ = s.Timestamp098.Get()
+	}
+	if !s.Bytes099.IsUnset() {
+		t.Bytes099, _ = s.Bytes099.Get()
+	}
+	if !s.NullText100.IsUnset() {
+		t.NullText100, _ = s.NullText100.GetNull()
+	}
+}
+
+func (s *Wide100Setter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Wide100s.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 100)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		if s.Text003.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.Text003)
+		}
+
+		if s.Integer004.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Integer004)
+		}
+
+		if s.Bigint005.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.Bigint005)
+		}
+
+		if s.Boolean006.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.Boolean006)
+		}
+
+		if s.Float007.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Float007)
+		}
+
+		if s.Timestamp008.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Timestamp008)
+		}
+
+		if s.Bytes009.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Bytes009)
+		}
+
+		if s.NullText010.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.NullText010)
+		}
+
+		if s.NullInteger011.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.NullInteger011)
+		}
+
+		if s.NullTimestamp012.IsUnset() {
+			vals[11] = psql.Raw("DEFAULT")
+		} else {
+			vals[11] = psql.Arg(s.NullTimestamp012)
+		}
+
+		if s.Text013.IsUnset() {
+			vals[12] = psql.Raw("DEFAULT")
+		} else {
+			vals[12] = psql.Arg(s.Text013)
+		}
+
+		if s.Integer014.IsUnset() {
+			vals[13] = psql.Raw("DEFAULT")
+		} else {
+			vals[13] = psql.Arg(s.Integer014)
+		}
+
+		if s.Bigint015.IsUnset() {
+			vals[14] = psql.Raw("DEFAULT")
+		} else {
+			vals[14] = psql.Arg(s.Bigint015)
+		}
+
+		if s.Boolean016.IsUnset() {
+			vals[15] = psql.Raw("DEFAULT")
+		} else {
+			vals[15] = psql.Arg(s.Boolean016)
+		}
+
+		if s.Float017.IsUnset() {
+			vals[16] = psql.Raw("DEFAULT")
+		} else {
+			vals[16] = psql.Arg(s.Float017)
+		}
+
+		if s.Timestamp018.IsUnset() {
+			vals[17] = psql.Raw("DEFAULT")
+		} else {
+			vals[17] = psql.Arg(s.Timestamp018)
+		}
+
+		if s.Bytes019.IsUnset() {
+			vals[18] = psql.Raw("DEFAULT")
+		} else {
+			vals[18] = psql.Arg(s.Bytes019)
+		}
+
+		if s.NullText020.IsUnset() {
+			vals[19] = psql.Raw("DEFAULT")
+		} else {
+			vals[19] = psql.Arg(s.NullText020)
+		}
+
+		if s.NullInteger021.IsUnset() {
+			vals[20] = psql.Raw("DEFAULT")
+		} else {
+			vals[20] = psql.Arg(s.NullInteger021)
+		}
+
+		if s.NullTimestamp022.IsUnset() {
+			vals[21] = psql.Raw("DEFAULT")
+		} else {
+			vals[21] = psql.Arg(s.NullTimestamp022)
+		}
+
+		if s.Text023.IsUnset() {
+			vals[22] = psql.Raw("DEFAULT")
+		} else {
+			vals[22] = psql.Arg(s.Text023)
+		}
+
+		if s.Integer024.IsUnset() {
+			vals[23] = psql.Raw("DEFAULT")
+		} else {
+			vals[23] = psql.Arg(s.Integer024)
+		}
+
+		if s.Bigint025.IsUnset() {
+			vals[24] = psql.Raw("DEFAULT")
+		} else {
+			vals[24] = psql.Arg(s.Bigint025)
+		}
+
+		if s.Boolean026.IsUnset() {
+			vals[25] = psql.Raw("DEFAULT")
+		} else {
+			vals[25] = psql.Arg(s.Boolean026)
+		}
+
+		if s.Float027.IsUnset() {
+			vals[26] = psql.Raw("DEFAULT")
+		} else {
+			vals[26] = psql.Arg(s.Float027)
+		}
+
+		if s.Timestamp028.IsUnset() {
+			vals[27] = psql.Raw("DEFAULT")
+		} else {
+			vals[27] = psql.Arg(s.Timestamp028)
+		}
+
+		if s.Bytes029.IsUnset() {
+			vals[28] = psql.Raw("DEFAULT")
+		} else {
+			vals[28] = psql.Arg(s.Bytes029)
+		}
+
+		if s.NullText030.IsUnset() {
+			vals[29] = psql.Raw("DEFAULT")
+		} else {
+			vals[29] = psql.Arg(s.NullText030)
+		}
+
+		if s.NullInteger031.IsUnset() {
+			vals[30] = psql.Raw("DEFAULT")
+		} else {
+			vals[30] = psql.Arg(s.NullInteger031)
+		}
+
+		if s.NullTimestamp032.IsUnset() {
+			vals[31] = psql.Raw("DEFAULT")
+		} else {
+			vals[31] = psql.Arg(s.NullTimestamp032)
+		}
+
+		if s.Text033.IsUnset() {
+			vals[32] = psql.Raw("DEFAULT")
+		} else {
+			vals[32] = psql.Arg(s.Text033)
+		}
+
+		if s.Integer034.IsUnset() {
+			vals[33] = psql.Raw("DEFAULT")
+		} else {
+			vals[33] = psql.Arg(s.Integer034)
+		}
+
+		if s.Bigint035.IsUnset() {
+			vals[34] = psql.Raw("DEFAULT")
+		} else {
+			vals[34] = psql.Arg(s.Bigint035)
+		}
+
+		if s.Boolean036.IsUnset() {
+			vals[35] = psql.Raw("DEFAULT")
+		} else {
+			vals[35] = psql.Arg(s.Boolean036)
+		}
+
+		if s.Float037.IsUnset() {
+			vals[36] = psql.Raw("DEFAULT")
+		} else {
+			vals[36] = psql.Arg(s.Float037)
+		}
+
+		if s.Timestamp038.IsUnset() {
+			vals[37] = psql.Raw("DEFAULT")
+		} else {
+			vals[37] = psql.Arg(s.Timestamp038)
+		}
+
+		if s.Bytes039.IsUnset() {
+			vals[38] = psql.Raw("DEFAULT")
+		} else {
+			vals[38] = psql.Arg(s.Bytes039)
+		}
+
+		if s.NullText040.IsUnset() {
+			vals[39] = psql.Raw("DEFAULT")
+		} else {
+			vals[39] = psql.Arg(s.NullText040)
+		}
+
+		if s.NullInteger041.IsUnset() {
+			vals[40] = psql.Raw("DEFAULT")
+		} else {
+			vals[40] = psql.Arg(s.NullInteger041)
+		}
+
+		if s.NullTimestamp042.IsUnset() {
+			vals[41] = psql.Raw("DEFAULT")
+		} else {
+			vals[41] = psql.Arg(s.NullTimestamp042)
+		}
+
+		if s.Text043.IsUnset() {
+			vals[42] = psql.Raw("DEFAULT")
+		} else {
+			vals[42] = psql.Arg(s.Text043)
+		}
+
+		if s.Integer044.IsUnset() {
+			vals[43] = psql.Raw("DEFAULT")
+		} else {
+			vals[43] = psql.Arg(s.Integer044)
+		}
+
+		if s.Bigint045.IsUnset() {
+			vals[44] = psql.Raw("DEFAULT")
+		} else {
+			vals[44] = psql.Arg(s.Bigint045)
+		}
+
+		if s.Boolean046.IsUnset() {
+			vals[45] = psql.Raw("DEFAULT")
+		} else {
+			vals[45] = psql.Arg(s.Boolean046)
+		}
+
+		if s.Float047.IsUnset() {
+			vals[46] = psql.Raw("DEFAULT")
+		} else {
+			vals[46] = psql.Arg(s.Float047)
+		}
+
+		if s.Timestamp048.IsUnset() {
+			vals[47] = psql.Raw("DEFAULT")
+		} else {
+			vals[47] = psql.Arg(s.Timestamp048)
+		}
+
+		if s.Bytes049.IsUnset() {
+			vals[48] = psql.Raw("DEFAULT")
+		} else {
+			vals[48] = psql.Arg(s.Bytes049)
+		}
+
+		if s.NullText050.IsUnset() {
+			vals[49] = psql.Raw("DEFAULT")
+		} else {
+			vals[49] = psql.Arg(s.NullText050)
+		}
+
+		if s.NullInteger051.IsUnset() {
+			vals[50] = psql.Raw("DEFAULT")
+		} else {
+			vals[50] = psql.Arg(s.NullInteger051)
+		}
+
+		if s.NullTimestamp052.IsUnset() {
+			vals[51] = psql.Raw("DEFAULT")
+		} else {
+			vals[51] = psql.Arg(s.NullTimestamp052)
+		}
+
+		if s.Text053.IsUnset() {
+			vals[52] = psql.Raw("DEFAULT")
+		} else {
+			vals[52] = psql.Arg(s.Text053)
+		}
+
+		if s.Integer054.IsUnset() {
+			vals[53] = psql.Raw("DEFAULT")
+		} else {
+			vals[53] = psql.Arg(s.Integer054)
+		}
+
+		if s.Bigint055.IsUnset() {
+			vals[54] = psql.Raw("DEFAULT")
+		} else {
+			vals[54] = psql.Arg(s.Bigint055)
+		}
+
+		if s.Boolean056.IsUnset() {
+			vals[55] = psql.Raw("DEFAULT")
+		} else {
+			vals[55] = psql.Arg(s.Boolean056)
+		}
+
+		if s.Float057.IsUnset() {
+			vals[56] = psql.Raw("DEFAULT")
+		} else {
+			vals[56] = psql.Arg(s.Float057)
+		}
+
+		if s.Timestamp058.IsUnset() {
+			vals[57] = psql.Raw("DEFAULT")
+		} else {
+			vals[57] = psql.Arg(s.Timestamp058)
+		}
+
+		if s.Bytes059.IsUnset() {
+			vals[58] = psql.Raw("DEFAULT")
+		} else {
+			vals[58] = psql.Arg(s.Bytes059)
+		}
+
+		if s.NullText060.IsUnset() {
+			vals[59] = psql.Raw("DEFAULT")
+		} else {
+			vals[59] = psql.Arg(s.NullText060)
+		}
+
+		if s.NullInteger061.IsUnset() {
+			vals[60] = psql.Raw("DEFAULT")
+		} else {
+			vals[60] = psql.Arg(s.NullInteger061)
+		}
+
+		if s.NullTimestamp062.IsUnset() {
+			vals[61] = psql.Raw("DEFAULT")
+		} else {
+			vals[61] = psql.Arg(s.NullTimestamp062)
+		}
+
+		if s.Text063.IsUnset() {
+			vals[62] = psql.Raw("DEFAULT")
+		} else {
+			vals[62] = psql.Arg(s.Text063)
+		}
+
+		if s.Integer064.IsUnset() {
+			vals[63] = psql.Raw("DEFAULT")
+		} else {
+			vals[63] = psql.Arg(s.Integer064)
+		}
+
+		if s.Bigint065.IsUnset() {
+			vals[64] = psql.Raw("DEFAULT")
+		} else {
+			vals[64] = psql.Arg(s.Bigint065)
+		}
+
+		if s.Boolean066.IsUnset() {
+			vals[65] = psql.Raw("DEFAULT")
+		} else {
+			vals[65] = psql.Arg(s.Boolean066)
+		}
+
+		if s.Float067.IsUnset() {
+			vals[66] = psql.Raw("DEFAULT")
+		} else {
+			vals[66] = psql.Arg(s.Float067)
+		}
+
+		if s.Timestamp068.IsUnset() {
+			vals[67] = psql.Raw("DEFAULT")
+		} else {
+			vals[67] = psql.Arg(s.Timestamp068)
+		}
+
+		if s.Bytes069.IsUnset() {
+			vals[68] = psql.Raw("DEFAULT")
+		} else {
+			vals[68] = psql.Arg(s.Bytes069)
+		}
+
+		if s.NullText070.IsUnset() {
+			vals[69] = psql.Raw("DEFAULT")
+		} else {
+			vals[69] = psql.Arg(s.NullText070)
+		}
+
+		if s.NullInteger071.IsUnset() {
+			vals[70] = psql.Raw("DEFAULT")
+		} else {
+			vals[70] = psql.Arg(s.NullInteger071)
+		}
+
+		if s.NullTimestamp072.IsUnset() {
+			vals[71] = psql.Raw("DEFAULT")
+		} else {
+			vals[71] = psql.Arg(s.NullTimestamp072)
+		}
+
+		if s.Text073.IsUnset() {
+			vals[72] = psql.Raw("DEFAULT")
+		} else {
+			vals[72] = psql.Arg(s.Text073)
+		}
+
+		if s.Integer074.IsUnset() {
+			vals[73] = psql.Raw("DEFAULT")
+		} else {
+			vals[73] = psql.Arg(s.Integer074)
+		}
+
+		if s.Bigint075.IsUnset() {
+			vals[74] = psql.Raw("DEFAULT")
+		} else {
+			vals[74] = psql.Arg(s.Bigint075)
+		}
+
+		if s.Boolean076.IsUnset() {
+			vals[75] = psql.Raw("DEFAULT")
+		} else {
+			vals[75] = psql.Arg(s.Boolean076)
+		}
+
+		if s.Float077.IsUnset() {
+			vals[76] = psql.Raw("DEFAULT")
+		} else {
+			vals[76] = psql.Arg(s.Float077)
+		}
+
+		if s.Timestamp078.IsUnset() {
+			vals[77] = psql.Raw("DEFAULT")
+		} else {
+			vals[77] = psql.Arg(s.Timestamp078)
+		}
+
+		if s.Bytes079.IsUnset() {
+			vals[78] = psql.Raw("DEFAULT")
+		} else {
+			vals[78] = psql.Arg(s.Bytes079)
+		}
+
+		if s.NullText080.IsUnset() {
+			vals[79] = psql.Raw("DEFAULT")
+		} else {
+			vals[79] = psql.Arg(s.NullText080)
+		}
+
+		if s.NullInteger081.IsUnset() {
+			vals[80] = psql.Raw("DEFAULT")
+		} else {
+			vals[80] = psql.Arg(s.NullInteger081)
+		}
+
+		if s.NullTimestamp082.IsUnset() {
+			vals[81] = psql.Raw("DEFAULT")
+		} else {
+			vals[81] = psql.Arg(s.NullTimestamp082)
+		}
+
+		if s.Text083.IsUnset() {
+			vals[82] = psql.Raw("DEFAULT")
+		} else {
+			vals[82] = psql.Arg(s.Text083)
+		}
+
+		if s.Integer084.IsUnset() {
+			vals[83] = psql.Raw("DEFAULT")
+		} else {
+			vals[83] = psql.Arg(s.Integer084)
+		}
+
+		if s.Bigint085.IsUnset() {
+			vals[84] = psql.Raw("DEFAULT")
+		} else {
+			vals[84] = psql.Arg(s.Bigint085)
+		}
+
+		if s.Boolean086.IsUnset() {
+			vals[85] = psql.Raw("DEFAULT")
+		} else {
+			vals[85] = psql.Arg(s.Boolean086)
+		}
+
+		if s.Float087.IsUnset() {
+			vals[86] = psql.Raw("DEFAULT")
+		} else {
+			vals[86] = psql.Arg(s.Float087)
+		}
+
+		if s.Timestamp088.IsUnset() {
+			vals[87] = psql.Raw("DEFAULT")
+		} else {
+			vals[87] = psql.Arg(s.Timestamp088)
+		}
+
+		if s.Bytes089.IsUnset() {
+			vals[88] = psql.Raw("DEFAULT")
+		} else {
+			vals[88] = psql.Arg(s.Bytes089)
+		}
+
+		if s.NullText090.IsUnset() {
+			vals[89] = psql.Raw("DEFAULT")
+		} else {
+			vals[89] = psql.Arg(s.NullText090)
+		}
+
+		if s.NullInteger091.IsUnset() {
+			vals[90] = psql.Raw("DEFAULT")
+		} else {
+			vals[90] = psql.Arg(s.NullInteger091)
+		}
+
+		if s.NullTimestamp092.IsUnset() {
+			vals[91] = psql.Raw("DEFAULT")
+		} else {
+			vals[91] = psql.Arg(s.NullTimestamp092)
+		}
+
+		if s.Text093.IsUnset() {
+			vals[92] = psql.Raw("DEFAULT")
+		} else {
+			vals[92] = psql.Arg(s.Text093)
+		}
+
+		if s.Integer094.IsUnset() {
+			vals[93] = psql.Raw("DEFAULT")
+		} else {
+			vals[93] = psql.Arg(s.Integer094)
+		}
+
+		if s.Bigint095.IsUnset() {
+			vals[94] = psql.Raw("DEFAULT")
+		} else {
+			vals[94] = psql.Arg(s.Bigint095)
+		}
+
+		if s.Boolean096.IsUnset() {
+			vals[95] = psql.Raw("DEFAULT")
+		} else {
+			vals[95] = psql.Arg(s.Boolean096)
+		}
+
+		if s.Float097.IsUnset() {
+			vals[96] = psql.Raw("DEFAULT")
+		} else {
+			vals[96] = psql.Arg(s.Float097)
+		}
+
+		if s.Timestamp098.IsUnset() {
+			vals[97] = psql.Raw("DEFAULT")
+		} else {
+			vals[97] = psql.Arg(s.Timestamp098)
+		}
+
+		if s.Bytes099.IsUnset() {
+			vals[98] = psql.Raw("DEFAULT")
+		} else {
+			vals[98] = psql.Arg(s.Bytes099)
+		}
+
+		if s.NullText100.IsUnset() {
+			vals[99] = psql.Raw("DEFAULT")
+		} else {
+			vals[99] = psql.Arg(s.NullText100)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s Wide100Setter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s Wide100Setter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 100)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.Text003.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_003")...),
+			psql.Arg(s.Text003),
+		}})
+	}
+
+	if !s.Integer004.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_004")...),
+			psql.Arg(s.Integer004),
+		}})
+	}
+
+	if !s.Bigint005.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_005")...),
+			psql.Arg(s.Bigint005),
+		}})
+	}
+
+	if !s.Boolean006.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_006")...),
+			psql.Arg(s.Boolean006),
+		}})
+	}
+
+	if !s.Float007.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_007")...),
+			psql.Arg(s.Float007),
+		}})
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_008")...),
+			psql.Arg(s.Timestamp008),
+		}})
+	}
+
+	if !s.Bytes009.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_009")...),
+			psql.Arg(s.Bytes009),
+		}})
+	}
+
+	if !s.NullText010.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_010")...),
+			psql.Arg(s.NullText010),
+		}})
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_011")...),
+			psql.Arg(s.NullInteger011),
+		}})
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_012")...),
+			psql.Arg(s.NullTimestamp012),
+		}})
+	}
+
+	if !s.Text013.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_013")...),
+			psql.Arg(s.Text013),
+		}})
+	}
+
+	if !s.Integer014.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_014")...),
+			psql.Arg(s.Integer014),
+		}})
+	}
+
+	if !s.Bigint015.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_015")...),
+			psql.Arg(s.Bigint015),
+		}})
+	}
+
+	if !s.Boolean016.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_016")...),
+			psql.Arg(s.Boolean016),
+		}})
+	}
+
+	if !s.Float017.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_017")...),
+			psql.Arg(s.Float017),
+		}})
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_018")...),
+			psql.Arg(s.Timestamp018),
+		}})
+	}
+
+	if !s.Bytes019.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_019")...),
+			psql.Arg(s.Bytes019),
+		}})
+	}
+
+	if !s.NullText020.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_020")...),
+			psql.Arg(s.NullText020),
+		}})
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_021")...),
+			psql.Arg(s.NullInteger021),
+		}})
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_022")...),
+			psql.Arg(s.NullTimestamp022),
+		}})
+	}
+
+	if !s.Text023.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_023")...),
+			psql.Arg(s.Text023),
+		}})
+	}
+
+	if !s.Integer024.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_024")...),
+			psql.Arg(s.Integer024),
+		}})
+	}
+
+	if !s.Bigint025.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_025")...),
+			psql.Arg(s.Bigint025),
+		}})
+	}
+
+	if !s.Boolean026.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_026")...),
+			psql.Arg(s.Boolean026),
+		}})
+	}
+
+	if !s.Float027.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_027")...),
+			psql.Arg(s.Float027),
+		}})
+	}
+
+	if !s.Timestamp028.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_028")...),
+			psql.Arg(s.Timestamp028),
+		}})
+	}
+
+	if !s.Bytes029.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_029")...),
+			psql.Arg(s.Bytes029),
+		}})
+	}
+
+	if !s.NullText030.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_030")...),
+			psql.Arg(s.NullText030),
+		}})
+	}
+
+	if !s.NullInteger031.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_031")...),
+			psql.Arg(s.NullInteger031),
+		}})
+	}
+
+	if !s.NullTimestamp032.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_032")...),
+			psql.Arg(s.NullTimestamp032),
+		}})
+	}
+
+	if !s.Text033.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_033")...),
+			psql.Arg(s.Text033),
+		}})
+	}
+
+	if !s.Integer034.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_034")...),
+			psql.Arg(s.Integer034),
+		}})
+	}
+
+	if !s.Bigint035.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_035")...),
+			psql.Arg(s.Bigint035),
+		}})
+	}
+
+	if !s.Boolean036.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_036")...),
+			psql.Arg(s.Boolean036),
+		}})
+	}
+
+	if !s.Float037.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_037")...),
+			psql.Arg(s.Float037),
+		}})
+	}
+
+	if !s.Timestamp038.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_038")...),
+			psql.Arg(s.Timestamp038),
+		}})
+	}
+
+	if !s.Bytes039.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_039")...),
+			psql.Arg(s.Bytes039),
+		}})
+	}
+
+	if !s.NullText040.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_040")...),
+			psql.Arg(s.NullText040),
+		}})
+	}
+
+	if !s.NullInteger041.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_041")...),
+			psql.Arg(s.NullInteger041),
+		}})
+	}
+
+	if !s.NullTimestamp042.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_042")...),
+			psql.Arg(s.NullTimestamp042),
+		}})
+	}
+
+	if !s.Text043.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_043")...),
+			psql.Arg(s.Text043),
+		}})
+	}
+
+	if !s.Integer044.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_044")...),
+			psql.Arg(s.Integer044),
+		}})
+	}
+
+	if !s.Bigint045.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_045")...),
+			psql.Arg(s.Bigint045),
+		}})
+	}
+
+	if !s.Boolean046.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_046")...),
+			psql.Arg(s.Boolean046),
+		}})
+	}
+
+	if !s.Float047.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_047")...),
+			psql.Arg(s.Float047),
+		}})
+	}
+
+	if !s.Timestamp048.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_048")...),
+			psql.Arg(s.Timestamp048),
+		}})
+	}
+
+	if !s.Bytes049.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_049")...),
+			psql.Arg(s.Bytes049),
+		}})
+	}
+
+	if !s.NullText050.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_050")...),
+			psql.Arg(s.NullText050),
+		}})
+	}
+
+	if !s.NullInteger051.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_051")...),
+			psql.Arg(s.NullInteger051),
+		}})
+	}
+
+	if !s.NullTimestamp052.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_052")...),
+			psql.Arg(s.NullTimestamp052),
+		}})
+	}
+
+	if !s.Text053.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_053")...),
+			psql.Arg(s.Text053),
+		}})
+	}
+
+	if !s.Integer054.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_054")...),
+			psql.Arg(s.Integer054),
+		}})
+	}
+
+	if !s.Bigint055.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_055")...),
+			psql.Arg(s.Bigint055),
+		}})
+	}
+
+	if !s.Boolean056.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_056")...),
+			psql.Arg(s.Boolean056),
+		}})
+	}
+
+	if !s.Float057.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_057")...),
+			psql.Arg(s.Float057),
+		}})
+	}
+
+	if !s.Timestamp058.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_058")...),
+			psql.Arg(s.Timestamp058),
+		}})
+	}
+
+	if !s.Bytes059.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_059")...),
+			psql.Arg(s.Bytes059),
+		}})
+	}
+
+	if !s.NullText060.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_060")...),
+			psql.Arg(s.NullText060),
+		}})
+	}
+
+	if !s.NullInteger061.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_061")...),
+			psql.Arg(s.NullInteger061),
+		}})
+	}
+
+	if !s.NullTimestamp062.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_062")...),
+			psql.Arg(s.NullTimestamp062),
+		}})
+	}
+
+	if !s.Text063.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_063")...),
+			psql.Arg(s.Text063),
+		}})
+	}
+
+	if !s.Integer064.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_064")...),
+			psql.Arg(s.Integer064),
+		}})
+	}
+
+	if !s.Bigint065.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_065")...),
+			psql.Arg(s.Bigint065),
+		}})
+	}
+
+	if !s.Boolean066.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_066")...),
+			psql.Arg(s.Boolean066),
+		}})
+	}
+
+	if !s.Float067.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_067")...),
+			psql.Arg(s.Float067),
+		}})
+	}
+
+	if !s.Timestamp068.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_068")...),
+			psql.Arg(s.Timestamp068),
+		}})
+	}
+
+	if !s.Bytes069.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_069")...),
+			psql.Arg(s.Bytes069),
+		}})
+	}
+
+	if !s.NullText070.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_070")...),
+			psql.Arg(s.NullText070),
+		}})
+	}
+
+	if !s.NullInteger071.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_071")...),
+			psql.Arg(s.NullInteger071),
+		}})
+	}
+
+	if !s.NullTimestamp072.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_072")...),
+			psql.Arg(s.NullTimestamp072),
+		}})
+	}
+
+	if !s.Text073.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_073")...),
+			psql.Arg(s.Text073),
+		}})
+	}
+
+	if !s.Integer074.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_074")...),
+			psql.Arg(s.Integer074),
+		}})
+	}
+
+	if !s.Bigint075.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_075")...),
+			psql.Arg(s.Bigint075),
+		}})
+	}
+
+	if !s.Boolean076.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_076")...),
+			psql.Arg(s.Boolean076),
+		}})
+	}
+
+	if !s.Float077.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_077")...),
+			psql.Arg(s.Float077),
+		}})
+	}
+
+	if !s.Timestamp078.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_078")...),
+			psql.Arg(s.Timestamp078),
+		}})
+	}
+
+	if !s.Bytes079.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_079")...),
+			psql.Arg(s.Bytes079),
+		}})
+	}
+
+	if !s.NullText080.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_080")...),
+			psql.Arg(s.NullText080),
+		}})
+	}
+
+	if !s.NullInteger081.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_081")...),
+			psql.Arg(s.NullInteger081),
+		}})
+	}
+
+	if !s.NullTimestamp082.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_082")...),
+			psql.Arg(s.NullTimestamp082),
+		}})
+	}
+
+	if !s.Text083.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_083")...),
+			psql.Arg(s.Text083),
+		}})
+	}
+
+	if !s.Integer084.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_084")...),
+			psql.Arg(s.Integer084),
+		}})
+	}
+
+	if !s.Bigint085.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_085")...),
+			psql.Arg(s.Bigint085),
+		}})
+	}
+
+	if !s.Boolean086.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_086")...),
+			psql.Arg(s.Boolean086),
+		}})
+	}
+
+	if !s.Float087.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_087")...),
+			psql.Arg(s.Float087),
+		}})
+	}
+
+	if !s.Timestamp088.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_088")...),
+			psql.Arg(s.Timestamp088),
+		}})
+	}
+
+	if !s.Bytes089.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_089")...),
+			psql.Arg(s.Bytes089),
+		}})
+	}
+
+	if !s.NullText090.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_090")...),
+			psql.Arg(s.NullText090),
+		}})
+	}
+
+	if !s.NullInteger091.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_091")...),
+			psql.Arg(s.NullInteger091),
+		}})
+	}
+
+	if !s.NullTimestamp092.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_092")...),
+			psql.Arg(s.NullTimestamp092),
+		}})
+	}
+
+	if !s.Text093.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_093")...),
+			psql.Arg(s.Text093),
+		}})
+	}
+
+	if !s.Integer094.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_094")...),
+			psql.Arg(s.Integer094),
+		}})
+	}
+
+	if !s.Bigint095.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_095")...),
+			psql.Arg(s.Bigint095),
+		}})
+	}
+
+	if !s.Boolean096.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_096")...),
+			psql.Arg(s.Boolean096),
+		}})
+	}
+
+	if !s.Float097.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_097")...),
+			psql.Arg(s.Float097),
+		}})
+	}
+
+	if !s.Timestamp098.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_098")...),
+			psql.Arg(s.Timestamp098),
+		}})
+	}
+
+	if !s.Bytes099.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_099")...),
+			psql.Arg(s.Bytes099),
+		}})
+	}
+
+	if !s.NullText100.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_100")...),
+			psql.Arg(s.NullText100),
+		}})
+	}
+
+	return exprs
+}
+
+// FindWide100 retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindWide100(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Wide100, error) {
+	if len(cols) == 0 {
+		return Wide100s.Query(
+			SelectWhere.Wide100s.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Wide100s.Query(
+		SelectWhere.Wide100s.ID.EQ(IDPK),
+		sm.Columns(Wide100s.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// Wide100Exists checks the presence of a single record by primary key
+func Wide100Exists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Wide100s.Query(
+		SelectWhere.Wide100s.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Wide100 is retrieved from the database
+func (o *Wide100) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide100s.AfterSelectHooks.RunHooks(ctx, exec, Wide100Slice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Wide100s.AfterInsertHooks.RunHooks(ctx, exec, Wide100Slice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide100s.AfterUpdateHooks.RunHooks(ctx, exec, Wide100Slice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Wide100s.AfterDeleteHooks.RunHooks(ctx, exec, Wide100Slice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Wide100
+func (o *Wide100) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Wide100) pkEQ() dialect.Expression {
+	return psql.Quote("wide100", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Wide100
+func (o *Wide100) Update(ctx context.Context, exec bob.Executor, s *Wide100Setter) error {
+	v, err := Wide100s.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Wide100 record with an executor
+func (o *Wide100) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Wide100s.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Wide100 using the executor
+func (o *Wide100) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Wide100s.Query(
+		SelectWhere.Wide100s.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after Wide100Slice is retrieved from the database
+func (o Wide100Slice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide100s.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Wide100s.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide100s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Wide100s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o Wide100Slice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("wide100", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o Wide100Slice) copyMatchingRows(from ...*Wide100) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o Wide100Slice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide100s.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide100:
+				o.copyMatchingRows(retrieved)
+			case []*Wide100:
+				o.copyMatchingRows(retrieved...)
+			case Wide100Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide100 or a slice of Wide100
+				// then run the AfterUpdateHooks on the slice
+				_, err = Wide100s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o Wide100Slice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide100s.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide100:
+				o.copyMatchingRows(retrieved)
+			case []*Wide100:
+				o.copyMatchingRows(retrieved...)
+			case Wide100Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide100 or a slice of Wide100
+				// then run the AfterDeleteHooks on the slice
+				_, err = Wide100s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o Wide100Slice) UpdateAll(ctx context.Context, exec bob.Executor, vals Wide100Setter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide100s.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o Wide100Slice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide100s.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o Wide100Slice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Wide100s.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type wide100Joins[Q dialect.Joinable] struct {
+	typ   string
+	Pilot func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j wide100Joins[Q]) aliasedAs(alias string) wide100Joins[Q] {
+	return buildWide100Joins[Q](buildWide100Columns(alias), j.typ)
+}
+
+func buildWide100Joins[Q dialect.Joinable](cols wide100Columns, typ string) wide100Joins[Q] {
+	return wide100Joins[Q]{
+		typ:   typ,
+		Pilot: wide100sJoinPilot[Q](cols, typ),
+	}
+}
+
+func wide100sJoinPilot[Q dialect.Joinable](from wide100Columns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *Wide100) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os Wide100Slice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Wide100) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("wide100 cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Wide100s = Wide100Slice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("wide100 has no relationship %q", name)
+	}
+}
+
+func PreloadWide100Pilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Wide100s,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Wide100s.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadWide100Pilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadWide100Pilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load Wide100Pilot", retrieved)
+		}
+
+		err := loader.LoadWide100Pilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadWide100Pilot loads the wide100's Pilot into the .R struct
+func (o *Wide100) LoadWide100Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Wide100s = Wide100Slice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadWide100Pilot loads the wide100's Pilot into the .R struct
+func (os Wide100Slice) LoadWide100Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			rel.R.Wide100s = append(rel.R.Wide100s, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachWide100Pilot0(ctx context.Context, exec bob.Executor, count int, wide1000 *Wide100, pilot1 *Pilot) (*Wide100, error) {
+	setter := &Wide100Setter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := wide1000.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachWide100Pilot0: %w", err)
+	}
+
+	return wide1000, nil
+}
+
+func (wide1000 *Wide100) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachWide100Pilot0(ctx, exec, 1, wide1000, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide1000.R.Pilot = pilot1
+
+	pilot1.R.Wide100s = append(pilot1.R.Wide100s, wide1000)
+
+	return nil
+}
+
+func (wide1000 *Wide100) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachWide100Pilot0(ctx, exec, 1, wide1000, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide1000.R.Pilot = pilot1
+
+	pilot1.R.Wide100s = append(pilot1.R.Wide100s, wide1000)
+
+	return nil
+}
@@ -0,0 +1,1230 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Wide25 is an object representing the database table.
+type Wide25 struct {
+	ID               int32               `db:"id,pk" `
+	PilotID          int32               `db:"pilot_id" `
+	Text003          string              `db:"text_003" `
+	Integer004       int32               `db:"integer_004" `
+	Bigint005        int64               `db:"bigint_005" `
+	Boolean006       bool                `db:"boolean_006" `
+	Float007         float64             `db:"float_007" `
+	Timestamp008     time.Time           `db:"timestamp_008" `
+	Bytes009         []byte              `db:"bytes_009" `
+	NullText010      null.Val[string]    `db:"null_text_010" `
+	NullInteger011   null.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 null.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          string              `db:"text_013" `
+	Integer014       int32               `db:"integer_014" `
+	Bigint015        int64               `db:"bigint_015" `
+	Boolean016       bool                `db:"boolean_016" `
+	Float017         float64             `db:"float_017" `
+	Timestamp018     time.Time           `db:"timestamp_018" `
+	Bytes019         []byte              `db:"bytes_019" `
+	NullText020      null.Val[string]    `db:"null_text_020" `
+	NullInteger021   null.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 null.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          string              `db:"text_023" `
+	Integer024       int32               `db:"integer_024" `
+	Bigint025        int64               `db:"bigint_025" `
+
+	R wide25R `db:"-" `
+}
+
+// Wide25Slice is an alias for a slice of pointers to Wide25.
+// This should almost always be used instead of []*Wide25.
+type Wide25Slice []*Wide25
+
+// Wide25s contains methods to work with the wide25 table
+var Wide25s = psql.NewTablex[*Wide25, Wide25Slice, *Wide25Setter]("", "wide25")
+
+// Wide25sQuery is a query on the wide25 table
+type Wide25sQuery = *psql.ViewQuery[*Wide25, Wide25Slice]
+
+// wide25R is where relationships are stored.
+type wide25R struct {
+	Pilot *Pilot // wide25.wide25_pilot_id_pilots_id_foreign
+}
+
+type wide25ColumnNames struct {
+	ID               string
+	PilotID          string
+	Text003          string
+	Integer004       string
+	Bigint005        string
+	Boolean006       string
+	Float007         string
+	Timestamp008     string
+	Bytes009         string
+	NullText010      string
+	NullInteger011   string
+	NullTimestamp012 string
+	Text013          string
+	Integer014       string
+	Bigint015        string
+	Boolean016       string
+	Float017         string
+	Timestamp018     string
+	Bytes019         string
+	NullText020      string
+	NullInteger021   string
+	NullTimestamp022 string
+	Text023          string
+	Integer024       string
+	Bigint025        string
+}
+
+var Wide25Columns = buildWide25Columns("wide25")
+
+type wide25Columns struct {
+	tableAlias       string
+	ID               psql.Expression
+	PilotID          psql.Expression
+	Text003          psql.Expression
+	Integer004       psql.Expression
+	Bigint005        psql.Expression
+	Boolean006       psql.Expression
+	Float007         psql.Expression
+	Timestamp008     psql.Expression
+	Bytes009         psql.Expression
+	NullText010      psql.Expression
+	NullInteger011   psql.Expression
+	NullTimestamp012 psql.Expression
+	Text013          psql.Expression
+	Integer014       psql.Expression
+	Bigint015        psql.Expression
+	Boolean016       psql.Expression
+	Float017         psql.Expression
+	Timestamp018     psql.Expression
+	Bytes019         psql.Expression
+	NullText020      psql.Expression
+	NullInteger021   psql.Expression
+	NullTimestamp022 psql.Expression
+	Text023          psql.Expression
+	Integer024       psql.Expression
+	Bigint025        psql.Expression
+}
+
+func (c wide25Columns) Alias() string {
+	return c.tableAlias
+}
+
+func (wide25Columns) AliasedAs(alias string) wide25Columns {
+	return buildWide25Columns(alias)
+}
+
+func buildWide25Columns(alias string) wide25Columns {
+	return wide25Columns{
+		tableAlias:       alias,
+		ID:               psql.Quote(alias, "id"),
+		PilotID:          psql.Quote(alias, "pilot_id"),
+		Text003:          psql.Quote(alias, "text_003"),
+		Integer004:       psql.Quote(alias, "integer_004"),
+		Bigint005:        psql.Quote(alias, "bigint_005"),
+		Boolean006:       psql.Quote(alias, "boolean_006"),
+		Float007:         psql.Quote(alias, "float_007"),
+		Timestamp008:     psql.Quote(alias, "timestamp_008"),
+		Bytes009:         psql.Quote(alias, "bytes_009"),
+		NullText010:      psql.Quote(alias, "null_text_010"),
+		NullInteger011:   psql.Quote(alias, "null_integer_011"),
+		NullTimestamp012: psql.Quote(alias, "null_timestamp_012"),
+		Text013:          psql.Quote(alias, "text_013"),
+		Integer014:       psql.Quote(alias, "integer_014"),
+		Bigint015:        psql.Quote(alias, "bigint_015"),
+		Boolean016:       psql.Quote(alias, "boolean_016"),
+		Float017:         psql.Quote(alias, "float_017"),
+		Timestamp018:     psql.Quote(alias, "timestamp_018"),
+		Bytes019:         psql.Quote(alias, "bytes_019"),
+		NullText020:      psql.Quote(alias, "null_text_020"),
+		NullInteger021:   psql.Quote(alias, "null_integer_021"),
+		NullTimestamp022: psql.Quote(alias, "null_timestamp_022"),
+		Text023:          psql.Quote(alias, "text_023"),
+		Integer024:       psql.Quote(alias, "integer_024"),
+		Bigint025:        psql.Quote(alias, "bigint_025"),
+	}
+}
+
+type wide25Where[Q psql.Filterable] struct {
+	ID               psql.WhereMod[Q, int32]
+	PilotID          psql.WhereMod[Q, int32]
+	Text003          psql.WhereMod[Q, string]
+	Integer004       psql.WhereMod[Q, int32]
+	Bigint005        psql.WhereMod[Q, int64]
+	Boolean006       psql.WhereMod[Q, bool]
+	Float007         psql.WhereMod[Q, float64]
+	Timestamp008     psql.WhereMod[Q, time.Time]
+	Bytes009         psql.WhereMod[Q, []byte]
+	NullText010      psql.WhereNullMod[Q, string]
+	NullInteger011   psql.WhereNullMod[Q, int32]
+	NullTimestamp012 psql.WhereNullMod[Q, time.Time]
+	Text013          psql.WhereMod[Q, string]
+	Integer014       psql.WhereMod[Q, int32]
+	Bigint015        psql.WhereMod[Q, int64]
+	Boolean016       psql.WhereMod[Q, bool]
+	Float017         psql.WhereMod[Q, float64]
+	Timestamp018     psql.WhereMod[Q, time.Time]
+	Bytes019         psql.WhereMod[Q, []byte]
+	NullText020      psql.WhereNullMod[Q, string]
+	NullInteger021   psql.WhereNullMod[Q, int32]
+	NullTimestamp022 psql.WhereNullMod[Q, time.Time]
+	Text023          psql.WhereMod[Q, string]
+	Integer024       psql.WhereMod[Q, int32]
+	Bigint025        psql.WhereMod[Q, int64]
+}
+
+func (wide25Where[Q]) AliasedAs(alias string) wide25Where[Q] {
+	return buildWide25Where[Q](buildWide25Columns(alias))
+}
+
+func buildWide25Where[Q psql.Filterable](cols wide25Columns) wide25Where[Q] {
+	return wide25Where[Q]{
+		ID:               psql.Where[Q, int32](cols.ID),
+		PilotID:          psql.Where[Q, int32](cols.PilotID),
+		Text003:          psql.Where[Q, string](cols.Text003),
+		Integer004:       psql.Where[Q, int32](cols.Integer004),
+		Bigint005:        psql.Where[Q, int64](cols.Bigint005),
+		Boolean006:       psql.Where[Q, bool](cols.Boolean006),
+		Float007:         psql.Where[Q, float64](cols.Float007),
+		Timestamp008:     psql.Where[Q, time.Time](cols.Timestamp008),
+		Bytes009:         psql.Where[Q, []byte](cols.Bytes009),
+		NullText010:      psql.WhereNull[Q, string](cols.NullText010),
+		NullInteger011:   psql.WhereNull[Q, int32](cols.NullInteger011),
+		NullTimestamp012: psql.WhereNull[Q, time.Time](cols.NullTimestamp012),
+		Text013:          psql.Where[Q, string](cols.Text013),
+		Integer014:       psql.Where[Q, int32](cols.Integer014),
+		Bigint015:        psql.Where[Q, int64](cols.Bigint015),
+		Boolean016:       psql.Where[Q, bool](cols.Boolean016),
+		Float017:         psql.Where[Q, float64](cols.Float017),
+		Timestamp018:     psql.Where[Q, time.Time](cols.Timestamp018),
+		Bytes019:         psql.Where[Q, []byte](cols.Bytes019),
+		NullText020:      psql.WhereNull[Q, string](cols.NullText020),
+		NullInteger021:   psql.WhereNull[Q, int32](cols.NullInteger021),
+		NullTimestamp022: psql.WhereNull[Q, time.Time](cols.NullTimestamp022),
+		Text023:          psql.Where[Q, string](cols.Text023),
+		Integer024:       psql.Where[Q, int32](cols.Integer024),
+		Bigint025:        psql.Where[Q, int64](cols.Bigint025),
+	}
+}
+
+var Wide25Errors = &wide25Errors{
+	ErrUniqueWide25Pkey: &UniqueConstraintError{s: "wide25_pkey"},
+}
+
+type wide25Errors struct {
+	ErrUniqueWide25Pkey *UniqueConstraintError
+}
+
+// Wide25Setter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type Wide25Setter struct {
+	ID               omit.Val[int32]         `db:"id,pk" `
+	PilotID          omit.Val[int32]         `db:"pilot_id" `
+	Text003          omit.Val[string]        `db:"text_003" `
+	Integer004       omit.Val[int32]         `db:"integer_004" `
+	Bigint005        omit.Val[int64]         `db:"bigint_005" `
+	Boolean006       omit.Val[bool]          `db:"boolean_006" `
+	Float007         omit.Val[float64]       `db:"float_007" `
+	Timestamp008     omit.Val[time.Time]     `db:"timestamp_008" `
+	Bytes009         omit.Val[[]byte]        `db:"bytes_009" `
+	NullText010      omitnull.Val[string]    `db:"null_text_010" `
+	NullInteger011   omitnull.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 omitnull.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          omit.Val[string]        `db:"text_013" `
+	Integer014       omit.Val[int32]         `db:"integer_014" `
+	Bigint015        omit.Val[int64]         `db:"bigint_015" `
+	Boolean016       omit.Val[bool]          `db:"boolean_016" `
+	Float017         omit.Val[float64]       `db:"float_017" `
+	Timestamp018     omit.Val[time.Time]     `db:"timestamp_018" `
+	Bytes019         omit.Val[[]byte]        `db:"bytes_019" `
+	NullText020      omitnull.Val[string]    `db:"null_text_020" `
+	NullInteger021   omitnull.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 omitnull.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          omit.Val[string]        `db:"text_023" `
+	Integer024       omit.Val[int32]         `db:"integer_024" `
+	Bigint025        omit.Val[int64]         `db:"bigint_025" `
+}
+
+func (s Wide25Setter) SetColumns() []string {
+	vals := make([]string, 0, 25)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.Text003.IsUnset() {
+		vals = append(vals, "text_003")
+	}
+
+	if !s.Integer004.IsUnset() {
+		vals = append(vals, "integer_004")
+	}
+
+	if !s.Bigint005.IsUnset() {
+		vals = append(vals, "bigint_005")
+	}
+
+	if !s.Boolean006.IsUnset() {
+		vals = append(vals, "boolean_006")
+	}
+
+	if !s.Float007.IsUnset() {
+		vals = append(vals, "float_007")
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		vals = append(vals, "timestamp_008")
+	}
+
+	if !s.Bytes009.IsUnset() {
+		vals = append(vals, "bytes_009")
+	}
+
+	if !s.NullText010.IsUnset() {
+		vals = append(vals, "null_text_010")
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		vals = append(vals, "null_integer_011")
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		vals = append(vals, "null_timestamp_012")
+	}
+
+	if !s.Text013.IsUnset() {
+		vals = append(vals, "text_013")
+	}
+
+	if !s.Integer014.IsUnset() {
+		vals = append(vals, "integer_014")
+	}
+
+	if !s.Bigint015.IsUnset() {
+		vals = append(vals, "bigint_015")
+	}
+
+	if !s.Boolean016.IsUnset() {
+		vals = append(vals, "boolean_016")
+	}
+
+	if !s.Float017.IsUnset() {
+		vals = append(vals, "float_017")
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		vals = append(vals, "timestamp_018")
+	}
+
+	if !s.Bytes019.IsUnset() {
+		vals = append(vals, "bytes_019")
+	}
+
+	if !s.NullText020.IsUnset() {
+		vals = append(vals, "null_text_020")
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		vals = append(vals, "null_integer_021")
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		vals = append(vals, "null_timestamp_022")
+	}
+
+	if !s.Text023.IsUnset() {
+		vals = append(vals, "text_023")
+	}
+
+	if !s.Integer024.IsUnset() {
+		vals = append(vals, "integer_024")
+	}
+
+	if !s.Bigint025.IsUnset() {
+		vals = append(vals, "bigint_025")
+	}
+
+	return vals
+}
+
+func (s Wide25Setter) Overwrite(t *Wide25) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.Text003.IsUnset() {
+		t.Text003, _ = s.Text003.Get()
+	}
+	if !s.Integer004.IsUnset() {
+		t.Integer004, _ = s.Integer004.Get()
+	}
+	if !s.Bigint005.IsUnset() {
+		t.Bigint005, _ = s.Bigint005.Get()
+	}
+	if !s.Boolean006.IsUnset() {
+		t.Boolean006, _ = s.Boolean006.Get()
+	}
+	if !s.Float007.IsUnset() {
+		t.Float007, _ = s.Float007.Get()
+	}
+	if !s.Timestamp008.IsUnset() {
+		t.Timestamp008, _ = s.Timestamp008.Get()
+	}
+	if !s.Bytes009.IsUnset() {
+		t.Bytes009, _ = s.Bytes009.Get()
+	}
+	if !s.NullText010.IsUnset() {
+		t.NullText010, _ = s.NullText010.GetNull()
+	}
+	if !s.NullInteger011.IsUnset() {
+		t.NullInteger011, _ = s.NullInteger011.GetNull()
+	}
+	if !s.NullTimestamp012.IsUnset() {
+		t.NullTimestamp012, _ = s.NullTimestamp012.GetNull()
+	}
+	if !s.Text013.IsUnset() {
+		t.Text013, _ = s.Text013.Get()
+	}
+	if !s.Integer014.IsUnset() {
+		t.Integer014, _ = s.Integer014.Get()
+	}
+	if !s.Bigint015.IsUnset() {
+		t.Bigint015, _ = s.Bigint015.Get()
+	}
+	if !s.Boolean016.IsUnset() {
+		t.Boolean016, _ = s.Boolean016.Get()
+	}
+	if !s.Float017.IsUnset() {
+		t.Float017, _ = s.Float017.Get()
+	}
+	if !s.Timestamp018.IsUnset() {
+		t.Timestamp018, _ = s.Timestamp018.Get()
+	}
+	if !s.Bytes019.IsUnset() {
+		t.Bytes019, _ = s.Bytes019.Get()
+	}
+	if !s.NullText020.IsUnset() {
+		t.NullText020, _ = s.NullText020.GetNull()
+	}
+	if !s.NullInteger021.IsUnset() {
+		t.NullInteger021, _ = s.NullInteger021.GetNull()
+	}
+	if !s.NullTimestamp022.IsUnset() {
+		t.NullTimestamp022, _ = s.NullTimestamp022.GetNull()
+	}
+	if !s.Text023.IsUnset() {
+		t.Text023, _ = s.Text023.Get()
+	}
+	if !s.Integer024.IsUnset() {
+		t.Integer024, _ = s.Integer024.Get()
+	}
+	if !s.Bigint025.IsUnset() {
+		t.Bigint025, _ = s.Bigint025.Get()
+	}
+}
+
+func (s *Wide25Setter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Wide25s.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 25)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		if s.Text003.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.Text003)
+		}
+
+		if s.Integer004.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Integer004)
+		}
+
+		if s.Bigint005.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.Bigint005)
+		}
+
+		if s.Boolean006.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.Boolean006)
+		}
+
+		if s.Float007.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Float007)
+		}
+
+		if s.Timestamp008.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Timestamp008)
+		}
+
+		if s.Bytes009.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Bytes009)
+		}
+
+		if s.NullText010.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.NullText010)
+		}
+
+		if s.NullInteger011.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.NullInteger011)
+		}
+
+		if s.NullTimestamp012.IsUnset() {
+			vals[11] = psql.Raw("DEFAULT")
+		} else {
+			vals[11] = psql.Arg(s.NullTimestamp012)
+		}
+
+		if s.Text013.IsUnset() {
+			vals[12] = psql.Raw("DEFAULT")
+		} else {
+			vals[12] = psql.Arg(s.Text013)
+		}
+
+		if s.Integer014.IsUnset() {
+			vals[13] = psql.Raw("DEFAULT")
+		} else {
+			vals[13] = psql.Arg(s.Integer014)
+		}
+
+		if s.Bigint015.IsUnset() {
+			vals[14] = psql.Raw("DEFAULT")
+		} else {
+			vals[14] = psql.Arg(s.Bigint015)
+		}
+
+		if s.Boolean016.IsUnset() {
+			vals[15] = psql.Raw("DEFAULT")
+		} else {
+			vals[15] = psql.Arg(s.Boolean016)
+		}
+
+		if s.Float017.IsUnset() {
+			vals[16] = psql.Raw("DEFAULT")
+		} else {
+			vals[16] = psql.Arg(s.Float017)
+		}
+
+		if s.Timestamp018.IsUnset() {
+			vals[17] = psql.Raw("DEFAULT")
+		} else {
+			vals[17] = psql.Arg(s.Timestamp018)
+		}
+
+		if s.Bytes019.IsUnset() {
+			vals[18] = psql.Raw("DEFAULT")
+		} else {
+			vals[18] = psql.Arg(s.Bytes019)
+		}
+
+		if s.NullText020.IsUnset() {
+			vals[19] = psql.Raw("DEFAULT")
+		} else {
+			vals[19] = psql.Arg(s.NullText020)
+		}
+
+		if s.NullInteger021.IsUnset() {
+			vals[20] = psql.Raw("DEFAULT")
+		} else {
+			vals[20] = psql.Arg(s.NullInteger021)
+		}
+
+		if s.NullTimestamp022.IsUnset() {
+			vals[21] = psql.Raw("DEFAULT")
+		} else {
+			vals[21] = psql.Arg(s.NullTimestamp022)
+		}
+
+		if s.Text023.IsUnset() {
+			vals[22] = psql.Raw("DEFAULT")
+		} else {
+			vals[22] = psql.Arg(s.Text023)
+		}
+
+		if s.Integer024.IsUnset() {
+			vals[23] = psql.Raw("DEFAULT")
+		} else {
+			vals[23] = psql.Arg(s.Integer024)
+		}
+
+		if s.Bigint025.IsUnset() {
+			vals[24] = psql.Raw("DEFAULT")
+		} else {
+			vals[24] = psql.Arg(s.Bigint025)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s Wide25Setter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s Wide25Setter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 25)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.Text003.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_003")...),
+			psql.Arg(s.Text003),
+		}})
+	}
+
+	if !s.Integer004.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_004")...),
+			psql.Arg(s.Integer004),
+		}})
+	}
+
+	if !s.Bigint005.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_005")...),
+			psql.Arg(s.Bigint005),
+		}})
+	}
+
+	if !s.Boolean006.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_006")...),
+			psql.Arg(s.Boolean006),
+		}})
+	}
+
+	if !s.Float007.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_007")...),
+			psql.Arg(s.Float007),
+		}})
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_008")...),
+			psql.Arg(s.Timestamp008),
+		}})
+	}
+
+	if !s.Bytes009.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_009")...),
+			psql.Arg(s.Bytes009),
+		}})
+	}
+
+	if !s.NullText010.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_010")...),
+			psql.Arg(s.NullText010),
+		}})
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_011")...),
+			psql.Arg(s.NullInteger011),
+		}})
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_012")...),
+			psql.Arg(s.NullTimestamp012),
+		}})
+	}
+
+	if !s.Text013.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_013")...),
+			psql.Arg(s.Text013),
+		}})
+	}
+
+	if !s.Integer014.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_014")...),
+			psql.Arg(s.Integer014),
+		}})
+	}
+
+	if !s.Bigint015.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_015")...),
+			psql.Arg(s.Bigint015),
+		}})
+	}
+
+	if !s.Boolean016.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_016")...),
+			psql.Arg(s.Boolean016),
+		}})
+	}
+
+	if !s.Float017.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_017")...),
+			psql.Arg(s.Float017),
+		}})
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_018")...),
+			psql.Arg(s.Timestamp018),
+		}})
+	}
+
+	if !s.Bytes019.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_019")...),
+			psql.Arg(s.Bytes019),
+		}})
+	}
+
+	if !s.NullText020.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_020")...),
+			psql.Arg(s.NullText020),
+		}})
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_021")...),
+			psql.Arg(s.NullInteger021),
+		}})
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_022")...),
+			psql.Arg(s.NullTimestamp022),
+		}})
+	}
+
+	if !s.Text023.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_023")...),
+			psql.Arg(s.Text023),
+		}})
+	}
+
+	if !s.Integer024.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_024")...),
+			psql.Arg(s.Integer024),
+		}})
+	}
+
+	if !s.Bigint025.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_025")...),
+			psql.Arg(s.Bigint025),
+		}})
+	}
+
+	return exprs
+}
+
+// FindWide25 retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindWide25(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Wide25, error) {
+	if len(cols) == 0 {
+		return Wide25s.Query(
+			SelectWhere.Wide25s.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Wide25s.Query(
+		SelectWhere.Wide25s.ID.EQ(IDPK),
+		sm.Columns(Wide25s.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// Wide25Exists checks the presence of a single record by primary key
+func Wide25Exists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Wide25s.Query(
+		SelectWhere.Wide25s.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Wide25 is retrieved from the database
+func (o *Wide25) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide25s.AfterSelectHooks.RunHooks(ctx, exec, Wide25Slice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Wide25s.AfterInsertHooks.RunHooks(ctx, exec, Wide25Slice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide25s.AfterUpdateHooks.RunHooks(ctx, exec, Wide25Slice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Wide25s.AfterDeleteHooks.RunHooks(ctx, exec, Wide25Slice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Wide25
+func (o *Wide25) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Wide25) pkEQ() dialect.Expression {
+	return psql.Quote("wide25", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Wide25
+func (o *Wide25) Update(ctx context.Context, exec bob.Executor, s *Wide25Setter) error {
+	v, err := Wide25s.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Wide25 record with an executor
+func (o *Wide25) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Wide25s.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Wide25 using the executor
+func (o *Wide25) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Wide25s.Query(
+		SelectWhere.Wide25s.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after Wide25Slice is retrieved from the database
+func (o Wide25Slice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide25s.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Wide25s.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide25s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Wide25s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o Wide25Slice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("wide25", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o Wide25Slice) copyMatchingRows(from ...*Wide25) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o Wide25Slice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide25s.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide25:
+				o.copyMatchingRows(retrieved)
+			case []*Wide25:
+				o.copyMatchingRows(retrieved...)
+			case Wide25Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide25 or a slice of Wide25
+				// then run the AfterUpdateHooks on the slice
+				_, err = Wide25s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o Wide25Slice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide25s.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide25:
+				o.copyMatchingRows(retrieved)
+			case []*Wide25:
+				o.copyMatchingRows(retrieved...)
+			case Wide25Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide25 or a slice of Wide25
+				// then run the AfterDeleteHooks on the slice
+				_, err = Wide25s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o Wide25Slice) UpdateAll(ctx context.Context, exec bob.Executor, vals Wide25Setter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide25s.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o Wide25Slice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide25s.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o Wide25Slice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Wide25s.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type wide25Joins[Q dialect.Joinable] struct {
+	typ   string
+	Pilot func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j wide25Joins[Q]) aliasedAs(alias string) wide25Joins[Q] {
+	return buildWide25Joins[Q](buildWide25Columns(alias), j.typ)
+}
+
+func buildWide25Joins[Q dialect.Joinable](cols wide25Columns, typ string) wide25Joins[Q] {
+	return wide25Joins[Q]{
+		typ:   typ,
+		Pilot: wide25sJoinPilot[Q](cols, typ),
+	}
+}
+
+func wide25sJoinPilot[Q dialect.Joinable](from wide25Columns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *Wide25) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os Wide25Slice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Wide25) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("wide25 cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Wide25s = Wide25Slice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("wide25 has no relationship %q", name)
+	}
+}
+
+func PreloadWide25Pilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Wide25s,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Wide25s.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadWide25Pilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadWide25Pilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load Wide25Pilot", retrieved)
+		}
+
+		err := loader.LoadWide25Pilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadWide25Pilot loads the wide25's Pilot into the .R struct
+func (o *Wide25) LoadWide25Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Wide25s = Wide25Slice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadWide25Pilot loads the wide25's Pilot into the .R struct
+func (os Wide25Slice) LoadWide25Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			rel.R.Wide25s = append(rel.R.Wide25s, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachWide25Pilot0(ctx context.Context, exec bob.Executor, count int, wide250 *Wide25, pilot1 *Pilot) (*Wide25, error) {
+	setter := &Wide25Setter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := wide250.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachWide25Pilot0: %w", err)
+	}
+
+	return wide250, nil
+}
+
+func (wide250 *Wide25) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachWide25Pilot0(ctx, exec, 1, wide250, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide250.R.Pilot = pilot1
+
+	pilot1.R.Wide25s = append(pilot1.R.Wide25s, wide250)
+
+	return nil
+}
+
+func (wide250 *Wide25) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachWide25Pilot0(ctx, exec, 1, wide250, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide250.R.Pilot = pilot1
+
+	pilot1.R.Wide25s = append(pilot1.R.Wide25s, wide250)
+
+	return nil
+}
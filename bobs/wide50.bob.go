@@ -0,0 +1,1905 @@
+// Code generated by BobGen psql v0.34.2. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package bobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Wide50 is an object representing the database table.
+type Wide50 struct {
+	ID               int32               `db:"id,pk" `
+	PilotID          int32               `db:"pilot_id" `
+	Text003          string              `db:"text_003" `
+	Integer004       int32               `db:"integer_004" `
+	Bigint005        int64               `db:"bigint_005" `
+	Boolean006       bool                `db:"boolean_006" `
+	Float007         float64             `db:"float_007" `
+	Timestamp008     time.Time           `db:"timestamp_008" `
+	Bytes009         []byte              `db:"bytes_009" `
+	NullText010      null.Val[string]    `db:"null_text_010" `
+	NullInteger011   null.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 null.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          string              `db:"text_013" `
+	Integer014       int32               `db:"integer_014" `
+	Bigint015        int64               `db:"bigint_015" `
+	Boolean016       bool                `db:"boolean_016" `
+	Float017         float64             `db:"float_017" `
+	Timestamp018     time.Time           `db:"timestamp_018" `
+	Bytes019         []byte              `db:"bytes_019" `
+	NullText020      null.Val[string]    `db:"null_text_020" `
+	NullInteger021   null.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 null.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          string              `db:"text_023" `
+	Integer024       int32               `db:"integer_024" `
+	Bigint025        int64               `db:"bigint_025" `
+	Boolean026       bool                `db:"boolean_026" `
+	Float027         float64             `db:"float_027" `
+	Timestamp028     time.Time           `db:"timestamp_028" `
+	Bytes029         []byte              `db:"bytes_029" `
+	NullText030      null.Val[string]    `db:"null_text_030" `
+	NullInteger031   null.Val[int32]     `db:"null_integer_031" `
+	NullTimestamp032 null.Val[time.Time] `db:"null_timestamp_032" `
+	Text033          string              `db:"text_033" `
+	Integer034       int32               `db:"integer_034" `
+	Bigint035        int64               `db:"bigint_035" `
+	Boolean036       bool                `db:"boolean_036" `
+	Float037         float64             `db:"float_037" `
+	Timestamp038     time.Time           `db:"timestamp_038" `
+	Bytes039         []byte              `db:"bytes_039" `
+	NullText040      null.Val[string]    `db:"null_text_040" `
+	NullInteger041   null.Val[int32]     `db:"null_integer_041" `
+	NullTimestamp042 null.Val[time.Time] `db:"null_timestamp_042" `
+	Text043          string              `db:"text_043" `
+	Integer044       int32               `db:"integer_044" `
+	Bigint045        int64               `db:"bigint_045" `
+	Boolean046       bool                `db:"boolean_046" `
+	Float047         float64             `db:"float_047" `
+	Timestamp048     time.Time           `db:"timestamp_048" `
+	Bytes049         []byte              `db:"bytes_049" `
+	NullText050      null.Val[string]    `db:"null_text_050" `
+
+	R wide50R `db:"-" `
+}
+
+// Wide50Slice is an alias for a slice of pointers to Wide50.
+// This should almost always be used instead of []*Wide50.
+type Wide50Slice []*Wide50
+
+// Wide50s contains methods to work with the wide50 table
+var Wide50s = psql.NewTablex[*Wide50, Wide50Slice, *Wide50Setter]("", "wide50")
+
+// Wide50sQuery is a query on the wide50 table
+type Wide50sQuery = *psql.ViewQuery[*Wide50, Wide50Slice]
+
+// wide50R is where relationships are stored.
+type wide50R struct {
+	Pilot *Pilot // wide50.wide50_pilot_id_pilots_id_foreign
+}
+
+type wide50ColumnNames struct {
+	ID               string
+	PilotID          string
+	Text003          string
+	Integer004       string
+	Bigint005        string
+	Boolean006       string
+	Float007         string
+	Timestamp008     string
+	Bytes009         string
+	NullText010      string
+	NullInteger011   string
+	NullTimestamp012 string
+	Text013          string
+	Integer014       string
+	Bigint015        string
+	Boolean016       string
+	Float017         string
+	Timestamp018     string
+	Bytes019         string
+	NullText020      string
+	NullInteger021   string
+	NullTimestamp022 string
+	Text023          string
+	Integer024       string
+	Bigint025        string
+	Boolean026       string
+	Float027         string
+	Timestamp028     string
+	Bytes029         string
+	NullText030      string
+	NullInteger031   string
+	NullTimestamp032 string
+	Text033          string
+	Integer034       string
+	Bigint035        string
+	Boolean036       string
+	Float037         string
+	Timestamp038     string
+	Bytes039         string
+	NullText040      string
+	NullInteger041   string
+	NullTimestamp042 string
+	Text043          string
+	Integer044       string
+	Bigint045        string
+	Boolean046       string
+	Float047         string
+	Timestamp048     string
+	Bytes049         string
+	NullText050      string
+}
+
+var Wide50Columns = buildWide50Columns("wide50")
+
+type wide50Columns struct {
+	tableAlias       string
+	ID               psql.Expression
+	PilotID          psql.Expression
+	Text003          psql.Expression
+	Integer004       psql.Expression
+	Bigint005        psql.Expression
+	Boolean006       psql.Expression
+	Float007         psql.Expression
+	Timestamp008     psql.Expression
+	Bytes009         psql.Expression
+	NullText010      psql.Expression
+	NullInteger011   psql.Expression
+	NullTimestamp012 psql.Expression
+	Text013          psql.Expression
+	Integer014       psql.Expression
+	Bigint015        psql.Expression
+	Boolean016       psql.Expression
+	Float017         psql.Expression
+	Timestamp018     psql.Expression
+	Bytes019         psql.Expression
+	NullText020      psql.Expression
+	NullInteger021   psql.Expression
+	NullTimestamp022 psql.Expression
+	Text023          psql.Expression
+	Integer024       psql.Expression
+	Bigint025        psql.Expression
+	Boolean026       psql.Expression
+	Float027         psql.Expression
+	Timestamp028     psql.Expression
+	Bytes029         psql.Expression
+	NullText030      psql.Expression
+	NullInteger031   psql.Expression
+	NullTimestamp032 psql.Expression
+	Text033          psql.Expression
+	Integer034       psql.Expression
+	Bigint035        psql.Expression
+	Boolean036       psql.Expression
+	Float037         psql.Expression
+	Timestamp038     psql.Expression
+	Bytes039         psql.Expression
+	NullText040      psql.Expression
+	NullInteger041   psql.Expression
+	NullTimestamp042 psql.Expression
+	Text043          psql.Expression
+	Integer044       psql.Expression
+	Bigint045        psql.Expression
+	Boolean046       psql.Expression
+	Float047         psql.Expression
+	Timestamp048     psql.Expression
+	Bytes049         psql.Expression
+	NullText050      psql.Expression
+}
+
+func (c wide50Columns) Alias() string {
+	return c.tableAlias
+}
+
+func (wide50Columns) AliasedAs(alias string) wide50Columns {
+	return buildWide50Columns(alias)
+}
+
+func buildWide50Columns(alias string) wide50Columns {
+	return wide50Columns{
+		tableAlias:       alias,
+		ID:               psql.Quote(alias, "id"),
+		PilotID:          psql.Quote(alias, "pilot_id"),
+		Text003:          psql.Quote(alias, "text_003"),
+		Integer004:       psql.Quote(alias, "integer_004"),
+		Bigint005:        psql.Quote(alias, "bigint_005"),
+		Boolean006:       psql.Quote(alias, "boolean_006"),
+		Float007:         psql.Quote(alias, "float_007"),
+		Timestamp008:     psql.Quote(alias, "timestamp_008"),
+		Bytes009:         psql.Quote(alias, "bytes_009"),
+		NullText010:      psql.Quote(alias, "null_text_010"),
+		NullInteger011:   psql.Quote(alias, "null_integer_011"),
+		NullTimestamp012: psql.Quote(alias, "null_timestamp_012"),
+		Text013:          psql.Quote(alias, "text_013"),
+		Integer014:       psql.Quote(alias, "integer_014"),
+		Bigint015:        psql.Quote(alias, "bigint_015"),
+		Boolean016:       psql.Quote(alias, "boolean_016"),
+		Float017:         psql.Quote(alias, "float_017"),
+		Timestamp018:     psql.Quote(alias, "timestamp_018"),
+		Bytes019:         psql.Quote(alias, "bytes_019"),
+		NullText020:      psql.Quote(alias, "null_text_020"),
+		NullInteger021:   psql.Quote(alias, "null_integer_021"),
+		NullTimestamp022: psql.Quote(alias, "null_timestamp_022"),
+		Text023:          psql.Quote(alias, "text_023"),
+		Integer024:       psql.Quote(alias, "integer_024"),
+		Bigint025:        psql.Quote(alias, "bigint_025"),
+		Boolean026:       psql.Quote(alias, "boolean_026"),
+		Float027:         psql.Quote(alias, "float_027"),
+		Timestamp028:     psql.Quote(alias, "timestamp_028"),
+		Bytes029:         psql.Quote(alias, "bytes_029"),
+		NullText030:      psql.Quote(alias, "null_text_030"),
+		NullInteger031:   psql.Quote(alias, "null_integer_031"),
+		NullTimestamp032: psql.Quote(alias, "null_timestamp_032"),
+		Text033:          psql.Quote(alias, "text_033"),
+		Integer034:       psql.Quote(alias, "integer_034"),
+		Bigint035:        psql.Quote(alias, "bigint_035"),
+		Boolean036:       psql.Quote(alias, "boolean_036"),
+		Float037:         psql.Quote(alias, "float_037"),
+		Timestamp038:     psql.Quote(alias, "timestamp_038"),
+		Bytes039:         psql.Quote(alias, "bytes_039"),
+		NullText040:      psql.Quote(alias, "null_text_040"),
+		NullInteger041:   psql.Quote(alias, "null_integer_041"),
+		NullTimestamp042: psql.Quote(alias, "null_timestamp_042"),
+		Text043:          psql.Quote(alias, "text_043"),
+		Integer044:       psql.Quote(alias, "integer_044"),
+		Bigint045:        psql.Quote(alias, "bigint_045"),
+		Boolean046:       psql.Quote(alias, "boolean_046"),
+		Float047:         psql.Quote(alias, "float_047"),
+		Timestamp048:     psql.Quote(alias, "timestamp_048"),
+		Bytes049:         psql.Quote(alias, "bytes_049"),
+		NullText050:      psql.Quote(alias, "null_text_050"),
+	}
+}
+
+type wide50Where[Q psql.Filterable] struct {
+	ID               psql.WhereMod[Q, int32]
+	PilotID          psql.WhereMod[Q, int32]
+	Text003          psql.WhereMod[Q, string]
+	Integer004       psql.WhereMod[Q, int32]
+	Bigint005        psql.WhereMod[Q, int64]
+	Boolean006       psql.WhereMod[Q, bool]
+	Float007         psql.WhereMod[Q, float64]
+	Timestamp008     psql.WhereMod[Q, time.Time]
+	Bytes009         psql.WhereMod[Q, []byte]
+	NullText010      psql.WhereNullMod[Q, string]
+	NullInteger011   psql.WhereNullMod[Q, int32]
+	NullTimestamp012 psql.WhereNullMod[Q, time.Time]
+	Text013          psql.WhereMod[Q, string]
+	Integer014       psql.WhereMod[Q, int32]
+	Bigint015        psql.WhereMod[Q, int64]
+	Boolean016       psql.WhereMod[Q, bool]
+	Float017         psql.WhereMod[Q, float64]
+	Timestamp018     psql.WhereMod[Q, time.Time]
+	Bytes019         psql.WhereMod[Q, []byte]
+	NullText020      psql.WhereNullMod[Q, string]
+	NullInteger021   psql.WhereNullMod[Q, int32]
+	NullTimestamp022 psql.WhereNullMod[Q, time.Time]
+	Text023          psql.WhereMod[Q, string]
+	Integer024       psql.WhereMod[Q, int32]
+	Bigint025        psql.WhereMod[Q, int64]
+	Boolean026       psql.WhereMod[Q, bool]
+	Float027         psql.WhereMod[Q, float64]
+	Timestamp028     psql.WhereMod[Q, time.Time]
+	Bytes029         psql.WhereMod[Q, []byte]
+	NullText030      psql.WhereNullMod[Q, string]
+	NullInteger031   psql.WhereNullMod[Q, int32]
+	NullTimestamp032 psql.WhereNullMod[Q, time.Time]
+	Text033          psql.WhereMod[Q, string]
+	Integer034       psql.WhereMod[Q, int32]
+	Bigint035        psql.WhereMod[Q, int64]
+	Boolean036       psql.WhereMod[Q, bool]
+	Float037         psql.WhereMod[Q, float64]
+	Timestamp038     psql.WhereMod[Q, time.Time]
+	Bytes039         psql.WhereMod[Q, []byte]
+	NullText040      psql.WhereNullMod[Q, string]
+	NullInteger041   psql.WhereNullMod[Q, int32]
+	NullTimestamp042 psql.WhereNullMod[Q, time.Time]
+	Text043          psql.WhereMod[Q, string]
+	Integer044       psql.WhereMod[Q, int32]
+	Bigint045        psql.WhereMod[Q, int64]
+	Boolean046       psql.WhereMod[Q, bool]
+	Float047         psql.WhereMod[Q, float64]
+	Timestamp048     psql.WhereMod[Q, time.Time]
+	Bytes049         psql.WhereMod[Q, []byte]
+	NullText050      psql.WhereNullMod[Q, string]
+}
+
+func (wide50Where[Q]) AliasedAs(alias string) wide50Where[Q] {
+	return buildWide50Where[Q](buildWide50Columns(alias))
+}
+
+func buildWide50Where[Q psql.Filterable](cols wide50Columns) wide50Where[Q] {
+	return wide50Where[Q]{
+		ID:               psql.Where[Q, int32](cols.ID),
+		PilotID:          psql.Where[Q, int32](cols.PilotID),
+		Text003:          psql.Where[Q, string](cols.Text003),
+		Integer004:       psql.Where[Q, int32](cols.Integer004),
+		Bigint005:        psql.Where[Q, int64](cols.Bigint005),
+		Boolean006:       psql.Where[Q, bool](cols.Boolean006),
+		Float007:         psql.Where[Q, float64](cols.Float007),
+		Timestamp008:     psql.Where[Q, time.Time](cols.Timestamp008),
+		Bytes009:         psql.Where[Q, []byte](cols.Bytes009),
+		NullText010:      psql.WhereNull[Q, string](cols.NullText010),
+		NullInteger011:   psql.WhereNull[Q, int32](cols.NullInteger011),
+		NullTimestamp012: psql.WhereNull[Q, time.Time](cols.NullTimestamp012),
+		Text013:          psql.Where[Q, string](cols.Text013),
+		Integer014:       psql.Where[Q, int32](cols.Integer014),
+		Bigint015:        psql.Where[Q, int64](cols.Bigint015),
+		Boolean016:       psql.Where[Q, bool](cols.Boolean016),
+		Float017:         psql.Where[Q, float64](cols.Float017),
+		Timestamp018:     psql.Where[Q, time.Time](cols.Timestamp018),
+		Bytes019:         psql.Where[Q, []byte](cols.Bytes019),
+		NullText020:      psql.WhereNull[Q, string](cols.NullText020),
+		NullInteger021:   psql.WhereNull[Q, int32](cols.NullInteger021),
+		NullTimestamp022: psql.WhereNull[Q, time.Time](cols.NullTimestamp022),
+		Text023:          psql.Where[Q, string](cols.Text023),
+		Integer024:       psql.Where[Q, int32](cols.Integer024),
+		Bigint025:        psql.Where[Q, int64](cols.Bigint025),
+		Boolean026:       psql.Where[Q, bool](cols.Boolean026),
+		Float027:         psql.Where[Q, float64](cols.Float027),
+		Timestamp028:     psql.Where[Q, time.Time](cols.Timestamp028),
+		Bytes029:         psql.Where[Q, []byte](cols.Bytes029),
+		NullText030:      psql.WhereNull[Q, string](cols.NullText030),
+		NullInteger031:   psql.WhereNull[Q, int32](cols.NullInteger031),
+		NullTimestamp032: psql.WhereNull[Q, time.Time](cols.NullTimestamp032),
+		Text033:          psql.Where[Q, string](cols.Text033),
+		Integer034:       psql.Where[Q, int32](cols.Integer034),
+		Bigint035:        psql.Where[Q, int64](cols.Bigint035),
+		Boolean036:       psql.Where[Q, bool](cols.Boolean036),
+		Float037:         psql.Where[Q, float64](cols.Float037),
+		Timestamp038:     psql.Where[Q, time.Time](cols.Timestamp038),
+		Bytes039:         psql.Where[Q, []byte](cols.Bytes039),
+		NullText040:      psql.WhereNull[Q, string](cols.NullText040),
+		NullInteger041:   psql.WhereNull[Q, int32](cols.NullInteger041),
+		NullTimestamp042: psql.WhereNull[Q, time.Time](cols.NullTimestamp042),
+		Text043:          psql.Where[Q, string](cols.Text043),
+		Integer044:       psql.Where[Q, int32](cols.Integer044),
+		Bigint045:        psql.Where[Q, int64](cols.Bigint045),
+		Boolean046:       psql.Where[Q, bool](cols.Boolean046),
+		Float047:         psql.Where[Q, float64](cols.Float047),
+		Timestamp048:     psql.Where[Q, time.Time](cols.Timestamp048),
+		Bytes049:         psql.Where[Q, []byte](cols.Bytes049),
+		NullText050:      psql.WhereNull[Q, string](cols.NullText050),
+	}
+}
+
+var Wide50Errors = &wide50Errors{
+	ErrUniqueWide50Pkey: &UniqueConstraintError{s: "wide50_pkey"},
+}
+
+type wide50Errors struct {
+	ErrUniqueWide50Pkey *UniqueConstraintError
+}
+
+// Wide50Setter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type Wide50Setter struct {
+	ID               omit.Val[int32]         `db:"id,pk" `
+	PilotID          omit.Val[int32]         `db:"pilot_id" `
+	Text003          omit.Val[string]        `db:"text_003" `
+	Integer004       omit.Val[int32]         `db:"integer_004" `
+	Bigint005        omit.Val[int64]         `db:"bigint_005" `
+	Boolean006       omit.Val[bool]          `db:"boolean_006" `
+	Float007         omit.Val[float64]       `db:"float_007" `
+	Timestamp008     omit.Val[time.Time]     `db:"timestamp_008" `
+	Bytes009         omit.Val[[]byte]        `db:"bytes_009" `
+	NullText010      omitnull.Val[string]    `db:"null_text_010" `
+	NullInteger011   omitnull.Val[int32]     `db:"null_integer_011" `
+	NullTimestamp012 omitnull.Val[time.Time] `db:"null_timestamp_012" `
+	Text013          omit.Val[string]        `db:"text_013" `
+	Integer014       omit.Val[int32]         `db:"integer_014" `
+	Bigint015        omit.Val[int64]         `db:"bigint_015" `
+	Boolean016       omit.Val[bool]          `db:"boolean_016" `
+	Float017         omit.Val[float64]       `db:"float_017" `
+	Timestamp018     omit.Val[time.Time]     `db:"timestamp_018" `
+	Bytes019         omit.Val[[]byte]        `db:"bytes_019" `
+	NullText020      omitnull.Val[string]    `db:"null_text_020" `
+	NullInteger021   omitnull.Val[int32]     `db:"null_integer_021" `
+	NullTimestamp022 omitnull.Val[time.Time] `db:"null_timestamp_022" `
+	Text023          omit.Val[string]        `db:"text_023" `
+	Integer024       omit.Val[int32]         `db:"integer_024" `
+	Bigint025        omit.Val[int64]         `db:"bigint_025" `
+	Boolean026       omit.Val[bool]          `db:"boolean_026" `
+	Float027         omit.Val[float64]       `db:"float_027" `
+	Timestamp028     omit.Val[time.Time]     `db:"timestamp_028" `
+	Bytes029         omit.Val[[]byte]        `db:"bytes_029" `
+	NullText030      omitnull.Val[string]    `db:"null_text_030" `
+	NullInteger031   omitnull.Val[int32]     `db:"null_integer_031" `
+	NullTimestamp032 omitnull.Val[time.Time] `db:"null_timestamp_032" `
+	Text033          omit.Val[string]        `db:"text_033" `
+	Integer034       omit.Val[int32]         `db:"integer_034" `
+	Bigint035        omit.Val[int64]         `db:"bigint_035" `
+	Boolean036       omit.Val[bool]          `db:"boolean_036" `
+	Float037         omit.Val[float64]       `db:"float_037" `
+	Timestamp038     omit.Val[time.Time]     `db:"timestamp_038" `
+	Bytes039         omit.Val[[]byte]        `db:"bytes_039" `
+	NullText040      omitnull.Val[string]    `db:"null_text_040" `
+	NullInteger041   omitnull.Val[int32]     `db:"null_integer_041" `
+	NullTimestamp042 omitnull.Val[time.Time] `db:"null_timestamp_042" `
+	Text043          omit.Val[string]        `db:"text_043" `
+	Integer044       omit.Val[int32]         `db:"integer_044" `
+	Bigint045        omit.Val[int64]         `db:"bigint_045" `
+	Boolean046       omit.Val[bool]          `db:"boolean_046" `
+	Float047         omit.Val[float64]       `db:"float_047" `
+	Timestamp048     omit.Val[time.Time]     `db:"timestamp_048" `
+	Bytes049         omit.Val[[]byte]        `db:"bytes_049" `
+	NullText050      omitnull.Val[string]    `db:"null_text_050" `
+}
+
+func (s Wide50Setter) SetColumns() []string {
+	vals := make([]string, 0, 50)
+	if !s.ID.IsUnset() {
+		vals = append(vals, "id")
+	}
+
+	if !s.PilotID.IsUnset() {
+		vals = append(vals, "pilot_id")
+	}
+
+	if !s.Text003.IsUnset() {
+		vals = append(vals, "text_003")
+	}
+
+	if !s.Integer004.IsUnset() {
+		vals = append(vals, "integer_004")
+	}
+
+	if !s.Bigint005.IsUnset() {
+		vals = append(vals, "bigint_005")
+	}
+
+	if !s.Boolean006.IsUnset() {
+		vals = append(vals, "boolean_006")
+	}
+
+	if !s.Float007.IsUnset() {
+		vals = append(vals, "float_007")
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		vals = append(vals, "timestamp_008")
+	}
+
+	if !s.Bytes009.IsUnset() {
+		vals = append(vals, "bytes_009")
+	}
+
+	if !s.NullText010.IsUnset() {
+		vals = append(vals, "null_text_010")
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		vals = append(vals, "null_integer_011")
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		vals = append(vals, "null_timestamp_012")
+	}
+
+	if !s.Text013.IsUnset() {
+		vals = append(vals, "text_013")
+	}
+
+	if !s.Integer014.IsUnset() {
+		vals = append(vals, "integer_014")
+	}
+
+	if !s.Bigint015.IsUnset() {
+		vals = append(vals, "bigint_015")
+	}
+
+	if !s.Boolean016.IsUnset() {
+		vals = append(vals, "boolean_016")
+	}
+
+	if !s.Float017.IsUnset() {
+		vals = append(vals, "float_017")
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		vals = append(vals, "timestamp_018")
+	}
+
+	if !s.Bytes019.IsUnset() {
+		vals = append(vals, "bytes_019")
+	}
+
+	if !s.NullText020.IsUnset() {
+		vals = append(vals, "null_text_020")
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		vals = append(vals, "null_integer_021")
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		vals = append(vals, "null_timestamp_022")
+	}
+
+	if !s.Text023.IsUnset() {
+		vals = append(vals, "text_023")
+	}
+
+	if !s.Integer024.IsUnset() {
+		vals = append(vals, "integer_024")
+	}
+
+	if !s.Bigint025.IsUnset() {
+		vals = append(vals, "bigint_025")
+	}
+
+	if !s.Boolean026.IsUnset() {
+		vals = append(vals, "boolean_026")
+	}
+
+	if !s.Float027.IsUnset() {
+		vals = append(vals, "float_027")
+	}
+
+	if !s.Timestamp028.IsUnset() {
+		vals = append(vals, "timestamp_028")
+	}
+
+	if !s.Bytes029.IsUnset() {
+		vals = append(vals, "bytes_029")
+	}
+
+	if !s.NullText030.IsUnset() {
+		vals = append(vals, "null_text_030")
+	}
+
+	if !s.NullInteger031.IsUnset() {
+		vals = append(vals, "null_integer_031")
+	}
+
+	if !s.NullTimestamp032.IsUnset() {
+		vals = append(vals, "null_timestamp_032")
+	}
+
+	if !s.Text033.IsUnset() {
+		vals = append(vals, "text_033")
+	}
+
+	if !s.Integer034.IsUnset() {
+		vals = append(vals, "integer_034")
+	}
+
+	if !s.Bigint035.IsUnset() {
+		vals = append(vals, "bigint_035")
+	}
+
+	if !s.Boolean036.IsUnset() {
+		vals = append(vals, "boolean_036")
+	}
+
+	if !s.Float037.IsUnset() {
+		vals = append(vals, "float_037")
+	}
+
+	if !s.Timestamp038.IsUnset() {
+		vals = append(vals, "timestamp_038")
+	}
+
+	if !s.Bytes039.IsUnset() {
+		vals = append(vals, "bytes_039")
+	}
+
+	if !s.NullText040.IsUnset() {
+		vals = append(vals, "null_text_040")
+	}
+
+	if !s.NullInteger041.IsUnset() {
+		vals = append(vals, "null_integer_041")
+	}
+
+	if !s.NullTimestamp042.IsUnset() {
+		vals = append(vals, "null_timestamp_042")
+	}
+
+	if !s.Text043.IsUnset() {
+		vals = append(vals, "text_043")
+	}
+
+	if !s.Integer044.IsUnset() {
+		vals = append(vals, "integer_044")
+	}
+
+	if !s.Bigint045.IsUnset() {
+		vals = append(vals, "bigint_045")
+	}
+
+	if !s.Boolean046.IsUnset() {
+		vals = append(vals, "boolean_046")
+	}
+
+	if !s.Float047.IsUnset() {
+		vals = append(vals, "float_047")
+	}
+
+	if !s.Timestamp048.IsUnset() {
+		vals = append(vals, "timestamp_048")
+	}
+
+	if !s.Bytes049.IsUnset() {
+		vals = append(vals, "bytes_049")
+	}
+
+	if !s.NullText050.IsUnset() {
+		vals = append(vals, "null_text_050")
+	}
+
+	return vals
+}
+
+func (s Wide50Setter) Overwrite(t *Wide50) {
+	if !s.ID.IsUnset() {
+		t.ID, _ = s.ID.Get()
+	}
+	if !s.PilotID.IsUnset() {
+		t.PilotID, _ = s.PilotID.Get()
+	}
+	if !s.Text003.IsUnset() {
+		t.Text003, _ = s.Text003.Get()
+	}
+	if !s.Integer004.IsUnset() {
+		t.Integer004, _ = s.Integer004.Get()
+	}
+	if !s.Bigint005.IsUnset() {
+		t.Bigint005, _ = s.Bigint005.Get()
+	}
+	if !s.Boolean006.IsUnset() {
+		t.Boolean006, _ = s.Boolean006.Get()
+	}
+	if !s.Float007.IsUnset() {
+		t.Float007, _ = s.Float007.Get()
+	}
+	if !s.Timestamp008.IsUnset() {
+		t.Timestamp008, _ = s.Timestamp008.Get()
+	}
+	if !s.Bytes009.IsUnset() {
+		t.Bytes009, _ = s.Bytes009.Get()
+	}
+	if !s.NullText010.IsUnset() {
+		t.NullText010, _ = s.NullText010.GetNull()
+	}
+	if !s.NullInteger011.IsUnset() {
+		t.NullInteger011, _ = s.NullInteger011.GetNull()
+	}
+	if !s.NullTimestamp012.IsUnset() {
+		t.NullTimestamp012, _ = s.NullTimestamp012.GetNull()
+	}
+	if !s.Text013.IsUnset() {
+		t.Text013, _ = s.Text013.Get()
+	}
+	if !s.Integer014.IsUnset() {
+		t.Integer014, _ = s.Integer014.Get()
+	}
+	if !s.Bigint015.IsUnset() {
+		t.Bigint015, _ = s.Bigint015.Get()
+	}
+	if !s.Boolean016.IsUnset() {
+		t.Boolean016, _ = s.Boolean016.Get()
+	}
+	if !s.Float017.IsUnset() {
+		t.Float017, _ = s.Float017.Get()
+	}
+	if !s.Timestamp018.IsUnset() {
+		t.Timestamp018, _ = s.Timestamp018.Get()
+	}
+	if !s.Bytes019.IsUnset() {
+		t.Bytes019, _ = s.Bytes019.Get()
+	}
+	if !s.NullText020.IsUnset() {
+		t.NullText020, _ = s.NullText020.GetNull()
+	}
+	if !s.NullInteger021.IsUnset() {
+		t.NullInteger021, _ = s.NullInteger021.GetNull()
+	}
+	if !s.NullTimestamp022.IsUnset() {
+		t.NullTimestamp022, _ = s.NullTimestamp022.GetNull()
+	}
+	if !s.Text023.IsUnset() {
+		t.Text023, _ = s.Text023.Get()
+	}
+	if !s.Integer024.IsUnset() {
+		t.Integer024, _ = s.Integer024.Get()
+	}
+	if !s.Bigint025.IsUnset() {
+		t.Bigint025, _ = s.Bigint025.Get()
+	}
+	if !s.Boolean026.IsUnset() {
+		t.Boolean026, _ = s.Boolean026.Get()
+	}
+	if !s.Float027.IsUnset() {
+		t.Float027, _ = s.Float027.Get()
+	}
+	if !s.Timestamp028.IsUnset() {
+		t.Timestamp028, _ = s.Timestamp028.Get()
+	}
+	if !s.Bytes029.IsUnset() {
+		t.Bytes029, _ = s.Bytes029.Get()
+	}
+	if !s.NullText030.IsUnset() {
+		t.NullText030, _ = s.NullText030.GetNull()
+	}
+	if !s.NullInteger031.IsUnset() {
+		t.NullInteger031, _ = s.NullInteger031.GetNull()
+	}
+	if !s.NullTimestamp032.IsUnset() {
+		t.NullTimestamp032, _ = s.NullTimestamp032.GetNull()
+	}
+	if !s.Text033.IsUnset() {
+		t.Text033, _ = s.Text033.Get()
+	}
+	if !s.Integer034.IsUnset() {
+		t.Integer034, _ = s.Integer034.Get()
+	}
+	if !s.Bigint035.IsUnset() {
+		t.Bigint035, _ = s.Bigint035.Get()
+	}
+	if !s.Boolean036.IsUnset() {
+		t.Boolean036, _ = s.Boolean036.Get()
+	}
+	if !s.Float037.IsUnset() {
+		t.Float037, _ = s.Float037.Get()
+	}
+	if !s.Timestamp038.IsUnset() {
+		t.Timestamp038, _ = s.Timestamp038.Get()
+	}
+	if !s.Bytes039.IsUnset() {
+		t.Bytes039, _ = s.Bytes039.Get()
+	}
+	if !s.NullText040.IsUnset() {
+		t.NullText040, _ = s.NullText040.GetNull()
+	}
+	if !s.NullInteger041.IsUnset() {
+		t.NullInteger041, _ = s.NullInteger041.GetNull()
+	}
+	if !s.NullTimestamp042.IsUnset() {
+		t.NullTimestamp042, _ = s.NullTimestamp042.GetNull()
+	}
+	if !s.Text043.IsUnset() {
+		t.Text043, _ = s.Text043.Get()
+	}
+	if !s.Integer044.IsUnset() {
+		t.Integer044, _ = s.Integer044.Get()
+	}
+	if !s.Bigint045.IsUnset() {
+		t.Bigint045, _ = s.Bigint045.Get()
+	}
+	if !s.Boolean046.IsUnset() {
+		t.Boolean046, _ = s.Boolean046.Get()
+	}
+	if !s.Float047.IsUnset() {
+		t.Float047, _ = s.Float047.Get()
+	}
+	if !s.Timestamp048.IsUnset() {
+		t.Timestamp048, _ = s.Timestamp048.Get()
+	}
+	if !s.Bytes049.IsUnset() {
+		t.Bytes049, _ = s.Bytes049.Get()
+	}
+	if !s.NullText050.IsUnset() {
+		t.NullText050, _ = s.NullText050.GetNull()
+	}
+}
+
+func (s *Wide50Setter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Wide50s.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 50)
+		if s.ID.IsUnset() {
+			vals[0] = psql.Raw("DEFAULT")
+		} else {
+			vals[0] = psql.Arg(s.ID)
+		}
+
+		if s.PilotID.IsUnset() {
+			vals[1] = psql.Raw("DEFAULT")
+		} else {
+			vals[1] = psql.Arg(s.PilotID)
+		}
+
+		if s.Text003.IsUnset() {
+			vals[2] = psql.Raw("DEFAULT")
+		} else {
+			vals[2] = psql.Arg(s.Text003)
+		}
+
+		if s.Integer004.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Integer004)
+		}
+
+		if s.Bigint005.IsUnset() {
+			vals[4] = psql.Raw("DEFAULT")
+		} else {
+			vals[4] = psql.Arg(s.Bigint005)
+		}
+
+		if s.Boolean006.IsUnset() {
+			vals[5] = psql.Raw("DEFAULT")
+		} else {
+			vals[5] = psql.Arg(s.Boolean006)
+		}
+
+		if s.Float007.IsUnset() {
+			vals[6] = psql.Raw("DEFAULT")
+		} else {
+			vals[6] = psql.Arg(s.Float007)
+		}
+
+		if s.Timestamp008.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Timestamp008)
+		}
+
+		if s.Bytes009.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Bytes009)
+		}
+
+		if s.NullText010.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.NullText010)
+		}
+
+		if s.NullInteger011.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.NullInteger011)
+		}
+
+		if s.NullTimestamp012.IsUnset() {
+			vals[11] = psql.Raw("DEFAULT")
+		} else {
+			vals[11] = psql.Arg(s.NullTimestamp012)
+		}
+
+		if s.Text013.IsUnset() {
+			vals[12] = psql.Raw("DEFAULT")
+		} else {
+			vals[12] = psql.Arg(s.Text013)
+		}
+
+		if s.Integer014.IsUnset() {
+			vals[13] = psql.Raw("DEFAULT")
+		} else {
+			vals[13] = psql.Arg(s.Integer014)
+		}
+
+		if s.Bigint015.IsUnset() {
+			vals[14] = psql.Raw("DEFAULT")
+		} else {
+			vals[14] = psql.Arg(s.Bigint015)
+		}
+
+		if s.Boolean016.IsUnset() {
+			vals[15] = psql.Raw("DEFAULT")
+		} else {
+			vals[15] = psql.Arg(s.Boolean016)
+		}
+
+		if s.Float017.IsUnset() {
+			vals[16] = psql.Raw("DEFAULT")
+		} else {
+			vals[16] = psql.Arg(s.Float017)
+		}
+
+		if s.Timestamp018.IsUnset() {
+			vals[17] = psql.Raw("DEFAULT")
+		} else {
+			vals[17] = psql.Arg(s.Timestamp018)
+		}
+
+		if s.Bytes019.IsUnset() {
+			vals[18] = psql.Raw("DEFAULT")
+		} else {
+			vals[18] = psql.Arg(s.Bytes019)
+		}
+
+		if s.NullText020.IsUnset() {
+			vals[19] = psql.Raw("DEFAULT")
+		} else {
+			vals[19] = psql.Arg(s.NullText020)
+		}
+
+		if s.NullInteger021.IsUnset() {
+			vals[20] = psql.Raw("DEFAULT")
+		} else {
+			vals[20] = psql.Arg(s.NullInteger021)
+		}
+
+		if s.NullTimestamp022.IsUnset() {
+			vals[21] = psql.Raw("DEFAULT")
+		} else {
+			vals[21] = psql.Arg(s.NullTimestamp022)
+		}
+
+		if s.Text023.IsUnset() {
+			vals[22] = psql.Raw("DEFAULT")
+		} else {
+			vals[22] = psql.Arg(s.Text023)
+		}
+
+		if s.Integer024.IsUnset() {
+			vals[23] = psql.Raw("DEFAULT")
+		} else {
+			vals[23] = psql.Arg(s.Integer024)
+		}
+
+		if s.Bigint025.IsUnset() {
+			vals[24] = psql.Raw("DEFAULT")
+		} else {
+			vals[24] = psql.Arg(s.Bigint025)
+		}
+
+		if s.Boolean026.IsUnset() {
+			vals[25] = psql.Raw("DEFAULT")
+		} else {
+			vals[25] = psql.Arg(s.Boolean026)
+		}
+
+		if s.Float027.IsUnset() {
+			vals[26] = psql.Raw("DEFAULT")
+		} else {
+			vals[26] = psql.Arg(s.Float027)
+		}
+
+		if s.Timestamp028.IsUnset() {
+			vals[27] = psql.Raw("DEFAULT")
+		} else {
+			vals[27] = psql.Arg(s.Timestamp028)
+		}
+
+		if s.Bytes029.IsUnset() {
+			vals[28] = psql.Raw("DEFAULT")
+		} else {
+			vals[28] = psql.Arg(s.Bytes029)
+		}
+
+		if s.NullText030.IsUnset() {
+			vals[29] = psql.Raw("DEFAULT")
+		} else {
+			vals[29] = psql.Arg(s.NullText030)
+		}
+
+		if s.NullInteger031.IsUnset() {
+			vals[30] = psql.Raw("DEFAULT")
+		} else {
+			vals[30] = psql.Arg(s.NullInteger031)
+		}
+
+		if s.NullTimestamp032.IsUnset() {
+			vals[31] = psql.Raw("DEFAULT")
+		} else {
+			vals[31] = psql.Arg(s.NullTimestamp032)
+		}
+
+		if s.Text033.IsUnset() {
+			vals[32] = psql.Raw("DEFAULT")
+		} else {
+			vals[32] = psql.Arg(s.Text033)
+		}
+
+		if s.Integer034.IsUnset() {
+			vals[33] = psql.Raw("DEFAULT")
+		} else {
+			vals[33] = psql.Arg(s.Integer034)
+		}
+
+		if s.Bigint035.IsUnset() {
+			vals[34] = psql.Raw("DEFAULT")
+		} else {
+			vals[34] = psql.Arg(s.Bigint035)
+		}
+
+		if s.Boolean036.IsUnset() {
+			vals[35] = psql.Raw("DEFAULT")
+		} else {
+			vals[35] = psql.Arg(s.Boolean036)
+		}
+
+		if s.Float037.IsUnset() {
+			vals[36] = psql.Raw("DEFAULT")
+		} else {
+			vals[36] = psql.Arg(s.Float037)
+		}
+
+		if s.Timestamp038.IsUnset() {
+			vals[37] = psql.Raw("DEFAULT")
+		} else {
+			vals[37] = psql.Arg(s.Timestamp038)
+		}
+
+		if s.Bytes039.IsUnset() {
+			vals[38] = psql.Raw("DEFAULT")
+		} else {
+			vals[38] = psql.Arg(s.Bytes039)
+		}
+
+		if s.NullText040.IsUnset() {
+			vals[39] = psql.Raw("DEFAULT")
+		} else {
+			vals[39] = psql.Arg(s.NullText040)
+		}
+
+		if s.NullInteger041.IsUnset() {
+			vals[40] = psql.Raw("DEFAULT")
+		} else {
+			vals[40] = psql.Arg(s.NullInteger041)
+		}
+
+		if s.NullTimestamp042.IsUnset() {
+			vals[41] = psql.Raw("DEFAULT")
+		} else {
+			vals[41] = psql.Arg(s.NullTimestamp042)
+		}
+
+		if s.Text043.IsUnset() {
+			vals[42] = psql.Raw("DEFAULT")
+		} else {
+			vals[42] = psql.Arg(s.Text043)
+		}
+
+		if s.Integer044.IsUnset() {
+			vals[43] = psql.Raw("DEFAULT")
+		} else {
+			vals[43] = psql.Arg(s.Integer044)
+		}
+
+		if s.Bigint045.IsUnset() {
+			vals[44] = psql.Raw("DEFAULT")
+		} else {
+			vals[44] = psql.Arg(s.Bigint045)
+		}
+
+		if s.Boolean046.IsUnset() {
+			vals[45] = psql.Raw("DEFAULT")
+		} else {
+			vals[45] = psql.Arg(s.Boolean046)
+		}
+
+		if s.Float047.IsUnset() {
+			vals[46] = psql.Raw("DEFAULT")
+		} else {
+			vals[46] = psql.Arg(s.Float047)
+		}
+
+		if s.Timestamp048.IsUnset() {
+			vals[47] = psql.Raw("DEFAULT")
+		} else {
+			vals[47] = psql.Arg(s.Timestamp048)
+		}
+
+		if s.Bytes049.IsUnset() {
+			vals[48] = psql.Raw("DEFAULT")
+		} else {
+			vals[48] = psql.Arg(s.Bytes049)
+		}
+
+		if s.NullText050.IsUnset() {
+			vals[49] = psql.Raw("DEFAULT")
+		} else {
+			vals[49] = psql.Arg(s.NullText050)
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s Wide50Setter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s Wide50Setter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 50)
+
+	if !s.ID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.PilotID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "pilot_id")...),
+			psql.Arg(s.PilotID),
+		}})
+	}
+
+	if !s.Text003.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_003")...),
+			psql.Arg(s.Text003),
+		}})
+	}
+
+	if !s.Integer004.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_004")...),
+			psql.Arg(s.Integer004),
+		}})
+	}
+
+	if !s.Bigint005.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_005")...),
+			psql.Arg(s.Bigint005),
+		}})
+	}
+
+	if !s.Boolean006.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_006")...),
+			psql.Arg(s.Boolean006),
+		}})
+	}
+
+	if !s.Float007.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_007")...),
+			psql.Arg(s.Float007),
+		}})
+	}
+
+	if !s.Timestamp008.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_008")...),
+			psql.Arg(s.Timestamp008),
+		}})
+	}
+
+	if !s.Bytes009.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_009")...),
+			psql.Arg(s.Bytes009),
+		}})
+	}
+
+	if !s.NullText010.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_010")...),
+			psql.Arg(s.NullText010),
+		}})
+	}
+
+	if !s.NullInteger011.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_011")...),
+			psql.Arg(s.NullInteger011),
+		}})
+	}
+
+	if !s.NullTimestamp012.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_012")...),
+			psql.Arg(s.NullTimestamp012),
+		}})
+	}
+
+	if !s.Text013.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_013")...),
+			psql.Arg(s.Text013),
+		}})
+	}
+
+	if !s.Integer014.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_014")...),
+			psql.Arg(s.Integer014),
+		}})
+	}
+
+	if !s.Bigint015.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_015")...),
+			psql.Arg(s.Bigint015),
+		}})
+	}
+
+	if !s.Boolean016.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_016")...),
+			psql.Arg(s.Boolean016),
+		}})
+	}
+
+	if !s.Float017.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_017")...),
+			psql.Arg(s.Float017),
+		}})
+	}
+
+	if !s.Timestamp018.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_018")...),
+			psql.Arg(s.Timestamp018),
+		}})
+	}
+
+	if !s.Bytes019.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_019")...),
+			psql.Arg(s.Bytes019),
+		}})
+	}
+
+	if !s.NullText020.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_020")...),
+			psql.Arg(s.NullText020),
+		}})
+	}
+
+	if !s.NullInteger021.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_021")...),
+			psql.Arg(s.NullInteger021),
+		}})
+	}
+
+	if !s.NullTimestamp022.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_022")...),
+			psql.Arg(s.NullTimestamp022),
+		}})
+	}
+
+	if !s.Text023.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_023")...),
+			psql.Arg(s.Text023),
+		}})
+	}
+
+	if !s.Integer024.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_024")...),
+			psql.Arg(s.Integer024),
+		}})
+	}
+
+	if !s.Bigint025.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_025")...),
+			psql.Arg(s.Bigint025),
+		}})
+	}
+
+	if !s.Boolean026.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_026")...),
+			psql.Arg(s.Boolean026),
+		}})
+	}
+
+	if !s.Float027.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_027")...),
+			psql.Arg(s.Float027),
+		}})
+	}
+
+	if !s.Timestamp028.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_028")...),
+			psql.Arg(s.Timestamp028),
+		}})
+	}
+
+	if !s.Bytes029.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_029")...),
+			psql.Arg(s.Bytes029),
+		}})
+	}
+
+	if !s.NullText030.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_030")...),
+			psql.Arg(s.NullText030),
+		}})
+	}
+
+	if !s.NullInteger031.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_031")...),
+			psql.Arg(s.NullInteger031),
+		}})
+	}
+
+	if !s.NullTimestamp032.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_032")...),
+			psql.Arg(s.NullTimestamp032),
+		}})
+	}
+
+	if !s.Text033.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_033")...),
+			psql.Arg(s.Text033),
+		}})
+	}
+
+	if !s.Integer034.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_034")...),
+			psql.Arg(s.Integer034),
+		}})
+	}
+
+	if !s.Bigint035.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_035")...),
+			psql.Arg(s.Bigint035),
+		}})
+	}
+
+	if !s.Boolean036.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_036")...),
+			psql.Arg(s.Boolean036),
+		}})
+	}
+
+	if !s.Float037.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_037")...),
+			psql.Arg(s.Float037),
+		}})
+	}
+
+	if !s.Timestamp038.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_038")...),
+			psql.Arg(s.Timestamp038),
+		}})
+	}
+
+	if !s.Bytes039.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_039")...),
+			psql.Arg(s.Bytes039),
+		}})
+	}
+
+	if !s.NullText040.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_040")...),
+			psql.Arg(s.NullText040),
+		}})
+	}
+
+	if !s.NullInteger041.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_integer_041")...),
+			psql.Arg(s.NullInteger041),
+		}})
+	}
+
+	if !s.NullTimestamp042.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_timestamp_042")...),
+			psql.Arg(s.NullTimestamp042),
+		}})
+	}
+
+	if !s.Text043.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text_043")...),
+			psql.Arg(s.Text043),
+		}})
+	}
+
+	if !s.Integer044.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "integer_044")...),
+			psql.Arg(s.Integer044),
+		}})
+	}
+
+	if !s.Bigint045.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bigint_045")...),
+			psql.Arg(s.Bigint045),
+		}})
+	}
+
+	if !s.Boolean046.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "boolean_046")...),
+			psql.Arg(s.Boolean046),
+		}})
+	}
+
+	if !s.Float047.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "float_047")...),
+			psql.Arg(s.Float047),
+		}})
+	}
+
+	if !s.Timestamp048.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timestamp_048")...),
+			psql.Arg(s.Timestamp048),
+		}})
+	}
+
+	if !s.Bytes049.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bytes_049")...),
+			psql.Arg(s.Bytes049),
+		}})
+	}
+
+	if !s.NullText050.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "null_text_050")...),
+			psql.Arg(s.NullText050),
+		}})
+	}
+
+	return exprs
+}
+
+// FindWide50 retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindWide50(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Wide50, error) {
+	if len(cols) == 0 {
+		return Wide50s.Query(
+			SelectWhere.Wide50s.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return Wide50s.Query(
+		SelectWhere.Wide50s.ID.EQ(IDPK),
+		sm.Columns(Wide50s.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// Wide50Exists checks the presence of a single record by primary key
+func Wide50Exists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Wide50s.Query(
+		SelectWhere.Wide50s.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Wide50 is retrieved from the database
+func (o *Wide50) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide50s.AfterSelectHooks.RunHooks(ctx, exec, Wide50Slice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Wide50s.AfterInsertHooks.RunHooks(ctx, exec, Wide50Slice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide50s.AfterUpdateHooks.RunHooks(ctx, exec, Wide50Slice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Wide50s.AfterDeleteHooks.RunHooks(ctx, exec, Wide50Slice{o})
+	}
+
+	return err
+}
+
+// PrimaryKeyVals returns the primary key values of the Wide50
+func (o *Wide50) PrimaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Wide50) pkEQ() dialect.Expression {
+	return psql.Quote("wide50", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.PrimaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Wide50
+func (o *Wide50) Update(ctx context.Context, exec bob.Executor, s *Wide50Setter) error {
+	v, err := Wide50s.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Wide50 record with an executor
+func (o *Wide50) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Wide50s.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Wide50 using the executor
+func (o *Wide50) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Wide50s.Query(
+		SelectWhere.Wide50s.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after Wide50Slice is retrieved from the database
+func (o Wide50Slice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Wide50s.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Wide50s.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Wide50s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Wide50s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o Wide50Slice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("wide50", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.PrimaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o Wide50Slice) copyMatchingRows(from ...*Wide50) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o Wide50Slice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide50s.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide50:
+				o.copyMatchingRows(retrieved)
+			case []*Wide50:
+				o.copyMatchingRows(retrieved...)
+			case Wide50Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide50 or a slice of Wide50
+				// then run the AfterUpdateHooks on the slice
+				_, err = Wide50s.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o Wide50Slice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Wide50s.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Wide50:
+				o.copyMatchingRows(retrieved)
+			case []*Wide50:
+				o.copyMatchingRows(retrieved...)
+			case Wide50Slice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Wide50 or a slice of Wide50
+				// then run the AfterDeleteHooks on the slice
+				_, err = Wide50s.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o Wide50Slice) UpdateAll(ctx context.Context, exec bob.Executor, vals Wide50Setter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide50s.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o Wide50Slice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Wide50s.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o Wide50Slice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Wide50s.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type wide50Joins[Q dialect.Joinable] struct {
+	typ   string
+	Pilot func(context.Context) modAs[Q, pilotColumns]
+}
+
+func (j wide50Joins[Q]) aliasedAs(alias string) wide50Joins[Q] {
+	return buildWide50Joins[Q](buildWide50Columns(alias), j.typ)
+}
+
+func buildWide50Joins[Q dialect.Joinable](cols wide50Columns, typ string) wide50Joins[Q] {
+	return wide50Joins[Q]{
+		typ:   typ,
+		Pilot: wide50sJoinPilot[Q](cols, typ),
+	}
+}
+
+func wide50sJoinPilot[Q dialect.Joinable](from wide50Columns, typ string) func(context.Context) modAs[Q, pilotColumns] {
+	return func(ctx context.Context) modAs[Q, pilotColumns] {
+		return modAs[Q, pilotColumns]{
+			c: PilotColumns,
+			f: func(to pilotColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pilots.Name().As(to.Alias())).On(
+						to.ID.EQ(from.PilotID),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// Pilot starts a query for related objects on pilots
+func (o *Wide50) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	return Pilots.Query(append(mods,
+		sm.Where(PilotColumns.ID.EQ(psql.Arg(o.PilotID))),
+	)...)
+}
+
+func (os Wide50Slice) Pilot(mods ...bob.Mod[*dialect.SelectQuery]) PilotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.PilotID)
+	}
+
+	return Pilots.Query(append(mods,
+		sm.Where(psql.Group(PilotColumns.ID).In(PKArgs...)),
+	)...)
+}
+
+func (o *Wide50) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Pilot":
+		rel, ok := retrieved.(*Pilot)
+		if !ok {
+			return fmt.Errorf("wide50 cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Pilot = rel
+
+		if rel != nil {
+			rel.R.Wide50s = Wide50Slice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("wide50 has no relationship %q", name)
+	}
+}
+
+func PreloadWide50Pilot(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Pilot, PilotSlice](orm.Relationship{
+		Name: "Pilot",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Wide50s,
+				To:   TableNames.Pilots,
+				FromColumns: []string{
+					ColumnNames.Wide50s.PilotID,
+				},
+				ToColumns: []string{
+					ColumnNames.Pilots.ID,
+				},
+			},
+		},
+	}, Pilots.Columns().Names(), opts...)
+}
+
+func ThenLoadWide50Pilot(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadWide50Pilot(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load Wide50Pilot", retrieved)
+		}
+
+		err := loader.LoadWide50Pilot(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadWide50Pilot loads the wide50's Pilot into the .R struct
+func (o *Wide50) LoadWide50Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Pilot = nil
+
+	related, err := o.Pilot(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Wide50s = Wide50Slice{o}
+
+	o.R.Pilot = related
+	return nil
+}
+
+// LoadWide50Pilot loads the wide50's Pilot into the .R struct
+func (os Wide50Slice) LoadWide50Pilot(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pilots, err := os.Pilot(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range pilots {
+			if o.PilotID != rel.ID {
+				continue
+			}
+
+			rel.R.Wide50s = append(rel.R.Wide50s, o)
+
+			o.R.Pilot = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachWide50Pilot0(ctx context.Context, exec bob.Executor, count int, wide500 *Wide50, pilot1 *Pilot) (*Wide50, error) {
+	setter := &Wide50Setter{
+		PilotID: omit.From(pilot1.ID),
+	}
+
+	err := wide500.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachWide50Pilot0: %w", err)
+	}
+
+	return wide500, nil
+}
+
+func (wide500 *Wide50) InsertPilot(ctx context.Context, exec bob.Executor, related *PilotSetter) error {
+	pilot1, err := Pilots.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachWide50Pilot0(ctx, exec, 1, wide500, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide500.R.Pilot = pilot1
+
+	pilot1.R.Wide50s = append(pilot1.R.Wide50s, wide500)
+
+	return nil
+}
+
+func (wide500 *Wide50) AttachPilot(ctx context.Context, exec bob.Executor, pilot1 *Pilot) error {
+	var err error
+
+	_, err = attachWide50Pilot0(ctx, exec, 1, wide500, pilot1)
+	if err != nil {
+		return err
+	}
+
+	wide500.R.Pilot = pilot1
+
+	pilot1.R.Wide50s = append(pilot1.R.Wide50s, wide500)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// buildOperation builds the SQL of an operation without running it
+type buildOperation struct {
+	name  string
+	build func(bench.Builder, context.Context) (string, []interface{}, error)
+}
+
+var buildOperations = []buildOperation{
+	{name: "BuildSelectComplex", build: bench.Builder.BuildSelectComplex},
+	{name: "BuildInsert", build: bench.Builder.BuildInsert},
+	{name: "BuildUpdate", build: bench.Builder.BuildUpdate},
+}
+
+// open an adapter for op. Nothing is run, the fixture is only there for
+// ORMs that talk to the database when they're opened.
+func (o buildOperation) open(prefix string, a bench.Adapter) (bench.Builder, error) {
+	bd, ok := a.(bench.Builder)
+	if !ok {
+		return nil, bench.ErrUnsupported
+	}
+
+	dsn := fmt.Sprintf("postgres://%s%s-%s", prefix, o.name, a.Name())
+	mimic.NewSequenceDSN(dsn, fixtures(jetQuery)()...)
+	if err := a.Open(dsn); err != nil {
+		return nil, err
+	}
+	return bd, nil
+}
+
+// runBuild creates an op/orm sub-benchmark for every adapter
+func runBuild(b *testing.B, op buildOperation) {
+	for _, a := range adapters() {
+		bd, err := op.open("", a)
+		if err != nil && !errors.Is(err, bench.ErrUnsupported) {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			ctx := context.Background()
+
+			if err == nil {
+				_, _, err = op.build(bd, ctx)
+			}
+			if errors.Is(err, bench.ErrUnsupported) {
+				skipUnsupported(b)
+			} else if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+			loop(b, func() error {
+				_, _, err := op.build(bd, ctx)
+				return err
+			})
+		})
+	}
+}
+
+// TestGoldenBuildSQL is TestGoldenSQL for the statements the adapters build
+// without running them, they're checked in under testdata/sql/Build<Op>
+func TestGoldenBuildSQL(t *testing.T) {
+	for _, op := range buildOperations {
+		for _, a := range adapters() {
+			op, a := op, a
+			t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+				bd, err := op.open("sql-", a)
+				var (
+					query string
+					args  []interface{}
+				)
+				if err == nil {
+					query, args, err = op.build(bd, context.Background())
+				}
+				if errors.Is(err, bench.ErrUnsupported) {
+					t.Skip("unsupported")
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				stmt := mimic.Statement{Query: query}
+				for _, arg := range args {
+					stmt.Args = append(stmt.Args, arg)
+				}
+				checkGolden(t, op.name, a.Name(), formatStatements([]mimic.Statement{stmt}))
+			})
+		}
+	}
+}
+
+// buildOperationNamed returns the build operation called name
+func buildOperationNamed(name string) buildOperation {
+	for _, op := range buildOperations {
+		if op.name == name {
+			return op
+		}
+	}
+	panic("no build operation " + name)
+}
+
+func BenchmarkBuildSelectComplex(b *testing.B) {
+	runBuild(b, buildOperationNamed("BuildSelectComplex"))
+}
+
+func BenchmarkBuildInsert(b *testing.B) {
+	runBuild(b, buildOperationNamed("BuildInsert"))
+}
+
+func BenchmarkBuildUpdate(b *testing.B) {
+	runBuild(b, buildOperationNamed("BuildUpdate"))
+}
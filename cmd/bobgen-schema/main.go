@@ -0,0 +1,342 @@
+// Command bobgen-schema generates the bob models from the DDL in schema.sql
+// instead of connecting to a database, so that they can be generated without
+// one. It is a bob driver that describes the tables, enums, constraints and
+// indexes the way the psql driver queries them from a database loaded from
+// the same file, and then runs bob's psql templates over them with the
+// settings in bobgen.yaml.
+//
+// Run it from the root of the repository:
+//
+//	go run ./cmd/bobgen-schema
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/boilbench/schema"
+	"github.com/aarondl/strmangle"
+	"github.com/stephenafamo/bob/gen"
+	helpers "github.com/stephenafamo/bob/gen/bobgen-helpers"
+	"github.com/stephenafamo/bob/gen/bobgen-psql/driver"
+	"github.com/stephenafamo/bob/gen/bobgen-psql/driver/parser"
+	"github.com/stephenafamo/bob/gen/drivers"
+	"github.com/stephenafamo/bob/gen/importers"
+)
+
+type offline struct {
+	config     driver.Config
+	schema     *schema.Schema
+	translator *parser.Translator
+}
+
+func newOffline(config driver.Config, s *schema.Schema) *offline {
+	if len(config.Schemas) == 0 {
+		config.Schemas = []string{"public"}
+	}
+	if len(config.SharedSchema) == 0 {
+		config.SharedSchema = config.Schemas[0]
+	}
+	if len(config.DriverName) == 0 {
+		config.DriverName = "github.com/lib/pq"
+	}
+
+	types := helpers.Types()
+	if config.UUIDPkg == "google" {
+		types["uuid.UUID"] = drivers.Type{
+			Imports:    importers.List{`"github.com/google/uuid"`},
+			RandomExpr: `return uuid.New()`,
+		}
+	} else {
+		types["uuid.UUID"] = drivers.Type{
+			Imports:    importers.List{`"github.com/gofrs/uuid/v5"`},
+			RandomExpr: `return uuid.Must(uuid.NewV4())`,
+		}
+	}
+
+	return &offline{config: config, schema: s, translator: &parser.Translator{Types: types}}
+}
+
+func (o *offline) Dialect() string { return "psql" }
+
+func (o *offline) Types() drivers.Types { return o.translator.Types }
+
+// Assemble describes the parsed DDL, query folders aren't supported since
+// checking queries needs a database
+func (o *offline) Assemble(ctx context.Context) (*driver.DBInfo, error) {
+	if len(o.config.Queries) != 0 {
+		return nil, fmt.Errorf("queries need a database")
+	}
+
+	// Columns are translated with the enums
+	for _, e := range o.schema.Enums {
+		o.translator.Enums = append(o.translator.Enums, parser.Enum{
+			Schema: o.config.SharedSchema,
+			Name:   e.Name,
+			Type:   strmangle.TitleCase(e.Name),
+			Values: e.Values,
+		})
+	}
+
+	tables, err := drivers.BuildDBInfo[any](ctx, o, 1, o.config.Only, o.config.Except)
+	if err != nil {
+		return nil, err
+	}
+
+	dbinfo := &driver.DBInfo{DriverName: o.config.DriverName, Tables: tables}
+	for _, e := range o.translator.Enums {
+		dbinfo.Enums = append(dbinfo.Enums, drivers.Enum{Type: e.Type, Values: e.Values})
+	}
+	sort.Slice(dbinfo.Enums, func(i, j int) bool {
+		return dbinfo.Enums[i].Type < dbinfo.Enums[j].Type
+	})
+
+	return dbinfo, nil
+}
+
+// TablesInfo returns the tables matching the filter
+func (o *offline) TablesInfo(ctx context.Context, filter drivers.Filter) (drivers.TablesInfo, error) {
+	only, err := newMatcher(filter, filter.Only)
+	if err != nil {
+		return nil, err
+	}
+	except, err := newMatcher(filter, filter.Except)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos drivers.TablesInfo
+	for _, name := range o.schema.TableNames() {
+		if len(filter.Only) != 0 && !only(name) || len(filter.Except) != 0 && except(name) {
+			continue
+		}
+		infos = append(infos, drivers.TableInfo{Key: name, Schema: o.config.SharedSchema, Name: name})
+	}
+	return infos, nil
+}
+
+// TableDetails describes the columns of a table the way the
+// information_schema query of the psql driver does
+func (o *offline) TableDetails(ctx context.Context, info drivers.TableInfo, colFilter drivers.ColumnFilter) (string, string, []drivers.Column, error) {
+	t := o.schema.Table(info.Name)
+	if t == nil {
+		return "", "", nil, fmt.Errorf("unknown table %s", info.Name)
+	}
+
+	filter := colFilter[info.Key]
+
+	var columns []drivers.Column
+	for _, c := range t.Columns {
+		if len(filter.Only) != 0 && !contains(filter.Only, c.Name) ||
+			len(filter.Only) == 0 && contains(filter.Except, c.Name) {
+			continue
+		}
+
+		column := drivers.Column{
+			Name:      c.Name,
+			DBType:    c.DataType,
+			Nullable:  c.Nullable,
+			Generated: c.Generated || c.IdentityAlways,
+		}
+		colInfo := parser.ColInfo{UDTSchema: "pg_catalog", UDTName: c.UDTName}
+
+		if c.IsEnum() {
+			colInfo.UDTSchema = o.config.SharedSchema
+			column.DBType = "ENUM"
+		}
+		if c.Array {
+			colInfo.ArrType = c.DataType
+			colInfo.UDTName = "_" + c.UDTName
+			column.DBType = "ARRAY"
+		}
+
+		// information_schema has no default for identity and generated
+		// columns, the expression of a generated column is not its default
+		if !c.Generated {
+			column.Default = c.Default
+		}
+		if c.Identity {
+			column.Default = "IDENTITY"
+		}
+		if c.Generated {
+			column.Default = "GENERATED"
+		}
+		if c.Nullable && len(column.Default) == 0 {
+			column.Default = "NULL"
+		}
+
+		columns = append(columns, o.translator.TranslateColumnType(column, colInfo))
+	}
+
+	return "", info.Name, columns, nil
+}
+
+// Comments returns nothing, comments aren't parsed
+func (o *offline) Comments(ctx context.Context) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// Constraints returns the primary keys, foreign keys and unique constraints
+// of every table, sorted by name
+func (o *offline) Constraints(ctx context.Context, _ drivers.ColumnFilter) (drivers.DBConstraints[any], error) {
+	ret := drivers.DBConstraints[any]{
+		PKs:     map[string]*drivers.Constraint[any]{},
+		FKs:     map[string][]drivers.ForeignKey[any]{},
+		Uniques: map[string][]drivers.Constraint[any]{},
+		Checks:  map[string][]drivers.Check[any]{},
+	}
+
+	for _, t := range o.schema.Tables {
+		if t.PrimaryKey != nil {
+			ret.PKs[t.Name] = &drivers.Constraint[any]{Name: t.PrimaryKey.Name, Columns: t.PrimaryKey.Columns}
+		}
+
+		for _, u := range t.Uniques {
+			ret.Uniques[t.Name] = append(ret.Uniques[t.Name], drivers.Constraint[any]{Name: u.Name, Columns: u.Columns})
+		}
+		sort.Slice(ret.Uniques[t.Name], func(i, j int) bool {
+			return ret.Uniques[t.Name][i].Name < ret.Uniques[t.Name][j].Name
+		})
+
+		for _, fk := range t.ForeignKeys {
+			ret.FKs[t.Name] = append(ret.FKs[t.Name], drivers.ForeignKey[any]{
+				Constraint:     drivers.Constraint[any]{Name: t.Name + "." + fk.Name, Columns: fk.Columns},
+				ForeignTable:   fk.ForeignTable,
+				ForeignColumns: fk.ForeignColumns,
+			})
+		}
+		sort.Slice(ret.FKs[t.Name], func(i, j int) bool {
+			return ret.FKs[t.Name][i].Name < ret.FKs[t.Name][j].Name
+		})
+	}
+
+	return ret, nil
+}
+
+// Indexes returns the indexes of every table, including those backing the
+// primary key and unique constraints. The primary key comes first, then
+// the rest by name.
+func (o *offline) Indexes(ctx context.Context) (drivers.DBIndexes[driver.IndexExtra], error) {
+	ret := drivers.DBIndexes[driver.IndexExtra]{}
+
+	for _, t := range o.schema.Tables {
+		var indexes []drivers.Index[driver.IndexExtra]
+		add := func(name string, unique bool, columns []string) {
+			index := drivers.Index[driver.IndexExtra]{
+				Type:   "btree",
+				Name:   name,
+				Unique: unique,
+				Extra:  driver.IndexExtra{NullsFirst: make([]bool, len(columns)), Include: []string{}},
+			}
+			for _, c := range columns {
+				index.Columns = append(index.Columns, drivers.IndexColumn{Name: c})
+			}
+			indexes = append(indexes, index)
+		}
+
+		for _, u := range t.Uniques {
+			add(u.Name, true, u.Columns)
+		}
+		for _, i := range t.Indexes {
+			add(i.Name, i.Unique, i.Columns)
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i].Name < indexes[j].Name })
+
+		if t.PrimaryKey != nil {
+			add(t.PrimaryKey.Name, true, t.PrimaryKey.Columns)
+			indexes = append(indexes[len(indexes)-1:], indexes[:len(indexes)-1]...)
+		}
+
+		if len(indexes) != 0 {
+			ret[t.Name] = indexes
+		}
+	}
+
+	return ret, nil
+}
+
+// newMatcher matches table names against patterns, those between slashes
+// are regular expressions
+func newMatcher(filter drivers.Filter, patterns []string) (func(string) bool, error) {
+	names, regexes := filter.ClassifyPatterns(patterns)
+
+	var rgx *regexp.Regexp
+	if len(regexes) != 0 {
+		var err error
+		if rgx, err = regexp.Compile(strings.Join(regexes, "|")); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(name string) bool {
+		return contains(names, name) || rgx != nil && rgx.MatchString(name)
+	}, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// bobVersion is the version of bob this command is built with
+func bobVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/stephenafamo/bob" {
+				return dep.Version
+			}
+		}
+	}
+	return "(devel)"
+}
+
+func main() {
+	configFile := flag.String("c", helpers.DefaultConfigPath, "bob config to read the psql settings from")
+	schemaFile := flag.String("schema", "schema.sql", "DDL to generate the models from")
+	flag.Parse()
+
+	if err := run(*configFile, *schemaFile); err != nil {
+		fmt.Fprintln(os.Stderr, "bobgen-schema:", err)
+		os.Exit(1)
+	}
+}
+
+func run(configFile, schemaFile string) error {
+	config, driverConfig, err := helpers.GetConfigFromFile[any, driver.Config](configFile, "psql")
+	if err != nil {
+		return err
+	}
+
+	// Files are headed with the version of bob rather than of this module
+	if config.Generator == "BobGen psql "+helpers.Version() {
+		config.Generator = "BobGen psql " + bobVersion()
+	}
+
+	s, err := schema.ParseFile(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	outputs := helpers.DefaultOutputs(
+		driverConfig.Output, driverConfig.Pkgname, config.NoFactory,
+		&helpers.Templates{Models: []fs.FS{gen.PSQLModelTemplates}},
+	)
+
+	state := &gen.State[any]{
+		Config:  config,
+		Outputs: outputs,
+	}
+
+	return gen.Run(context.Background(), state, newOffline(driverConfig, s))
+}
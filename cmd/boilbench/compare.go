@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"text/tabwriter"
+)
+
+const compareUsage = "compare [-alpha 0.05] old new"
+
+// compare reports the change of every benchmark and metric between two
+// result files. A change is only reported when a Mann-Whitney U test finds
+// it significant at -alpha, medians are shown with their confidence
+// interval at 1-alpha.
+func compare(args []string) error {
+	fs := newFlagSet("compare", compareUsage)
+	alpha := fs.Float64("alpha", 0.05, "significance level for the test and the confidence intervals")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errors.New("want an old and a new result file")
+	}
+	if *alpha <= 0 || *alpha >= 1 {
+		return errors.New("alpha must be between 0 and 1")
+	}
+
+	before, err := parseFiles(fs.Args()[:1])
+	if err != nil {
+		return err
+	}
+	after, err := parseFiles(fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+	if len(before) == 0 || len(after) == 0 {
+		return errors.New("no benchmark results found")
+	}
+
+	return writeComparison(os.Stdout, before, after, *alpha)
+}
+
+// change is the comparison of one key between two result sets
+type change struct {
+	key      Key
+	old, new []float64
+
+	// significant is set when p is below alpha
+	p           float64
+	significant bool
+}
+
+// compareSamples compares every key in either set, keys missing from one
+// side are included with no samples on that side
+func compareSamples(before, after Samples, alpha float64) []change {
+	keys := make(map[Key]bool)
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+
+	changes := make([]change, 0, len(keys))
+	for k := range keys {
+		c := change{key: k, old: before[k], new: after[k], p: 1}
+		if len(c.old) != 0 && len(c.new) != 0 {
+			c.p = mannWhitneyU(c.old, c.new)
+			c.significant = c.p < alpha
+		}
+		changes = append(changes, c)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].key, changes[j].key
+		if oa, ob := metricOrder(a.Unit), metricOrder(b.Unit); oa != ob {
+			return oa < ob
+		}
+		if a.Unit != b.Unit {
+			return a.Unit < b.Unit
+		}
+		if a.Op != b.Op {
+			return a.Op < b.Op
+		}
+		return a.ORM < b.ORM
+	})
+	return changes
+}
+
+// delta is the relative change of the medians in percent
+func (c change) delta() float64 {
+	o, n := median(c.old), median(c.new)
+	if o == 0 {
+		if n == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (n - o) / o * 100
+}
+
+// writeComparison prints a table per unit in the style of benchstat
+func writeComparison(w io.Writer, before, after Samples, alpha float64) error {
+	confidence := 1 - alpha
+	changes := compareSamples(before, after, alpha)
+	tooFew := false
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for i, c := range changes {
+		if i == 0 || c.key.Unit != changes[i-1].key.Unit {
+			if i != 0 {
+				fmt.Fprintln(tw, "\t\t\t")
+			}
+			fmt.Fprintf(tw, "%s\told\tnew\tdelta\n", c.key.Unit)
+		}
+
+		oldCell, oldOK := formatMedian(c.old, confidence)
+		newCell, newOK := formatMedian(c.new, confidence)
+		tooFew = tooFew || !oldOK || !newOK
+
+		var delta string
+		switch {
+		case len(c.old) == 0 || len(c.new) == 0:
+		case !c.significant:
+			delta = fmt.Sprintf("~ (p=%.3f n=%d+%d)", c.p, len(c.old), len(c.new))
+		default:
+			delta = fmt.Sprintf("%+.2f%% (p=%.3f n=%d+%d)", c.delta(), c.p, len(c.old), len(c.new))
+		}
+
+		fmt.Fprintf(tw, "%s/%s\t%s\t%s\t%s\n", c.key.Op, c.key.ORM, oldCell, newCell, delta)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n~ marks no significant change, p >= %g in a Mann-Whitney U test\n", alpha)
+	if tooFew {
+		fmt.Fprintf(w, "∞ need at least %d samples for a confidence interval at level %g\n",
+			minCISamples(confidence), confidence)
+	}
+	return nil
+}
+
+// formatMedian formats the median of values with its confidence interval
+// as a percentage of it, ok is false when there were too few samples for
+// an interval
+func formatMedian(values []float64, confidence float64) (cell string, ok bool) {
+	if len(values) == 0 {
+		return "-", true
+	}
+
+	m := median(values)
+	lo, hi, ok := medianCI(values, confidence)
+	if !ok {
+		return shortNumber(m) + " ± ∞", false
+	}
+
+	if m == 0 {
+		return shortNumber(m) + " ± 0%", true
+	}
+	return fmt.Sprintf("%s ± %.0f%%", shortNumber(m), math.Max(m-lo, hi-m)/m*100), true
+}
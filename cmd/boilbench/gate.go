@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"text/tabwriter"
+)
+
+const gateUsage = "gate [-config gate.json] [-update] [files]"
+
+// gateConfig is the file the gate reads its thresholds from
+type gateConfig struct {
+	// ORM whose benchmarks are gated
+	ORM string `json:"orm"`
+	// Baseline is the file the baseline is kept in
+	Baseline string `json:"baseline"`
+	// Thresholds are the largest increase of the median allowed per unit,
+	// in percent. Units without a threshold are reported but not gated.
+	Thresholds map[string]float64 `json:"thresholds"`
+	// Benchmarks override Thresholds per operation
+	Benchmarks map[string]map[string]float64 `json:"benchmarks"`
+}
+
+// threshold returns the threshold for an operation and unit
+func (c gateConfig) threshold(op, unit string) (float64, bool) {
+	if t, ok := c.Benchmarks[op][unit]; ok {
+		return t, true
+	}
+	t, ok := c.Thresholds[unit]
+	return t, ok
+}
+
+// baseline is the checked in summary of the gated ORM's results
+type baseline struct {
+	ORM        string                      `json:"orm"`
+	Benchmarks map[string]map[string]Stats `json:"benchmarks"`
+}
+
+// gate compares the results of one ORM against a checked in baseline and
+// fails when the median of a metric increased by more than its threshold.
+// With -update the baseline is refreshed from the results instead.
+func gate(args []string) error {
+	fs := newFlagSet("gate", gateUsage)
+	configFile := fs.String("config", "gate.json", "threshold config file")
+	update := fs.Bool("update", false, "write the results to the baseline instead of checking them")
+	_ = fs.Parse(args)
+
+	var config gateConfig
+	if err := readJSON(*configFile, &config); err != nil {
+		return err
+	}
+	if len(config.ORM) == 0 || len(config.Baseline) == 0 {
+		return fmt.Errorf("%s: orm and baseline must be set", *configFile)
+	}
+
+	samples, err := parseFiles(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	current := baseline{ORM: config.ORM, Benchmarks: make(map[string]map[string]Stats)}
+	for k, values := range samples {
+		if k.ORM != config.ORM {
+			continue
+		}
+		if current.Benchmarks[k.Op] == nil {
+			current.Benchmarks[k.Op] = make(map[string]Stats)
+		}
+		current.Benchmarks[k.Op][k.Unit] = summarize(values)
+	}
+	if len(current.Benchmarks) == 0 {
+		return fmt.Errorf("no benchmark results found for %s", config.ORM)
+	}
+
+	var base baseline
+	err = readJSON(config.Baseline, &base)
+	switch {
+	case *update && errors.Is(err, os.ErrNotExist):
+		base = baseline{Benchmarks: make(map[string]map[string]Stats)}
+	case err != nil:
+		return err
+	}
+
+	if *update {
+		// Only the operations that were run are replaced so that the
+		// baseline can be refreshed one benchmark at a time
+		base.ORM = config.ORM
+		for op, units := range current.Benchmarks {
+			base.Benchmarks[op] = units
+		}
+		if err := writeJSON(config.Baseline, base); err != nil {
+			return err
+		}
+		fmt.Printf("updated %d benchmarks in %s\n", len(current.Benchmarks), config.Baseline)
+		return nil
+	}
+
+	if base.ORM != config.ORM {
+		return fmt.Errorf("%s is a baseline for %q, not %q", config.Baseline, base.ORM, config.ORM)
+	}
+
+	regressions, err := writeGate(os.Stdout, config, base, current)
+	if err != nil {
+		return err
+	}
+	if regressions != 0 {
+		return fmt.Errorf("%d regressions against %s", regressions, config.Baseline)
+	}
+	return nil
+}
+
+// writeGate prints every metric of every operation in the baseline or the
+// results and returns the number of regressions
+func writeGate(w io.Writer, config gateConfig, base, current baseline) (int, error) {
+	ops := make(map[string]bool)
+	for op := range base.Benchmarks {
+		ops[op] = true
+	}
+	for op := range current.Benchmarks {
+		ops[op] = true
+	}
+	sorted := make([]string, 0, len(ops))
+	for op := range ops {
+		sorted = append(sorted, op)
+	}
+	sort.Strings(sorted)
+
+	regressions := 0
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "op\tunit\tbaseline\tcurrent\tdelta\tthreshold\t")
+	for _, op := range sorted {
+		units := make(map[string]bool)
+		for unit := range base.Benchmarks[op] {
+			units[unit] = true
+		}
+		for unit := range current.Benchmarks[op] {
+			units[unit] = true
+		}
+		sortedUnits := make([]string, 0, len(units))
+		for unit := range units {
+			sortedUnits = append(sortedUnits, unit)
+		}
+		sort.Slice(sortedUnits, func(i, j int) bool {
+			return metricOrder(sortedUnits[i]) < metricOrder(sortedUnits[j])
+		})
+
+		for _, unit := range sortedUnits {
+			was, inBase := base.Benchmarks[op][unit]
+			is, inCurrent := current.Benchmarks[op][unit]
+			threshold, gated := config.threshold(op, unit)
+
+			thresholdCell := "-"
+			if gated {
+				thresholdCell = fmt.Sprintf("%g%%", threshold)
+			}
+
+			switch {
+			case !inCurrent:
+				fmt.Fprintf(tw, "%s\t%s\t%s\t-\t\t%s\tnot run\n", op, unit, shortNumber(was.Median), thresholdCell)
+				continue
+			case !inBase:
+				fmt.Fprintf(tw, "%s\t%s\t-\t%s\t\t%s\tnot in baseline, refresh it with -update\n", op, unit, shortNumber(is.Median), thresholdCell)
+				continue
+			}
+
+			delta := 0.0
+			switch {
+			case was.Median != 0:
+				delta = (is.Median - was.Median) / was.Median * 100
+			case is.Median != 0:
+				delta = math.Inf(1)
+			}
+
+			status := "ok"
+			if !gated {
+				status = "not gated"
+			} else if delta > threshold {
+				status = "REGRESSION"
+				regressions++
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%+.2f%%\t%s\t%s\n",
+				op, unit, shortNumber(was.Median), shortNumber(is.Median), delta, thresholdCell, status)
+		}
+	}
+
+	return regressions, tw.Flush()
+}
+
+func readJSON(name string, v interface{}) error {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func writeJSON(name string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, append(b, '\n'), 0644)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"io"
+	"testing"
+)
+
+func TestGate(t *testing.T) {
+	config := gateConfig{
+		ORM:        "boil",
+		Thresholds: map[string]float64{"ns/op": 25, "allocs/op": 0},
+		Benchmarks: map[string]map[string]float64{"EagerLoad": {"ns/op": 50}},
+	}
+
+	base := baseline{ORM: "boil", Benchmarks: map[string]map[string]Stats{
+		"SelectAll": {"ns/op": {Median: 1000}, "allocs/op": {Median: 40}, "B/op": {Median: 100}},
+		"EagerLoad": {"ns/op": {Median: 1000}},
+		"Insert":    {"ns/op": {Median: 1000}},
+	}}
+
+	current := baseline{ORM: "boil", Benchmarks: map[string]map[string]Stats{
+		// Within the threshold, and B/op has none
+		"SelectAll": {"ns/op": {Median: 1200}, "allocs/op": {Median: 40}, "B/op": {Median: 500}},
+		// Within the per benchmark threshold
+		"EagerLoad": {"ns/op": {Median: 1400}},
+		// Not in the baseline
+		"Update": {"ns/op": {Median: 1000}},
+	}}
+
+	regressions, err := writeGate(io.Discard, config, base, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regressions != 0 {
+		t.Error("want no regressions, got", regressions)
+	}
+
+	current.Benchmarks["SelectAll"]["allocs/op"] = Stats{Median: 41}
+	current.Benchmarks["EagerLoad"]["ns/op"] = Stats{Median: 1600}
+	regressions, err = writeGate(io.Discard, config, base, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regressions != 2 {
+		t.Error("want 2 regressions, got", regressions)
+	}
+}
@@ -0,0 +1,85 @@
+// Command boilbench turns the output of the benchmarks in this repo into
+// reports and graphs.
+//
+// Usage:
+//
+//	boilbench <command> [flags] [files]
+//
+// Run boilbench help <command> for the flags of a command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// command is a boilbench subcommand, run receives the arguments after its
+// name.
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "report", usage: reportUsage, run: report},
+		{name: "compare", usage: compareUsage, run: compare},
+		{name: "gate", usage: gateUsage, run: gate},
+		{name: "matrix", usage: matrixUsage, run: matrix},
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name, args := os.Args[1], os.Args[2:]
+	if name == "help" || name == "-h" || name == "--help" {
+		if len(args) == 0 {
+			usage()
+			return
+		}
+		name, args = args[0], []string{"-h"}
+	}
+
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+
+		if err := c.run(args); err != nil {
+			fmt.Fprintln(os.Stderr, "boilbench "+c.name+":", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "boilbench: unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
+}
+
+// newFlagSet returns the flag set for a command
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: boilbench %s\n\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: boilbench <command> [flags] [files]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %s\n", c.usage)
+	}
+}
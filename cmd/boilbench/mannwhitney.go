@@ -0,0 +1,161 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// exactLimit is the largest n1*n2 for which the exact distribution of U is
+// used, larger samples use the normal approximation
+const exactLimit = 2500
+
+// mannWhitneyU tests whether x and y come from the same distribution and
+// returns the two sided p-value. Without ties and for small samples the
+// exact distribution of U is used, otherwise the normal approximation with
+// tie and continuity corrections.
+func mannWhitneyU(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type value struct {
+		v float64
+		x bool
+	}
+	all := make([]value, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, value{v: v, x: true})
+	}
+	for _, v := range y {
+		all = append(all, value{v: v})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].v < all[j].v })
+
+	// Rank the combined samples, tied values get the average of their ranks
+	var rankSum, tieCorrection float64
+	ties := false
+	for i := 0; i < len(all); {
+		j := i + 1
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if all[k].x {
+				rankSum += rank
+			}
+		}
+
+		if t := float64(j - i); t > 1 {
+			ties = true
+			tieCorrection += t*t*t - t
+		}
+		i = j
+	}
+
+	u := rankSum - float64(n1*(n1+1))/2
+	if !ties && n1*n2 <= exactLimit {
+		return exactP(n1, n2, u)
+	}
+
+	n := float64(n1 + n2)
+	mean := float64(n1*n2) / 2
+	variance := float64(n1*n2) / 12 * ((n + 1) - tieCorrection/(n*(n-1)))
+	if variance == 0 {
+		return 1
+	}
+
+	z := math.Max(math.Abs(u-mean)-0.5, 0) / math.Sqrt(variance)
+	return math.Min(math.Erfc(z/math.Sqrt2), 1)
+}
+
+// exactP is the two sided p-value of u from the exact distribution of the
+// U statistic for samples of n1 and n2 values without ties
+func exactP(n1, n2 int, u float64) float64 {
+	// counts[j][k] is the number of orderings of i x values and j y values
+	// in which the x values beat k of the y values, built up over i
+	max := n1 * n2
+	counts := make([][]float64, n2+1)
+	for j := range counts {
+		counts[j] = make([]float64, max+1)
+		counts[j][0] = 1
+	}
+
+	for i := 1; i <= n1; i++ {
+		next := make([][]float64, n2+1)
+		next[0] = make([]float64, max+1)
+		next[0][0] = 1
+		for j := 1; j <= n2; j++ {
+			next[j] = make([]float64, max+1)
+			for k := 0; k <= i*j; k++ {
+				// The largest value is either an x that beats all j y
+				// values, or a y
+				if k >= j {
+					next[j][k] += counts[j][k-j]
+				}
+				next[j][k] += next[j-1][k]
+			}
+		}
+		counts = next
+	}
+
+	dist := counts[n2]
+	var total, below, above float64
+	for k, c := range dist {
+		total += c
+		if float64(k) <= u {
+			below += c
+		}
+		if float64(k) >= u {
+			above += c
+		}
+	}
+
+	return math.Min(2*math.Min(below, above)/total, 1)
+}
+
+// medianCI returns the confidence interval of the median of values at the
+// given confidence level from the binomial distribution of order
+// statistics. ok is false when there are too few values for one.
+func medianCI(values []float64, confidence float64) (lo, hi float64, ok bool) {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+
+	// Find the widest k such that P(B < k) <= alpha/2 for B ~ Bin(n, 1/2),
+	// the interval is then [x(k), x(n-k+1)] with 1-based order statistics
+	alpha := 1 - confidence
+	k := 0
+	var cdf float64
+	for i := 0; i < n; i++ {
+		cdf += binomial(n, i) / math.Pow(2, float64(n))
+		if cdf > alpha/2 {
+			break
+		}
+		k = i + 1
+	}
+	if k == 0 {
+		return 0, 0, false
+	}
+
+	return sorted[k-1], sorted[n-k], true
+}
+
+// minCISamples is the fewest values medianCI can work with at confidence
+func minCISamples(confidence float64) int {
+	for n := 1; ; n++ {
+		if _, _, ok := medianCI(make([]float64, n), confidence); ok {
+			return n
+		}
+	}
+}
+
+func binomial(n, k int) float64 {
+	r := 1.0
+	for i := 1; i <= k; i++ {
+		r = r * float64(n-k+i) / float64(i)
+	}
+	return r
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	tests := []struct {
+		x, y []float64
+		p    float64
+	}{
+		// Exact, every x below every y
+		{x: []float64{1, 2, 3, 4, 5}, y: []float64{6, 7, 8, 9, 10}, p: 2.0 / 252},
+		// Exact, U = 35 and p = 0.2544 by enumerating all 3003 orderings
+		{
+			x: []float64{0.80, 0.83, 1.89, 1.04, 1.45, 1.38, 1.91, 1.64, 0.73, 1.46},
+			y: []float64{1.15, 0.88, 0.90, 0.74, 1.21},
+			p: 0.2544,
+		},
+		// Normal approximation with ties, U = 1 and the tie corrected
+		// variance is 25/12 * (11 - 36/90)
+		{x: []float64{1, 2, 2, 3, 3}, y: []float64{3, 4, 4, 5, 6}, p: 0.01924},
+		// Identical samples
+		{x: []float64{5, 5, 5}, y: []float64{5, 5, 5}, p: 1},
+	}
+
+	for i, test := range tests {
+		if p := mannWhitneyU(test.x, test.y); math.Abs(p-test.p) > 0.0001 {
+			t.Errorf("%d) want p = %v, got %v", i, test.p, p)
+		}
+	}
+}
+
+func TestMedianCI(t *testing.T) {
+	if _, _, ok := medianCI([]float64{1, 2, 3, 4, 5}, 0.95); ok {
+		t.Error("5 values are too few for a 95% confidence interval")
+	}
+	if n := minCISamples(0.95); n != 6 {
+		t.Error("want 6 samples for a 95% confidence interval, got", n)
+	}
+
+	lo, hi, ok := medianCI([]float64{9, 1, 8, 2, 7, 3, 6, 4, 5, 10}, 0.95)
+	if !ok || lo != 2 || hi != 9 {
+		t.Errorf("wrong interval: [%v, %v] %t", lo, hi, ok)
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const matrixUsage = "matrix [-bench regexp] [-count n] [-out file] [-data dir] [-graphs dir] version|[label=]path ..."
+
+const sqlboilerModule = "github.com/aarondl/sqlboiler/v4"
+
+// matrix benchmarks several sqlboiler versions or local checkouts. Each one
+// is run in a temporary copy of this module with sqlboiler replaced, and
+// models regenerated from schema.sql. The results are merged into one file
+// with the ORM of every benchmark labeled by version, for example
+// SelectAll/boil@v4.19.2, and reported on.
+func matrix(args []string) error {
+	fs := newFlagSet("matrix", matrixUsage)
+	bench := fs.String("bench", "/boil$", "benchmarks to run, as for go test -bench")
+	count := fs.Int("count", 6, "number of times to run each benchmark")
+	out := fs.String("out", "matrix.txt", "file to write the merged results to")
+	data := fs.String("data", "graph_data", "directory to write csv files to, empty to skip them")
+	graphs := fs.String("graphs", "graphs", "directory to write svg charts to, empty to skip them")
+	keep := fs.Bool("keep", false, "keep the temporary module copies")
+	_ = fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("want at least one version or checkout")
+	}
+
+	root, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(root, "schema.sql")); err != nil {
+		return errors.New("run matrix from the root of the repository")
+	}
+
+	var merged bytes.Buffer
+	for _, arg := range fs.Args() {
+		t, err := parseTarget(arg)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "=== %s\n", t.label)
+		output, err := runTarget(root, t, *bench, *count, *keep)
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.label, err)
+		}
+		relabel(&merged, output, t.label)
+	}
+
+	if err := os.WriteFile(*out, merged.Bytes(), 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "=== wrote %s\n", *out)
+
+	return report([]string{"-data", *data, "-graphs", *graphs, *out})
+}
+
+// target is a version or checkout of sqlboiler to benchmark
+type target struct {
+	label string
+	// replace is the right hand side of the go.mod replace directive
+	replace string
+	// version is set when the target is a released version, it's also
+	// required so that sqlboiler doesn't warn about a mismatch
+	version string
+}
+
+// parseTarget parses label=path, a path or a version, paths are anything
+// that exists on disk
+func parseTarget(arg string) (target, error) {
+	label, path := "", arg
+	if i := strings.IndexByte(arg, '='); i >= 0 {
+		label, path = arg[:i], arg[i+1:]
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return target{}, err
+		}
+		if len(label) == 0 {
+			label = filepath.Base(abs)
+		}
+		return target{label: sanitizeLabel(label), replace: abs}, nil
+	}
+
+	if !strings.HasPrefix(path, "v") {
+		return target{}, fmt.Errorf("%q is neither a version nor a directory", path)
+	}
+	if len(label) == 0 {
+		label = path
+	}
+	return target{label: sanitizeLabel(label), replace: sqlboilerModule + "@" + path, version: path}, nil
+}
+
+// sanitizeLabel keeps labels from splitting benchmark names
+func sanitizeLabel(label string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == ' ' || r == '\t' {
+			return '_'
+		}
+		return r
+	}, label)
+}
+
+// runTarget copies the module, replaces sqlboiler, regenerates the models
+// and their variants and returns the output of the benchmarks
+func runTarget(root string, t target, bench string, count int, keep bool) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "boilbench-"+t.label+"-")
+	if err != nil {
+		return nil, err
+	}
+	if keep {
+		fmt.Fprintf(os.Stderr, "module copy in %s\n", dir)
+	} else {
+		defer os.RemoveAll(dir)
+	}
+
+	if err := copyModule(root, dir); err != nil {
+		return nil, err
+	}
+
+	edit := []string{"go", "mod", "edit", "-replace", sqlboilerModule + "=" + t.replace}
+	if len(t.version) != 0 {
+		edit = append(edit, "-require", sqlboilerModule+"@"+t.version)
+	}
+	if err := run(dir, nil, edit...); err != nil {
+		return nil, err
+	}
+	if err := run(dir, nil, "go", "mod", "tidy"); err != nil {
+		return nil, fmt.Errorf("%w, note that only sqlboiler v4.19.2 and later are published as %s", err, sqlboilerModule)
+	}
+
+	if err := removeGenerated(filepath.Join(dir, "models")); err != nil {
+		return nil, err
+	}
+	if err := run(dir, nil, "go", "run", sqlboilerModule, "./scripts/offline/sqlboiler-psql", "--no-tests", "--tag", "db", "--add-enum-types"); err != nil {
+		return nil, err
+	}
+
+	// the variants of the models the boil benchmarks compare generation
+	// options with are imported by the benchmarks too
+	variants, err := filepath.Glob(filepath.Join(dir, "models_*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		if err := removeGenerated(v); err != nil {
+			return nil, err
+		}
+	}
+	if err := run(dir, nil, "./scripts/gen-variants"); err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	err = run(dir, io.MultiWriter(&output, os.Stderr),
+		"go", "test", "-run", "xxx", "-bench", bench, "-benchmem", "-count", fmt.Sprint(count), ".")
+	return output.Bytes(), err
+}
+
+// copyModule copies the repository to dir, without git history or output
+func copyModule(root, dir string) error {
+	skip := map[string]bool{".git": true, "graph_data": true, "graphs": true}
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if skip[rel] {
+			return filepath.SkipDir
+		}
+
+		dest := filepath.Join(dir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dest, b, info.Mode().Perm())
+	})
+}
+
+// removeGenerated deletes the files sqlboiler generated so that files a
+// version no longer generates don't linger, adapter.go is kept
+func removeGenerated(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+
+		path := filepath.Join(dir, e.Name())
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		first, _ := bufio.NewReader(f).ReadString('\n')
+		f.Close()
+
+		if strings.Contains(first, "Code generated by SQLBoiler") {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// run a command in dir, its output goes to stdout or stderr when nil
+func run(dir string, stdout io.Writer, args ...string) error {
+	fmt.Fprintln(os.Stderr, "$", strings.Join(args, " "))
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = dir
+	cmd.Stdout = stdout
+	if stdout == nil {
+		cmd.Stdout = os.Stderr
+	}
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// relabel appends @label to the ORM of every benchmark line in output
+func relabel(w io.Writer, output []byte, label string) {
+	for _, line := range strings.SplitAfter(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+			io.WriteString(w, line)
+			continue
+		}
+
+		name := fields[0]
+		suffix := procsSuffix.FindString(name)
+		renamed := strings.TrimSuffix(name, suffix) + "@" + label + suffix
+		io.WriteString(w, strings.Replace(line, name, renamed, 1))
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Key identifies a series of samples
+type Key struct {
+	Op   string
+	ORM  string
+	Unit string
+}
+
+// Samples holds every value reported for a key, one per benchmark line
+// across every run that was parsed
+type Samples map[Key][]float64
+
+// Ops returns the operations in s, sorted
+func (s Samples) Ops() []string {
+	return s.distinct(func(k Key) string { return k.Op })
+}
+
+// ORMs returns the ORMs in s, sorted
+func (s Samples) ORMs() []string {
+	return s.distinct(func(k Key) string { return k.ORM })
+}
+
+// Units returns the units in s in metricOrder
+func (s Samples) Units() []string {
+	units := s.distinct(func(k Key) string { return k.Unit })
+	sort.SliceStable(units, func(i, j int) bool {
+		return metricOrder(units[i]) < metricOrder(units[j])
+	})
+	return units
+}
+
+func (s Samples) distinct(field func(Key) string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for k := range s {
+		if v := field(k); !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// parseFiles parses benchmark output from every file, or from stdin when
+// there are none
+func parseFiles(names []string) (Samples, error) {
+	samples := make(Samples)
+	if len(names) == 0 {
+		return samples, parse(os.Stdin, samples)
+	}
+
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = parse(f, samples)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return samples, nil
+}
+
+// event is the part of a go test -json event that carries output
+type event struct {
+	Action  string
+	Package string
+	Output  string
+}
+
+// parse reads go test -bench output into samples. The output may be text
+// or go test -json events, in which case the output of each package is
+// reassembled before parsing since a benchmark's name and its results are
+// usually split over several events.
+func parse(r io.Reader, samples Samples) error {
+	var packages []string
+	output := make(map[string]*strings.Builder)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		var e event
+		if strings.HasPrefix(line, "{") && json.Unmarshal([]byte(line), &e) == nil {
+			if e.Action != "output" {
+				continue
+			}
+
+			b, ok := output[e.Package]
+			if !ok {
+				b = new(strings.Builder)
+				output[e.Package] = b
+				packages = append(packages, e.Package)
+			}
+			b.WriteString(e.Output)
+			continue
+		}
+
+		parseLine(line, samples)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	for _, p := range packages {
+		for _, line := range strings.Split(output[p].String(), "\n") {
+			parseLine(line, samples)
+		}
+	}
+
+	return nil
+}
+
+// procsSuffix is the -GOMAXPROCS suffix go test adds to benchmark names
+// when GOMAXPROCS is not 1
+var procsSuffix = regexp.MustCompile(`-\d+$`)
+
+// parseLine adds the metrics of a benchmark result line to samples, other
+// lines are ignored.
+//
+// Benchmark names are Benchmark<Op>/<orm>, the last element of the name is
+// the ORM and everything before it is the operation.
+func parseLine(line string, samples Samples) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") || len(fields)%2 != 0 {
+		return
+	}
+	if _, err := strconv.Atoi(fields[1]); err != nil {
+		return
+	}
+
+	name := procsSuffix.ReplaceAllString(strings.TrimPrefix(fields[0], "Benchmark"), "")
+	slash := strings.LastIndexByte(name, '/')
+	if slash < 0 {
+		return
+	}
+	op, orm := name[:slash], name[slash+1:]
+
+	for i := 2; i < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return
+		}
+
+		key := Key{Op: op, ORM: orm, Unit: fields[i+1]}
+		samples[key] = append(samples[key], value)
+	}
+}
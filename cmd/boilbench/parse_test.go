@@ -0,0 +1,77 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	text := `goos: linux
+goarch: amd64
+pkg: github.com/aarondl/boilbench
+BenchmarkSelectAll/boil-8         	   50000	     20000 ns/op	    2920 B/op	      46 allocs/op
+BenchmarkSelectAll/boil-16        	   50000	     22000 ns/op	    2920 B/op	      46 allocs/op
+BenchmarkSelectAll/gorm           	   50000	     30000 ns/op	    9138 B/op	     179 allocs/op
+BenchmarkSelectAll/goqu           	   50000	     15000 ns/op	   90000 max-ns	   11000 p50-ns	   18000 p90-ns	   62000 p99-ns
+--- SKIP: BenchmarkEagerLoad/gorp
+    unsupported
+BenchmarkSQLXRawBind
+    raw_bind_test.go:27: broken
+PASS
+`
+
+	json := `{"Action":"start","Package":"github.com/aarondl/boilbench"}
+{"Action":"output","Package":"github.com/aarondl/boilbench","Output":"BenchmarkSelectAll/bob\n"}
+{"Action":"output","Package":"github.com/aarondl/boilbench","Output":"BenchmarkSelectAll/bob-4         \t"}
+{"Action":"output","Package":"github.com/aarondl/boilbench","Output":"   50000\t     24000 ns/op\t    8689 B/op\t     133 allocs/op\n"}
+{"Action":"pass","Package":"github.com/aarondl/boilbench"}
+`
+
+	samples := make(Samples)
+	if err := parse(strings.NewReader(text), samples); err != nil {
+		t.Fatal(err)
+	}
+	if err := parse(strings.NewReader(json), samples); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Samples{
+		{Op: "SelectAll", ORM: "boil", Unit: "ns/op"}:     {20000, 22000},
+		{Op: "SelectAll", ORM: "boil", Unit: "B/op"}:      {2920, 2920},
+		{Op: "SelectAll", ORM: "boil", Unit: "allocs/op"}: {46, 46},
+		{Op: "SelectAll", ORM: "gorm", Unit: "ns/op"}:     {30000},
+		{Op: "SelectAll", ORM: "gorm", Unit: "B/op"}:      {9138},
+		{Op: "SelectAll", ORM: "gorm", Unit: "allocs/op"}: {179},
+		{Op: "SelectAll", ORM: "bob", Unit: "ns/op"}:      {24000},
+		{Op: "SelectAll", ORM: "bob", Unit: "B/op"}:       {8689},
+		{Op: "SelectAll", ORM: "bob", Unit: "allocs/op"}:  {133},
+		{Op: "SelectAll", ORM: "goqu", Unit: "ns/op"}:     {15000},
+		{Op: "SelectAll", ORM: "goqu", Unit: "max-ns"}:    {90000},
+		{Op: "SelectAll", ORM: "goqu", Unit: "p50-ns"}:    {11000},
+		{Op: "SelectAll", ORM: "goqu", Unit: "p90-ns"}:    {18000},
+		{Op: "SelectAll", ORM: "goqu", Unit: "p99-ns"}:    {62000},
+	}
+	if !reflect.DeepEqual(want, samples) {
+		t.Errorf("want:\n%v\ngot:\n%v", want, samples)
+	}
+
+	units := []string{"ns/op", "B/op", "allocs/op", "p50-ns", "p90-ns", "p99-ns", "max-ns"}
+	if got := samples.Units(); !reflect.DeepEqual(got, units) {
+		t.Error("wrong unit order:", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s := summarize([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if s.N != 8 || s.Min != 2 || s.Max != 9 || s.Mean != 5 || s.Median != 4.5 {
+		t.Errorf("wrong stats: %+v", s)
+	}
+	if s.StdDev < 2.138 || s.StdDev > 2.139 {
+		t.Error("wrong sample standard deviation:", s.StdDev)
+	}
+
+	if s := summarize([]float64{3}); s.StdDev != 0 {
+		t.Error("standard deviation of one sample should be 0:", s.StdDev)
+	}
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const reportUsage = "report [-data dir] [-graphs dir] [files]"
+
+// report summarizes benchmark output from any number of runs, writing a csv
+// per operation and metric to -data and an svg bar chart of each to
+// -graphs. Operations benchmarked at several sizes, like WideSelectAll/25,
+// also get a csv and a line chart of the means at every size, and a csv of
+// the fixed and per size cost fitted to them, or of how their throughput
+// scales for those benchmarked at several GOMAXPROCS. The variants of the
+// sqlboiler models are compared to them in variants.csv. Summary tables
+// are printed to stdout.
+func report(args []string) error {
+	fs := newFlagSet("report", reportUsage)
+	data := fs.String("data", "graph_data", "directory to write csv files to, empty to skip them")
+	graphs := fs.String("graphs", "graphs", "directory to write svg charts to, empty to skip them")
+	_ = fs.Parse(args)
+
+	samples, err := parseFiles(fs.Args())
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return errors.New("no benchmark results found")
+	}
+
+	if err := writeTable(os.Stdout, samples); err != nil {
+		return err
+	}
+	if err := writeFitTable(os.Stdout, samples); err != nil {
+		return err
+	}
+	if err := writeScalingTable(os.Stdout, samples); err != nil {
+		return err
+	}
+	changes := variantChanges(samples)
+	if err := writeVariantTable(os.Stdout, changes); err != nil {
+		return err
+	}
+	if len(*data) != 0 && len(changes) != 0 {
+		if err := writeFile(filepath.Join(*data, "variants.csv"), func(w io.Writer) error {
+			return writeVariantCSV(w, changes)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, op := range samples.Ops() {
+		for _, unit := range samples.Units() {
+			rows := summarizeOp(samples, op, unit)
+			if len(rows) == 0 {
+				continue
+			}
+
+			m := metricFor(unit)
+			name := strings.ReplaceAll(op, "/", "_") + "_" + m.name
+
+			if len(*data) != 0 {
+				if err := writeFile(filepath.Join(*data, name+".csv"), func(w io.Writer) error {
+					return writeCSV(w, rows)
+				}); err != nil {
+					return err
+				}
+			}
+
+			if len(*graphs) != 0 {
+				title := op + " " + m.title
+				if err := writeFile(filepath.Join(*graphs, strings.ToLower(name)+".svg"), func(w io.Writer) error {
+					return writeChart(w, title, m.unit, rows)
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, s := range findSeries(samples.Ops()) {
+		for _, unit := range samples.Units() {
+			lines := summarizeSeries(samples, s, unit)
+			if len(lines) == 0 {
+				continue
+			}
+
+			m := metricFor(unit)
+			name := strings.ReplaceAll(s.family, "/", "_") + "_" + m.name + "_series"
+
+			if len(*data) != 0 {
+				if err := writeFile(filepath.Join(*data, name+".csv"), func(w io.Writer) error {
+					return writeSeriesCSV(w, s, lines)
+				}); err != nil {
+					return err
+				}
+				if err := writeFitOrScaling(*data, strings.TrimSuffix(name, "_series"), unit, s, lines); err != nil {
+					return err
+				}
+			}
+
+			if len(*graphs) != 0 {
+				title := s.family + " " + m.title
+				if err := writeFile(filepath.Join(*graphs, strings.ToLower(name)+".svg"), func(w io.Writer) error {
+					return writeSeriesChart(w, title, m.unit, sizeLabel(s.family), s, lines)
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeFitOrScaling writes name_fit.csv with the fit of lines, or for
+// families benchmarked at several GOMAXPROCS name_scaling.csv with the
+// scaling of their ns/op
+func writeFitOrScaling(dir, name, unit string, s series, lines []line) error {
+	if !scales(s.family) {
+		return writeFile(filepath.Join(dir, name+"_fit.csv"), func(w io.Writer) error {
+			return writeFitCSV(w, s, fitSeries(s, lines))
+		})
+	}
+	if unit != "ns/op" {
+		return nil
+	}
+	return writeFile(filepath.Join(dir, name+"_scaling.csv"), func(w io.Writer) error {
+		return writeSeriesCSV(w, s, scaleSeries(lines))
+	})
+}
+
+// row is the summary of one ORM for an operation and metric
+type row struct {
+	orm string
+	Stats
+}
+
+// summarizeOp returns the stats of every ORM that reported unit for op
+func summarizeOp(samples Samples, op, unit string) []row {
+	var rows []row
+	for _, orm := range samples.ORMs() {
+		values, ok := samples[Key{Op: op, ORM: orm, Unit: unit}]
+		if !ok {
+			continue
+		}
+		rows = append(rows, row{orm: orm, Stats: summarize(values)})
+	}
+	return rows
+}
+
+// writeCSV writes orm,min,max,mean,range,stddev lines, the columns of the
+// old graphing scripts with the standard deviation after them
+func writeCSV(w io.Writer, rows []row) error {
+	for _, r := range rows {
+		_, err := fmt.Fprintf(w, "%s,%s,%s,%s,%s,%s\n", r.orm,
+			formatFloat(r.Min), formatFloat(r.Max), formatFloat(r.Mean), formatFloat(r.Max-r.Min), formatFloat(r.StdDev))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTable prints the mean and standard deviation of every metric
+func writeTable(w io.Writer, samples Samples) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	units := samples.Units()
+
+	fmt.Fprint(tw, "op\torm\tn\t")
+	for _, unit := range units {
+		fmt.Fprintf(tw, "%s\t±\t", unit)
+	}
+	fmt.Fprintln(tw)
+
+	for _, op := range samples.Ops() {
+		for _, orm := range samples.ORMs() {
+			var cells []string
+			n, found := 0, false
+			for _, unit := range units {
+				values, ok := samples[Key{Op: op, ORM: orm, Unit: unit}]
+				if !ok {
+					cells = append(cells, "-", "")
+					continue
+				}
+
+				s := summarize(values)
+				n, found = s.N, true
+				cells = append(cells, formatFloat(round(s.Mean)), formatFloat(round(s.StdDev)))
+			}
+			if !found {
+				continue
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t\n", op, orm, n, strings.Join(cells, "\t"))
+		}
+	}
+
+	return tw.Flush()
+}
+
+// writeFile creates name and its directory and writes it with fn
+func writeFile(name string, fn func(io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// round to two decimal places for display
+func round(f float64) float64 {
+	r, _ := strconv.ParseFloat(strconv.FormatFloat(f, 'f', 2, 64), 64)
+	return r
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// series is a family of operations benchmarked at several sizes, the ops
+// are named family/size, like WideSelectAll/25
+type series struct {
+	family string
+	sizes  []int
+}
+
+// op is the name of the operation at size
+func (s series) op(size int) string {
+	return s.family + "/" + strconv.Itoa(size)
+}
+
+// findSeries groups the ops whose last segment is a number by the rest of
+// their name, families are sorted and sizes ascending
+func findSeries(ops []string) []series {
+	sizes := make(map[string][]int)
+	for _, op := range ops {
+		i := strings.LastIndexByte(op, '/')
+		if i < 0 {
+			continue
+		}
+		size, err := strconv.Atoi(op[i+1:])
+		if err != nil {
+			continue
+		}
+		sizes[op[:i]] = append(sizes[op[:i]], size)
+	}
+
+	var found []series
+	for family, s := range sizes {
+		sort.Ints(s)
+		found = append(found, series{family: family, sizes: s})
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].family < found[j].family })
+	return found
+}
+
+// sizeLabels name the sizes of the families starting with each prefix
+var sizeLabels = map[string]string{
+	"Wide":     "columns",
+	"Rows":     "rows",
+	"Parallel": "procs",
+	"Hooks":    "hooks",
+	"Relation": "related",
+}
+
+// sizeLabel is the x axis label of a family
+func sizeLabel(family string) string {
+	for prefix, label := range sizeLabels {
+		if strings.HasPrefix(family, prefix) {
+			return label
+		}
+	}
+	return "size"
+}
+
+// line is the mean of one ORM at every size of a series, NaN where it
+// wasn't benchmarked
+type line struct {
+	orm   string
+	means []float64
+}
+
+// summarizeSeries returns a line for every ORM that reported unit for any
+// op of s
+func summarizeSeries(samples Samples, s series, unit string) []line {
+	var lines []line
+	for _, orm := range samples.ORMs() {
+		l := line{orm: orm, means: make([]float64, len(s.sizes))}
+		found := false
+		for i, size := range s.sizes {
+			values, ok := samples[Key{Op: s.op(size), ORM: orm, Unit: unit}]
+			if !ok {
+				l.means[i] = math.NaN()
+				continue
+			}
+			l.means[i], found = summarize(values).Mean, true
+		}
+		if found {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// writeSeriesCSV writes a header of the sizes and an orm,mean,mean... line
+// per ORM, missing means are left empty
+func writeSeriesCSV(w io.Writer, s series, lines []line) error {
+	header := []string{"orm"}
+	for _, size := range s.sizes {
+		header = append(header, strconv.Itoa(size))
+	}
+	if _, err := fmt.Fprintln(w, strings.Join(header, ",")); err != nil {
+		return err
+	}
+
+	for _, l := range lines {
+		cells := []string{l.orm}
+		for _, m := range l.means {
+			if math.IsNaN(m) {
+				cells = append(cells, "")
+				continue
+			}
+			cells = append(cells, formatFloat(m))
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cells, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fit is a line through the means of one ORM, fixed is the cost at size 0,
+// like the cost of a query without its rows, and perSize the cost of every
+// unit of size after it
+type fit struct {
+	orm     string
+	fixed   float64
+	perSize float64
+	r2      float64
+}
+
+// fitSeries fits mean = fixed + perSize*size to every line with two sizes
+// or more. The least squares are weighted by 1/mean², minimizing relative
+// rather than absolute error, so that the largest sizes don't drown out
+// the fixed cost when sizes span orders of magnitude.
+func fitSeries(s series, lines []line) []fit {
+	var fits []fit
+	for _, l := range lines {
+		var n, sw, sx, sy, sxx, sxy float64
+		for i, m := range l.means {
+			if math.IsNaN(m) || m <= 0 {
+				continue
+			}
+			x, w := float64(s.sizes[i]), 1/(m*m)
+			n++
+			sw += w
+			sx += w * x
+			sy += w * m
+			sxx += w * x * x
+			sxy += w * x * m
+		}
+		d := sw*sxx - sx*sx
+		if n < 2 || d == 0 {
+			continue
+		}
+
+		f := fit{orm: l.orm}
+		f.perSize = (sw*sxy - sx*sy) / d
+		f.fixed = (sy - f.perSize*sx) / sw
+
+		var res, tot float64
+		for i, m := range l.means {
+			if math.IsNaN(m) || m <= 0 {
+				continue
+			}
+			w := 1 / (m * m)
+			e := m - f.fixed - f.perSize*float64(s.sizes[i])
+			res += w * e * e
+			tot += w * (m - sy/sw) * (m - sy/sw)
+		}
+		f.r2 = 1
+		if tot != 0 {
+			f.r2 = 1 - res/tot
+		}
+		fits = append(fits, f)
+	}
+	return fits
+}
+
+// perLabel names the cost of one unit of size of a family, like row
+func perLabel(family string) string {
+	return "per_" + strings.TrimSuffix(sizeLabel(family), "s")
+}
+
+// writeFitCSV writes a header like orm,fixed,per_row,r2 and a line per ORM
+func writeFitCSV(w io.Writer, s series, fits []fit) error {
+	if _, err := fmt.Fprintf(w, "orm,fixed,%s,r2\n", perLabel(s.family)); err != nil {
+		return err
+	}
+	for _, f := range fits {
+		_, err := fmt.Fprintf(w, "%s,%s,%s,%s\n", f.orm,
+			formatFloat(round(f.fixed)), formatFloat(round(f.perSize)), formatFloat(math.Round(f.r2*1e4)/1e4))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFitTable prints the fit of every ORM for every series and unit
+func writeFitTable(w io.Writer, samples Samples) error {
+	var found []series
+	for _, s := range findSeries(samples.Ops()) {
+		if !scales(s.family) {
+			found = append(found, s)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "\nop\tunit\torm\tfixed\tper size\tr²\t")
+	for _, s := range found {
+		for _, unit := range samples.Units() {
+			for _, f := range fitSeries(s, summarizeSeries(samples, s, unit)) {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s/%s\t%.4f\t\n", s.family, unit, f.orm,
+					formatFloat(round(f.fixed)), formatFloat(round(f.perSize)), strings.TrimSuffix(sizeLabel(s.family), "s"), f.r2)
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+// scales reports whether the sizes of a family are GOMAXPROCS. Those
+// aren't fitted, a cost per proc means nothing, instead their throughput
+// is reported relative to the fewest procs.
+func scales(family string) bool {
+	return sizeLabel(family) == "procs"
+}
+
+// scaleSeries returns the throughput of every line relative to its first
+// size, the mean ns/op at the first size over that at each rounded to two
+// decimals. A line that
+// doubles at twice the procs scales perfectly, one that stays at 1 is
+// serialized, by a lock in an ORM's caches for example.
+func scaleSeries(lines []line) []line {
+	var scaled []line
+	for _, l := range lines {
+		base := l.means[0]
+		if math.IsNaN(base) || base <= 0 {
+			continue
+		}
+
+		s := line{orm: l.orm, means: make([]float64, len(l.means))}
+		for i, m := range l.means {
+			s.means[i] = round(base / m)
+			if m <= 0 {
+				s.means[i] = math.NaN()
+			}
+		}
+		scaled = append(scaled, s)
+	}
+	return scaled
+}
+
+// writeScalingTable prints how the throughput of every ORM scales with
+// GOMAXPROCS for every family benchmarked at several
+func writeScalingTable(w io.Writer, samples Samples) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	for _, s := range findSeries(samples.Ops()) {
+		if !scales(s.family) {
+			continue
+		}
+
+		fmt.Fprintf(tw, "\n%s\torm\t", s.family)
+		for _, size := range s.sizes {
+			fmt.Fprintf(tw, "%d\t", size)
+		}
+		fmt.Fprintln(tw)
+
+		for _, l := range scaleSeries(summarizeSeries(samples, s, "ns/op")) {
+			fmt.Fprintf(tw, "\t%s\t", l.orm)
+			for _, m := range l.means {
+				if math.IsNaN(m) {
+					fmt.Fprint(tw, "-\t")
+					continue
+				}
+				fmt.Fprintf(tw, "%.2fx\t", m)
+			}
+			fmt.Fprintln(tw)
+		}
+	}
+	return tw.Flush()
+}
+
+// Series charts are wider than the bar charts to fit the legend
+const (
+	seriesWidth  = 420
+	legendWidth  = 90
+	seriesMargin = 20
+)
+
+// writeSeriesChart renders a line chart of the mean of every ORM against
+// the size, sizes are spaced proportionally on the x axis
+func writeSeriesChart(w io.Writer, title, unit, xlabel string, s series, lines []line) error {
+	plotRight := float64(seriesWidth - legendWidth - seriesMargin)
+	plotWidth := plotRight - marginLeft
+	plotHeight := float64(chartHeight - marginTop - marginBottom)
+	bottom := float64(chartHeight - marginBottom)
+
+	var top float64
+	for _, l := range lines {
+		for _, m := range l.means {
+			if !math.IsNaN(m) {
+				top = math.Max(top, m)
+			}
+		}
+	}
+	top = niceCeil(top)
+	maxSize := float64(s.sizes[len(s.sizes)-1])
+
+	x := func(size int) float64 { return marginLeft + float64(size)/maxSize*plotWidth }
+	y := func(v float64) float64 { return bottom - v/top*plotHeight }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="10">`+"\n",
+		seriesWidth, chartHeight, seriesWidth, chartHeight)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", seriesWidth, chartHeight)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" font-size="14">%s</text>`+"\n",
+		seriesWidth/2, marginTop/2+4, html.EscapeString(title))
+
+	for i := 0; i <= chartTicks; i++ {
+		v := top / chartTicks * float64(i)
+		fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#e5e5e5"/>`+"\n",
+			marginLeft, y(v), plotRight, y(v))
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n",
+			marginLeft-4, y(v), shortNumber(v))
+	}
+	fmt.Fprintf(&b, `<text transform="translate(12,%.1f) rotate(-90)" text-anchor="middle">%s</text>`+"\n",
+		bottom-plotHeight/2, html.EscapeString(unit))
+
+	for _, size := range s.sizes {
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle">%d</text>`+"\n", x(size), bottom+14, size)
+	}
+	fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`+"\n",
+		marginLeft+plotWidth/2, bottom+34, html.EscapeString(xlabel))
+
+	for i, l := range lines {
+		color := chartColors[i%len(chartColors)]
+
+		var path []string
+		for j, m := range l.means {
+			if math.IsNaN(m) {
+				continue
+			}
+			cmd := "L"
+			if len(path) == 0 {
+				cmd = "M"
+			}
+			path = append(path, fmt.Sprintf("%s%.1f %.1f", cmd, x(s.sizes[j]), y(m)))
+			fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="2.5" fill="%s"><title>%s %d: %s</title></circle>`+"\n",
+				x(s.sizes[j]), y(m), color, html.EscapeString(l.orm), s.sizes[j], shortNumber(m))
+		}
+		fmt.Fprintf(&b, `<path d="%s" fill="none" stroke="%s" stroke-width="1.5"/>`+"\n", strings.Join(path, ""), color)
+
+		legendY := marginTop + 14*i
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="10" height="10" fill="%s"/>`+"\n", plotRight+seriesMargin, legendY, color)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%d" dominant-baseline="middle">%s</text>`+"\n",
+			plotRight+seriesMargin+14, legendY+5, html.EscapeString(l.orm))
+	}
+
+	fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%.1f" y2="%.1f" stroke="black"/>`+"\n",
+		marginLeft, bottom, plotRight, bottom)
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
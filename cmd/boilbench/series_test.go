@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries(t *testing.T) {
+	samples := Samples{
+		{Op: "WideSelectAll/10", ORM: "boil", Unit: "ns/op"}:  {100, 200},
+		{Op: "WideSelectAll/100", ORM: "boil", Unit: "ns/op"}: {1000},
+		{Op: "WideSelectAll/25", ORM: "boil", Unit: "ns/op"}:  {250},
+		{Op: "WideSelectAll/25", ORM: "gorm", Unit: "ns/op"}:  {500},
+		{Op: "SelectAll", ORM: "boil", Unit: "ns/op"}:         {50},
+	}
+
+	found := findSeries(samples.Ops())
+	want := []series{{family: "WideSelectAll", sizes: []int{10, 25, 100}}}
+	if !reflect.DeepEqual(want, found) {
+		t.Fatalf("want %v, got %v", want, found)
+	}
+
+	var buf bytes.Buffer
+	if err := writeSeriesCSV(&buf, found[0], summarizeSeries(samples, found[0], "ns/op")); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "orm,10,25,100\nboil,150,250,1000\ngorm,,500,\n" {
+		t.Errorf("wrong csv:\n%s", got)
+	}
+
+	if label := sizeLabel("WideSelectAll"); label != "columns" {
+		t.Error("wrong label:", label)
+	}
+}
+
+func TestFitSeries(t *testing.T) {
+	s := series{family: "RowsSelectAll", sizes: []int{1, 10, 100, 1000}}
+	lines := []line{
+		{orm: "boil", means: []float64{102, 120, 300, 2100}},
+		{orm: "gorm", means: []float64{math.NaN(), 50, math.NaN(), math.NaN()}},
+	}
+
+	fits := fitSeries(s, lines)
+	if len(fits) != 1 {
+		t.Fatalf("want one fit, got %v", fits)
+	}
+	if f := fits[0]; math.Abs(f.fixed-100) > 1e-6 || math.Abs(f.perSize-2) > 1e-9 || math.Abs(f.r2-1) > 1e-9 {
+		t.Errorf("wrong fit: %+v", f)
+	}
+
+	var buf bytes.Buffer
+	if err := writeFitCSV(&buf, s, fits); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "orm,fixed,per_row,r2\nboil,100,2,1\n" {
+		t.Errorf("wrong csv:\n%s", got)
+	}
+}
+
+func TestScaleSeries(t *testing.T) {
+	s := series{family: "ParallelSelectAll", sizes: []int{1, 2, 4}}
+	lines := []line{
+		{orm: "boil", means: []float64{100, 50, 30}},
+		{orm: "gorm", means: []float64{100, 100, math.NaN()}},
+		{orm: "xorm", means: []float64{math.NaN(), 100, 100}},
+	}
+
+	if !scales(s.family) || scales("RowsSelectAll") {
+		t.Error("only Parallel families should scale")
+	}
+
+	var buf bytes.Buffer
+	if err := writeSeriesCSV(&buf, s, scaleSeries(lines)); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "orm,1,2,4\nboil,1,2,3.33\ngorm,1,1,\n" {
+		t.Errorf("wrong csv:\n%s", got)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Stats summarizes the samples of a key
+type Stats struct {
+	N      int     `json:"n"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	StdDev float64 `json:"stddev"`
+}
+
+// summarize values, StdDev is the sample standard deviation and is 0 for
+// fewer than two values
+func summarize(values []float64) Stats {
+	if len(values) == 0 {
+		return Stats{}
+	}
+
+	s := Stats{N: len(values), Min: values[0], Max: values[0]}
+	var sum float64
+	for _, v := range values {
+		s.Min = math.Min(s.Min, v)
+		s.Max = math.Max(s.Max, v)
+		sum += v
+	}
+	s.Mean = sum / float64(len(values))
+	s.Median = median(values)
+
+	if len(values) > 1 {
+		var squares float64
+		for _, v := range values {
+			squares += (v - s.Mean) * (v - s.Mean)
+		}
+		s.StdDev = math.Sqrt(squares / float64(len(values)-1))
+	}
+
+	return s
+}
+
+// median of values, which are not modified
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// metric describes how a unit is named in files and graphs
+type metric struct {
+	unit  string
+	name  string
+	title string
+}
+
+// metrics are the units go test -benchmem reports, in the order they're
+// reported in, followed by the latency percentiles reported with -latency,
+// the time the Cold benchmarks take to open the ORM, how much the heap
+// grows filling sqlboiler's caches in ColumnsUpdate/Cycle and the
+// statements the Unit benchmark issues
+var metrics = []metric{
+	{unit: "ns/op", name: "nsop", title: "Speed"},
+	{unit: "B/op", name: "bop", title: "Memory"},
+	{unit: "allocs/op", name: "aop", title: "Allocations"},
+	{unit: "p50-ns", name: "p50", title: "Median latency"},
+	{unit: "p90-ns", name: "p90", title: "p90 latency"},
+	{unit: "p99-ns", name: "p99", title: "p99 latency"},
+	{unit: "max-ns", name: "max", title: "Max latency"},
+	{unit: "open-ns", name: "open", title: "Open"},
+	{unit: "cache-B", name: "cache", title: "Cache growth"},
+	{unit: "stmts/op", name: "stmts", title: "Statements"},
+}
+
+// metricOrder sorts the well known units first
+func metricOrder(unit string) int {
+	for i, m := range metrics {
+		if m.unit == unit {
+			return i
+		}
+	}
+	return len(metrics)
+}
+
+// metricFor returns the metric of unit, units reported with
+// b.ReportMetric are named after the unit with punctuation removed.
+func metricFor(unit string) metric {
+	for _, m := range metrics {
+		if m.unit == unit {
+			return m
+		}
+	}
+
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '-' || r == '_':
+			return '_'
+		}
+		return -1
+	}, unit)
+	return metric{unit: unit, name: name, title: unit}
+}
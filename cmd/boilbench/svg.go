@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+)
+
+// Chart dimensions, the same as the graphs in the sqlboiler readme
+const (
+	chartWidth  = 300
+	chartHeight = 300
+
+	marginLeft   = 60
+	marginRight  = 30
+	marginTop    = 40
+	marginBottom = 60
+
+	chartTicks = 5
+)
+
+var chartColors = []string{
+	"rgb(49,171,95)", "rgb(49,110,171)", "rgb(212,109,57)",
+	"rgb(148,62,154)", "rgb(54,176,165)", "rgb(184,75,75)",
+	"rgb(201,170,55)", "rgb(110,110,110)",
+}
+
+// writeChart renders a bar chart of the mean of each row with the standard
+// deviation as error bars
+func writeChart(w io.Writer, title, unit string, rows []row) error {
+	plotWidth := float64(chartWidth - marginLeft - marginRight)
+	plotHeight := float64(chartHeight - marginTop - marginBottom)
+	bottom := float64(chartHeight - marginBottom)
+
+	var top float64
+	for _, r := range rows {
+		top = math.Max(top, r.Mean+r.StdDev)
+	}
+	top = niceCeil(top)
+	y := func(v float64) float64 { return bottom - v/top*plotHeight }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="10">`+"\n",
+		chartWidth, chartHeight, chartWidth, chartHeight)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", chartWidth, chartHeight)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle" font-size="14">%s</text>`+"\n",
+		chartWidth/2, marginTop/2+4, html.EscapeString(title))
+
+	for i := 0; i <= chartTicks; i++ {
+		v := top / chartTicks * float64(i)
+		fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="#e5e5e5"/>`+"\n",
+			marginLeft, y(v), chartWidth-marginRight, y(v))
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n",
+			marginLeft-4, y(v), shortNumber(v))
+	}
+	fmt.Fprintf(&b, `<text transform="translate(12,%.1f) rotate(-90)" text-anchor="middle">%s</text>`+"\n",
+		bottom-plotHeight/2, html.EscapeString(unit))
+
+	slot := plotWidth / float64(len(rows))
+	for i, r := range rows {
+		center := float64(marginLeft) + slot*(float64(i)+0.5)
+		barWidth := slot * 0.6
+
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"><title>%s: %s ± %s</title></rect>`+"\n",
+			center-barWidth/2, y(r.Mean), barWidth, bottom-y(r.Mean), chartColors[i%len(chartColors)],
+			html.EscapeString(r.orm), shortNumber(r.Mean), shortNumber(r.StdDev))
+
+		if r.StdDev > 0 {
+			low, high := y(math.Max(r.Mean-r.StdDev, 0)), y(r.Mean+r.StdDev)
+			fmt.Fprintf(&b, `<path d="M%.1f %.1fV%.1fM%.1f %.1fh%.1fM%.1f %.1fh%.1f" stroke="black"/>`+"\n",
+				center, low, high, center-barWidth/4, low, barWidth/2, center-barWidth/4, high, barWidth/2)
+		}
+
+		fmt.Fprintf(&b, `<text transform="translate(%.1f,%.1f) rotate(-45)" text-anchor="end">%s</text>`+"\n",
+			center, bottom+12, html.EscapeString(r.orm))
+	}
+
+	fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="black"/>`+"\n",
+		marginLeft, bottom, chartWidth-marginRight, bottom)
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// niceCeil rounds v up to 1, 2, 2.5 or 5 times a power of ten so that the
+// axis ticks are round numbers
+func niceCeil(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+
+	pow := math.Pow(10, math.Floor(math.Log10(v)))
+	for _, step := range []float64{1, 2, 2.5, 5, 10} {
+		if step*pow >= v {
+			return step * pow
+		}
+	}
+	return 10 * pow
+}
+
+// shortNumber formats axis labels like 25k
+func shortNumber(v float64) string {
+	switch {
+	case v >= 1e9:
+		return fmt.Sprintf("%.3gG", v/1e9)
+	case v >= 1e6:
+		return fmt.Sprintf("%.3gM", v/1e6)
+	case v >= 1e3:
+		return fmt.Sprintf("%.3gk", v/1e3)
+	}
+	return fmt.Sprintf("%.3g", v)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// variantPrefix starts the names of the variants of the sqlboiler models
+// generated with other options, like boil-nohooks
+const variantPrefix = "boil-"
+
+// variantAlpha is the significance level variants are compared to boil at
+const variantAlpha = 0.05
+
+// variantChanges compares every variant to boil on every op and unit both
+// were benchmarked with, in the order of the units and then the ops
+func variantChanges(samples Samples) []change {
+	var changes []change
+	for _, unit := range samples.Units() {
+		for _, op := range samples.Ops() {
+			base, ok := samples[Key{Op: op, ORM: "boil", Unit: unit}]
+			if !ok {
+				continue
+			}
+
+			for _, orm := range samples.ORMs() {
+				values, ok := samples[Key{Op: op, ORM: orm, Unit: unit}]
+				if !ok || !strings.HasPrefix(orm, variantPrefix) {
+					continue
+				}
+
+				c := change{key: Key{Op: op, ORM: orm, Unit: unit}, old: base, new: values}
+				c.p = mannWhitneyU(base, values)
+				c.significant = c.p < variantAlpha
+				changes = append(changes, c)
+			}
+		}
+	}
+	return changes
+}
+
+// variantDelta is the change of a variant from boil in percent, or ~ when
+// it isn't significant
+func variantDelta(c change) string {
+	if !c.significant {
+		return "~"
+	}
+	return fmt.Sprintf("%+.2f%%", c.delta())
+}
+
+// writeVariantTable prints the median of boil and of every variant and the
+// change between them, for every op and unit
+func writeVariantTable(w io.Writer, changes []change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "\nop\tunit\tvariant\tboil\tvariant\tdelta\tp\t")
+	for _, c := range changes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%.3f\t\n", c.key.Op, c.key.Unit, strings.TrimPrefix(c.key.ORM, variantPrefix),
+			formatFloat(round(median(c.old))), formatFloat(round(median(c.new))), variantDelta(c), c.p)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "~ marks no significant change, p >= %g in a Mann-Whitney U test\n", variantAlpha)
+	return err
+}
+
+// writeVariantCSV writes an op,unit,variant,boil,median,delta,p line per
+// change, delta is empty when it isn't significant
+func writeVariantCSV(w io.Writer, changes []change) error {
+	if _, err := fmt.Fprintln(w, "op,unit,variant,boil,median,delta,p"); err != nil {
+		return err
+	}
+	for _, c := range changes {
+		delta := ""
+		if c.significant {
+			delta = formatFloat(round(c.delta()))
+		}
+		_, err := fmt.Fprintf(w, "%s,%s,%s,%s,%s,%s,%.3f\n", c.key.Op, c.key.Unit, strings.TrimPrefix(c.key.ORM, variantPrefix),
+			formatFloat(round(median(c.old))), formatFloat(round(median(c.new))), delta, c.p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVariantChanges(t *testing.T) {
+	samples := Samples{
+		{Op: "Insert", ORM: "boil", Unit: "ns/op"}:           {100, 101, 102, 103, 104},
+		{Op: "Insert", ORM: "boil-nohooks", Unit: "ns/op"}:   {90, 91, 92, 93, 94},
+		{Op: "Insert", ORM: "boil-nocontext", Unit: "ns/op"}: {100, 104, 101, 103, 102},
+		{Op: "Insert", ORM: "gorm", Unit: "ns/op"}:           {500},
+		{Op: "Delete", ORM: "boil-nohooks", Unit: "ns/op"}:   {50},
+	}
+
+	changes := variantChanges(samples)
+	if len(changes) != 2 {
+		t.Fatalf("want the 2 variants of Insert, got %v", changes)
+	}
+
+	var buf bytes.Buffer
+	if err := writeVariantCSV(&buf, changes); err != nil {
+		t.Fatal(err)
+	}
+	want := "op,unit,variant,boil,median,delta,p\n" +
+		"Insert,ns/op,nocontext,102,102,,1.000\n" +
+		"Insert,ns/op,nohooks,102,92,-9.8,0.008\n"
+	if got := buf.String(); got != want {
+		t.Errorf("wrong csv:\n%s", got)
+	}
+}
@@ -0,0 +1,97 @@
+// Command jet-schema generates the go-jet table and model types from the DDL
+// in schema.sql instead of connecting to a database, so that they can be
+// generated without one. It describes the tables the way go-jet's postgres
+// generator reads them from the catalog of a database loaded from the same
+// file and then runs go-jet's default templates over them.
+//
+// Run it from the root of the repository:
+//
+//	go run ./cmd/jet-schema
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/aarondl/boilbench/schema"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+func main() {
+	schemaFile := flag.String("schema", "schema.sql", "DDL to generate the types from")
+	path := flag.String("path", "gojets", "directory to generate the types in")
+	dbName := flag.String("dbname", "postgres", "database name, the types are generated in a folder of that name")
+	schemaName := flag.String("schema-name", "public", "schema the tables belong to")
+	flag.Parse()
+
+	if err := generate(*schemaFile, filepath.Join(*path, *dbName), *schemaName); err != nil {
+		fmt.Fprintln(os.Stderr, "jet-schema:", err)
+		os.Exit(1)
+	}
+}
+
+func generate(schemaFile, dir, schemaName string) error {
+	s, err := schema.ParseFile(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	return template.ProcessSchema(dir, describe(s, schemaName), template.Default(postgres.Dialect))
+}
+
+// describe converts the parsed DDL to go-jet's metadata, tables and enums
+// are sorted by name like the catalog queries sort them
+func describe(s *schema.Schema, schemaName string) metadata.Schema {
+	meta := metadata.Schema{Name: schemaName}
+
+	for _, name := range s.TableNames() {
+		t := s.Table(name)
+		table := metadata.Table{Name: t.Name}
+
+		for _, c := range t.Columns {
+			column := metadata.Column{
+				Name:        c.Name,
+				IsNullable:  c.Nullable,
+				IsGenerated: c.Generated,
+				// Identity columns have no default in pg_attrdef, generated
+				// columns have their expression there
+				HasDefault: len(c.Default) != 0,
+				DataType:   metadata.DataType{Name: c.UDTName, Kind: metadata.BaseType},
+			}
+			if t.PrimaryKey != nil {
+				for _, pk := range t.PrimaryKey.Columns {
+					column.IsPrimaryKey = column.IsPrimaryKey || pk == c.Name
+				}
+			}
+
+			switch {
+			case c.Array:
+				// Arrays are named by format_type, integer[] or numeric(10,2)[]
+				column.DataType = metadata.DataType{Name: c.FullType(), Kind: metadata.ArrayType}
+			case c.IsEnum():
+				column.DataType.Kind = metadata.EnumType
+			}
+
+			table.Columns = append(table.Columns, column)
+		}
+
+		meta.TablesMetaData = append(meta.TablesMetaData, table)
+	}
+
+	enums := make([]string, len(s.Enums))
+	for i, e := range s.Enums {
+		enums[i] = e.Name
+	}
+	sort.Strings(enums)
+	for _, name := range enums {
+		e := s.Enum(name)
+		meta.EnumsMetaData = append(meta.EnumsMetaData, metadata.Enum{Name: e.Name, Values: e.Values})
+	}
+
+	return meta
+}
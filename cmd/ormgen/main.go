@@ -0,0 +1,297 @@
+// Command ormgen generates the model structs of the ORMs without a generator
+// of their own, gorm, xorm, gorp, pop and goqu, from the DDL in schema.sql. Every
+// table becomes a struct with the fields, tags and relationships that ORM
+// expects, so that all of them benchmark against the same schema.
+//
+// Run it from the root of the repository:
+//
+//	go run ./cmd/ormgen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aarondl/boilbench/schema"
+	"github.com/aarondl/strmangle"
+)
+
+// model is a table as the generators see it
+type model struct {
+	table  *schema.Table
+	name   string
+	fields []field
+
+	// belongsTo are the foreign keys of the table, hasMany those of other
+	// tables to it and manyToMany the tables it is joined to through a join
+	// table
+	belongsTo  []relationship
+	hasMany    []relationship
+	manyToMany []relationship
+
+	// join is set for tables that only join two others
+	join bool
+}
+
+// field is a column as the generators see it
+type field struct {
+	column *schema.Column
+	// name is the Go name with initialisms, for example PilotID
+	name string
+	typ  string
+	// pk is set for columns of the primary key, serial for a single column
+	// primary key with a sequence or identity
+	pk     bool
+	serial bool
+	unique bool
+	index  string
+}
+
+// relationship to another model
+type relationship struct {
+	name   string
+	model  *model
+	column string
+	// through is the join table of a many to many relationship
+	through string
+}
+
+// generator writes the models file of one ORM
+type generator struct {
+	pkg  string
+	file string
+	gen  func(w *bytes.Buffer, models []*model) error
+}
+
+var generators = []generator{
+	{pkg: "gorms", file: "gorm.go", gen: genGorm},
+	{pkg: "xorms", file: "xorm.go", gen: genXorm},
+	{pkg: "gorps", file: "gorp.go", gen: genGorp},
+	{pkg: "pops", file: "pop.go", gen: genPop},
+	{pkg: "goqus", file: "goqu.go", gen: genGoqu},
+}
+
+func main() {
+	schemaFile := flag.String("schema", "schema.sql", "DDL to generate the models from")
+	dir := flag.String("dir", ".", "directory containing the ORM packages")
+	flag.Parse()
+
+	if err := generate(*schemaFile, *dir); err != nil {
+		fmt.Fprintln(os.Stderr, "ormgen:", err)
+		os.Exit(1)
+	}
+}
+
+func generate(schemaFile, dir string) error {
+	s, err := schema.ParseFile(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	models, err := buildModels(s)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range generators {
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "// Code generated by ormgen from %s. DO NOT EDIT.\n\n", filepath.Base(schemaFile))
+		fmt.Fprintf(&buf, "package %s\n\n", g.pkg)
+		if err := g.gen(&buf, models); err != nil {
+			return fmt.Errorf("%s: %w", g.pkg, err)
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s: %w\n%s", g.pkg, err, buf.Bytes())
+		}
+		if err := os.WriteFile(filepath.Join(dir, g.pkg, g.file), src, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildModels names the tables, columns and relationships
+func buildModels(s *schema.Schema) ([]*model, error) {
+	var models []*model
+	byTable := make(map[string]*model)
+
+	for _, t := range s.Tables {
+		m := &model{table: t, name: strmangle.TitleCase(strmangle.Singular(t.Name))}
+
+		for _, c := range t.Columns {
+			typ, err := goType(c)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", t.Name, c.Name, err)
+			}
+
+			f := field{column: c, name: strmangle.TitleCase(c.Name), typ: typ, unique: t.IsUnique(c.Name)}
+			if t.PrimaryKey != nil {
+				for _, pk := range t.PrimaryKey.Columns {
+					f.pk = f.pk || pk == c.Name
+				}
+				f.serial = f.pk && len(t.PrimaryKey.Columns) == 1 &&
+					(c.Identity || strings.HasPrefix(c.Default, "nextval("))
+			}
+			for _, i := range t.Indexes {
+				if !i.Unique && len(i.Columns) == 1 && i.Columns[0] == c.Name {
+					f.index = i.Name
+				}
+			}
+			m.fields = append(m.fields, f)
+		}
+
+		m.join = t.PrimaryKey != nil && len(t.Columns) == 2 && len(t.PrimaryKey.Columns) == 2 && len(t.ForeignKeys) == 2
+		models = append(models, m)
+		byTable[t.Name] = m
+	}
+
+	for _, m := range models {
+		for _, fk := range m.table.ForeignKeys {
+			if len(fk.Columns) != 1 {
+				continue
+			}
+			m.belongsTo = append(m.belongsTo, relationship{
+				name:   strmangle.TitleCase(strings.TrimSuffix(fk.Columns[0], "_id")),
+				model:  byTable[fk.ForeignTable],
+				column: fk.Columns[0],
+			})
+			if !m.join {
+				owner := byTable[fk.ForeignTable]
+				owner.hasMany = append(owner.hasMany, relationship{
+					name: strmangle.TitleCase(m.table.Name), model: m, column: fk.Columns[0],
+				})
+			}
+		}
+
+		if !m.join {
+			continue
+		}
+		a, b := m.table.ForeignKeys[0], m.table.ForeignKeys[1]
+		left, right := byTable[a.ForeignTable], byTable[b.ForeignTable]
+		left.manyToMany = append(left.manyToMany, relationship{
+			name: strmangle.TitleCase(b.ForeignTable), model: right, column: b.Columns[0], through: m.table.Name,
+		})
+		right.manyToMany = append(right.manyToMany, relationship{
+			name: strmangle.TitleCase(a.ForeignTable), model: left, column: a.Columns[0], through: m.table.Name,
+		})
+	}
+
+	return models, nil
+}
+
+// goType is the Go type of a column, the nullable types come from
+// github.com/aarondl/null/v8 and arrays are the types of github.com/lib/pq
+func goType(c *schema.Column) (string, error) {
+	if c.Array {
+		return arrayType(c)
+	}
+
+	types := map[string][2]string{
+		"int2":        {"int16", "null.Int16"},
+		"int4":        {"int", "null.Int"},
+		"int8":        {"int64", "null.Int64"},
+		"float4":      {"float32", "null.Float32"},
+		"float8":      {"float64", "null.Float64"},
+		"bool":        {"bool", "null.Bool"},
+		"bytea":       {"[]byte", "null.Bytes"},
+		"json":        {"[]byte", "null.JSON"},
+		"jsonb":       {"[]byte", "null.JSON"},
+		"date":        {"time.Time", "null.Time"},
+		"timestamp":   {"time.Time", "null.Time"},
+		"timestamptz": {"time.Time", "null.Time"},
+	}
+
+	t, ok := types[c.UDTName]
+	if !ok {
+		// Text, enums and everything without a better Go type
+		t = [2]string{"string", "null.String"}
+	}
+	if c.Nullable {
+		return t[1], nil
+	}
+	return t[0], nil
+}
+
+// arrayType is the lib/pq type of an array column, which scan NULL as a nil
+// slice so nullable arrays use the same types
+func arrayType(c *schema.Column) (string, error) {
+	switch c.UDTName {
+	case "int2", "int4", "int8":
+		return "pq.Int64Array", nil
+	case "float4", "float8":
+		return "pq.Float64Array", nil
+	case "bool":
+		return "pq.BoolArray", nil
+	case "bytea":
+		return "pq.ByteaArray", nil
+	case "text", "varchar", "bpchar":
+		return "pq.StringArray", nil
+	}
+	return "", fmt.Errorf("arrays of %s are not supported", c.UDTName)
+}
+
+// writeImports writes the import block for the types the fields use and
+// any extra packages
+func writeImports(w *bytes.Buffer, models []*model, extra ...string) {
+	imports := map[string]bool{}
+	for _, m := range models {
+		for _, f := range m.fields {
+			switch {
+			case strings.HasPrefix(f.typ, "null."):
+				imports["github.com/aarondl/null/v8"] = true
+			case strings.HasPrefix(f.typ, "pq."):
+				imports["github.com/lib/pq"] = true
+			case f.typ == "time.Time":
+				imports["time"] = true
+			}
+		}
+	}
+
+	var std, other []string
+	for path := range imports {
+		if strings.Contains(path, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	other = append(other, extra...)
+
+	w.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(w, "\t%q\n", path)
+	}
+	if len(std) != 0 && len(other) != 0 {
+		w.WriteString("\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(w, "\t%q\n", path)
+	}
+	w.WriteString(")\n")
+}
+
+// writeTableName writes the TableName method gorm, xorm and pop look for
+func writeTableName(w *bytes.Buffer, m *model) {
+	fmt.Fprintf(w, "\n// TableName of %s\nfunc (%s) TableName() string { return %q }\n", m.name, m.name, m.table.Name)
+}
+
+// tag joins the non empty key:"value" pairs of a struct tag
+func tag(pairs ...string) string {
+	var parts []string
+	for i := 0; i < len(pairs); i += 2 {
+		if len(pairs[i+1]) != 0 {
+			parts = append(parts, fmt.Sprintf("%s:%q", pairs[i], pairs[i+1]))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "`" + strings.Join(parts, " ") + "`"
+}
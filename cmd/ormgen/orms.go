@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// genGorm writes gorm models, belongs to, has many and many to many
+// relationships are fields tagged with their keys
+func genGorm(w *bytes.Buffer, models []*model) error {
+	writeImports(w, models)
+
+	for _, m := range models {
+		fmt.Fprintf(w, "\n// %s is a row of %s\ntype %s struct {\n", m.name, m.table.Name, m.name)
+		for _, f := range m.fields {
+			options := []string{"column:" + f.column.Name}
+			if f.pk {
+				options = append(options, "primaryKey")
+			}
+			if f.serial {
+				options = append(options, "autoIncrement")
+			}
+			// gorm can't tell the data type of the lib/pq arrays
+			if f.column.Array {
+				options = append(options, "type:"+f.column.UDTName+"[]")
+			}
+			if !f.column.Nullable && !f.pk {
+				options = append(options, "not null")
+			}
+			if f.unique && !f.pk {
+				options = append(options, "unique")
+			}
+			if len(f.index) != 0 {
+				options = append(options, "index:"+f.index)
+			}
+			fmt.Fprintf(w, "\t%s %s %s\n", f.name, f.typ, tag("gorm", strings.Join(options, ";")))
+		}
+
+		if len(m.belongsTo) != 0 || len(m.hasMany) != 0 || len(m.manyToMany) != 0 {
+			w.WriteString("\n")
+		}
+		for _, r := range m.belongsTo {
+			fmt.Fprintf(w, "\t%s %s %s\n", r.name, r.model.name, tag("gorm", "foreignKey:"+fieldName(m, r.column)))
+		}
+		for _, r := range m.hasMany {
+			fmt.Fprintf(w, "\t%s []%s %s\n", r.name, r.model.name, tag("gorm", "foreignKey:"+fieldName(r.model, r.column)))
+		}
+		for _, r := range m.manyToMany {
+			fmt.Fprintf(w, "\t%s []%s %s\n", r.name, r.model.name, tag("gorm", "many2many:"+r.through))
+		}
+		w.WriteString("}\n")
+
+		writeTableName(w, m)
+	}
+	return nil
+}
+
+// genXorm writes xorm models. Fields are named without initialisms, as
+// xorm's default mapper expects. xorm has no relationships to generate: Join
+// only adds a join to the SQL and extends embeds the columns of a row of
+// another table rather than loading related rows, so join tables are models
+// of their own and a many to many is queried through them.
+func genXorm(w *bytes.Buffer, models []*model) error {
+	writeImports(w, models)
+
+	for _, m := range models {
+		fmt.Fprintf(w, "\n// %s is a row of %s\ntype %s struct {\n", m.name, m.table.Name, m.name)
+		for _, f := range m.fields {
+			options := []string{"'" + f.column.Name + "'"}
+			if f.pk {
+				options = append(options, "pk")
+			}
+			if f.serial {
+				options = append(options, "autoincr")
+			}
+			if !f.column.Nullable && !f.pk {
+				options = append(options, "not null")
+			}
+			if f.unique && !f.pk {
+				options = append(options, "unique")
+			}
+			if len(f.index) != 0 {
+				options = append(options, "index("+f.index+")")
+			}
+			fmt.Fprintf(w, "\t%s %s %s\n", xormName(f.column.Name), f.typ, tag("xorm", strings.Join(options, " ")))
+		}
+		w.WriteString("}\n")
+
+		writeTableName(w, m)
+	}
+	return nil
+}
+
+// genGorp writes gorp models and AddTables, which registers them with their
+// names and keys since gorp doesn't look for a TableName method
+func genGorp(w *bytes.Buffer, models []*model) error {
+	writeImports(w, models, "gopkg.in/gorp.v1")
+
+	for _, m := range models {
+		fmt.Fprintf(w, "\n// %s is a row of %s\ntype %s struct {\n", m.name, m.table.Name, m.name)
+		for _, f := range m.fields {
+			fmt.Fprintf(w, "\t%s %s %s\n", f.name, f.typ, tag("db", f.column.Name))
+		}
+		w.WriteString("}\n")
+	}
+
+	w.WriteString("\n// AddTables registers every model with its table and keys\nfunc AddTables(db *gorp.DbMap) {\n")
+	for _, m := range models {
+		var keys []string
+		serial := false
+		for _, f := range m.fields {
+			if f.pk {
+				keys = append(keys, fmt.Sprintf("%q", f.name))
+				serial = serial || f.serial
+			}
+		}
+
+		fmt.Fprintf(w, "\tdb.AddTableWithName(%s{}, %q)", m.name, m.table.Name)
+		if len(keys) != 0 {
+			fmt.Fprintf(w, ".SetKeys(%t, %s)", serial, strings.Join(keys, ", "))
+		}
+		w.WriteString("\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// genPop writes pop models, pop maps every field by its db tag. Belongs to,
+// has many and many to many relationships are fields tagged with their
+// tables and keys, and db:"-" so that pop doesn't take them for columns.
+func genPop(w *bytes.Buffer, models []*model) error {
+	writeImports(w, models)
+
+	for _, m := range models {
+		fmt.Fprintf(w, "\n// %s is a row of %s\ntype %s struct {\n", m.name, m.table.Name, m.name)
+		for _, f := range m.fields {
+			fmt.Fprintf(w, "\t%s %s %s\n", f.name, f.typ, tag("db", f.column.Name))
+		}
+
+		if len(m.belongsTo) != 0 || len(m.hasMany) != 0 || len(m.manyToMany) != 0 {
+			w.WriteString("\n")
+		}
+		for _, r := range m.belongsTo {
+			fmt.Fprintf(w, "\t%s %s %s\n", r.name, r.model.name,
+				tag("belongs_to", r.model.table.Name, "fk_id", r.column, "db", "-"))
+		}
+		for _, r := range m.hasMany {
+			fmt.Fprintf(w, "\t%s []%s %s\n", r.name, r.model.name,
+				tag("has_many", r.model.table.Name, "fk_id", r.column, "db", "-"))
+		}
+		for _, r := range m.manyToMany {
+			fmt.Fprintf(w, "\t%s []%s %s\n", r.name, r.model.name,
+				tag("many_to_many", r.through, "fk_id", r.column, "db", "-"))
+		}
+		w.WriteString("}\n")
+
+		writeTableName(w, m)
+	}
+	return nil
+}
+
+// genGoqu writes goqu models, goqu maps every field by its db tag and
+// leaves serial keys to the database
+func genGoqu(w *bytes.Buffer, models []*model) error {
+	writeImports(w, models)
+
+	for _, m := range models {
+		fmt.Fprintf(w, "\n// %s is a row of %s\ntype %s struct {\n", m.name, m.table.Name, m.name)
+		for _, f := range m.fields {
+			options := ""
+			if f.serial {
+				options = "skipinsert,skipupdate"
+			}
+			fmt.Fprintf(w, "\t%s %s %s\n", f.name, f.typ, tag("db", f.column.Name, "goqu", options))
+		}
+		w.WriteString("}\n")
+	}
+	return nil
+}
+
+// fieldName returns the Go name of a column of a model
+func fieldName(m *model, column string) string {
+	for _, f := range m.fields {
+		if f.column.Name == column {
+			return f.name
+		}
+	}
+	return ""
+}
+
+// xormName titles every word of a column without initialisms, pilot_id is
+// PilotId, which xorm's snake mapper maps back to pilot_id
+func xormName(column string) string {
+	words := strings.Split(column, "_")
+	for i, w := range words {
+		if len(w) != 0 {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
@@ -0,0 +1,236 @@
+// Command sqlboiler-schema is a sqlboiler driver that reads the tables from
+// the DDL in schema.sql instead of connecting to a database, so that models
+// can be generated without one. Column types, defaults, uniqueness, primary
+// and foreign keys are reported as the psql driver would report them for a
+// database loaded from the same file, and templates and imports come from
+// the psql driver of whichever sqlboiler version it is built against.
+//
+// It is run through scripts/offline/sqlboiler-psql, the DDL is read from
+// schema.sql unless schema-file is set in the [psql] section of
+// sqlboiler.toml.
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/boilbench/schema"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+	"github.com/aarondl/sqlboiler/v4/drivers/sqlboiler-psql/driver"
+	"github.com/aarondl/strmangle"
+)
+
+type offline struct {
+	driver.PostgresDriver
+
+	schema            *schema.Schema
+	addEnumTypes      bool
+	enumNullPrefix    string
+	configForeignKeys []drivers.ForeignKey
+}
+
+// Assemble parses the DDL and builds the tables from it
+func (o *offline) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, err error) {
+	defer func() {
+		if r := recover(); r != nil && err == nil {
+			dbinfo = nil
+			if err, _ = r.(error); err == nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	schemaName := config.DefaultString(drivers.ConfigSchema, "public")
+	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
+	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+
+	o.addEnumTypes, _ = config[drivers.ConfigAddEnumTypes].(bool)
+	o.enumNullPrefix = strmangle.TitleCase(config.DefaultString(drivers.ConfigEnumNullPrefix, "Null"))
+	o.configForeignKeys = config.MustForeignKeys(drivers.ConfigForeignKeys)
+
+	if o.schema, err = schema.ParseFile(config.DefaultString("schema-file", "schema.sql")); err != nil {
+		return nil, err
+	}
+
+	dbinfo = &drivers.DBInfo{
+		Schema: schemaName,
+		Dialect: drivers.Dialect{
+			LQ: '"',
+			RQ: '"',
+
+			UseIndexPlaceholders: true,
+			UseSchema:            schemaName != "public" && !config.DefaultBool(drivers.ConfigNoOutputSchema, false),
+			UseDefaultKeyword:    true,
+		},
+	}
+	dbinfo.Tables, err = drivers.Tables(o, schemaName, whitelist, blacklist)
+	return dbinfo, err
+}
+
+// TableNames returns the tables in the whitelist, or those not in the
+// blacklist
+func (o *offline) TableNames(schemaName string, whitelist, blacklist []string) ([]string, error) {
+	whitelisted := drivers.TablesFromList(whitelist)
+	blacklisted := drivers.TablesFromList(blacklist)
+
+	var names []string
+	for _, name := range o.schema.TableNames() {
+		switch {
+		case len(whitelisted) != 0:
+			if !contains(whitelisted, name) {
+				continue
+			}
+		case contains(blacklisted, name):
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// ViewNames returns nothing, views aren't parsed
+func (o *offline) ViewNames(schemaName string, whitelist, blacklist []string) ([]string, error) {
+	return nil, nil
+}
+
+// Columns describes the columns of a table the way the information_schema
+// query of the psql driver does
+func (o *offline) Columns(schemaName, tableName string, whitelist, blacklist []string) ([]drivers.Column, error) {
+	t := o.schema.Table(tableName)
+	if t == nil {
+		return nil, fmt.Errorf("unknown table %s", tableName)
+	}
+
+	whitelisted := drivers.ColumnsFromList(whitelist, tableName)
+	blacklisted := drivers.ColumnsFromList(blacklist, tableName)
+
+	var columns []drivers.Column
+	for _, c := range t.Columns {
+		if len(whitelisted) != 0 && !contains(whitelisted, c.Name) || contains(blacklisted, c.Name) {
+			continue
+		}
+
+		column := drivers.Column{
+			Name:          c.Name,
+			DBType:        c.DataType,
+			UDTName:       c.UDTName,
+			FullDBType:    c.UDTName,
+			Nullable:      c.Nullable,
+			Unique:        t.IsUnique(c.Name),
+			Default:       c.Default,
+			AutoGenerated: c.Generated || c.IdentityAlways,
+		}
+
+		if e := o.schema.Enum(c.UDTName); e != nil && !c.Array {
+			column.DBType = fmt.Sprintf("enum.%s('%s')", e.Name, strings.Join(e.Values, "','"))
+		}
+
+		// Only character types have a maximum length in information_schema,
+		// character without one is character(1)
+		switch {
+		case c.DataType == "character" && len(c.Modifier) == 0:
+			column.FullDBType = "character(1)"
+		case c.DataType == "character" || c.DataType == "character varying":
+			column.FullDBType = c.DataType + c.Modifier
+		}
+
+		if c.Array {
+			arrType := c.DataType
+			column.ArrType = &arrType
+			column.DBType = "ARRAY"
+			column.UDTName = "_" + c.UDTName
+			column.FullDBType = column.UDTName
+		}
+
+		if c.Identity {
+			column.Default = "IDENTITY"
+		}
+		if c.Generated {
+			// The expression of a generated column is not its default
+			column.Default = "GENERATED"
+		}
+		if c.Nullable && len(column.Default) == 0 {
+			column.Default = "NULL"
+		}
+
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// PrimaryKeyInfo returns the primary key of a table, or nil
+func (o *offline) PrimaryKeyInfo(schemaName, tableName string) (*drivers.PrimaryKey, error) {
+	t := o.schema.Table(tableName)
+	if t == nil || t.PrimaryKey == nil {
+		return nil, nil
+	}
+	return &drivers.PrimaryKey{Name: t.PrimaryKey.Name, Columns: t.PrimaryKey.Columns}, nil
+}
+
+// ForeignKeyInfo returns the foreign keys of a table, one per column, merged
+// with those in the config
+func (o *offline) ForeignKeyInfo(schemaName, tableName string) ([]drivers.ForeignKey, error) {
+	t := o.schema.Table(tableName)
+	if t == nil {
+		return nil, fmt.Errorf("unknown table %s", tableName)
+	}
+
+	var fkeys []drivers.ForeignKey
+	for _, fk := range t.ForeignKeys {
+		for i, column := range fk.Columns {
+			fkeys = append(fkeys, drivers.ForeignKey{
+				Table:         tableName,
+				Name:          fk.Name,
+				Column:        column,
+				ForeignTable:  fk.ForeignTable,
+				ForeignColumn: fk.ForeignColumns[i],
+			})
+		}
+	}
+
+	// In the order the psql driver queries them in
+	sort.Slice(fkeys, func(i, j int) bool {
+		a, b := fkeys[i], fkeys[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		if a.ForeignTable != b.ForeignTable {
+			return a.ForeignTable < b.ForeignTable
+		}
+		return a.ForeignColumn < b.ForeignColumn
+	})
+
+	return drivers.CombineConfigAndDBForeignKeys(o.configForeignKeys, tableName, fkeys), nil
+}
+
+// TranslateColumnType defers to the psql driver, which only knows about
+// add-enum-types when it was the one to read the config
+func (o *offline) TranslateColumnType(c drivers.Column) drivers.Column {
+	c = o.PostgresDriver.TranslateColumnType(c)
+
+	if enumName := strmangle.ParseEnumName(c.DBType); enumName != "" && o.addEnumTypes {
+		if c.Nullable {
+			c.Type = o.enumNullPrefix + strmangle.TitleCase(enumName)
+		} else {
+			c.Type = strmangle.TitleCase(enumName)
+		}
+	}
+	return c
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	drivers.DriverMain(&offline{})
+}
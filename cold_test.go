@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// coldEnv names the operation and ORM, like SelectAll/gorm, that a child
+// started by the ColdProcess benchmarks runs instead of the tests
+const coldEnv = "BOILBENCH_COLD"
+
+// coldResult is what opening an ORM and running its first operation cost
+type coldResult struct {
+	open   time.Duration
+	op     time.Duration
+	bytes  uint64
+	allocs uint64
+}
+
+// runCold creates an op/orm sub-benchmark for every adapter whose ORM can
+// be made cold in process. Every run closes the handle, resets the ORM's
+// caches and opens a new one before timing op, the time Open took is
+// reported as open-ns.
+func runCold(b *testing.B, op jetOperation) {
+	for _, a := range adapters() {
+		dsn := "postgres://Cold" + op.name + "-" + a.Name()
+		mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+
+		if err := a.Open(dsn); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			ca, ok := a.(bench.ColdAdapter)
+			if !ok {
+				skip(b, "caches can't be reset, see ColdProcess")
+			}
+			checkOperation(b, op, a.Name(), a, dsn)
+
+			ctx := context.Background()
+			var open time.Duration
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				if err := ca.Close(); err != nil {
+					b.Fatal(err)
+				}
+				ca.Reset()
+
+				start := time.Now()
+				if err := ca.Open(dsn); err != nil {
+					b.Fatal(err)
+				}
+				open += time.Since(start)
+				b.StartTimer()
+
+				if err := op.run(ca, ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(open)/float64(b.N), "open-ns")
+		})
+	}
+}
+
+// runColdProcess creates an op/orm sub-benchmark for every adapter. Every
+// run starts this test binary to open the ORM and run op once, so that
+// nothing it caches survives between runs, not even globally. The child's
+// numbers replace ns/op, B/op and allocs/op, which would be those of
+// starting it otherwise.
+func runColdProcess(b *testing.B, op jetOperation) {
+	for _, a := range adapters() {
+		dsn := "postgres://ColdProcess" + op.name + "-" + a.Name()
+		mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+
+		if err := a.Open(dsn); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			checkOperation(b, op, a.Name(), a, dsn)
+
+			var total coldResult
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r, err := coldProcess(op.name + "/" + a.Name())
+				if err != nil {
+					b.Fatal(err)
+				}
+				total.open += r.open
+				total.op += r.op
+				total.bytes += r.bytes
+				total.allocs += r.allocs
+			}
+
+			n := float64(b.N)
+			b.ReportMetric(float64(total.op)/n, "ns/op")
+			b.ReportMetric(float64(total.bytes)/n, "B/op")
+			b.ReportMetric(float64(total.allocs)/n, "allocs/op")
+			b.ReportMetric(float64(total.open)/n, "open-ns")
+		})
+	}
+}
+
+// coldProcess starts this test binary to run the operation named like
+// SelectAll/gorm in a process of its own
+func coldProcess(name string) (coldResult, error) {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), coldEnv+"="+name)
+
+	out, err := cmd.Output()
+	if err != nil {
+		if exit, ok := err.(*exec.ExitError); ok {
+			return coldResult{}, fmt.Errorf("%s: %w: %s", name, err, exit.Stderr)
+		}
+		return coldResult{}, err
+	}
+
+	var r coldResult
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		if !strings.HasPrefix(s.Text(), "cold ") {
+			continue
+		}
+		_, err := fmt.Sscanf(s.Text(), "cold %d %d %d %d", &r.open, &r.op, &r.bytes, &r.allocs)
+		return r, err
+	}
+	return r, fmt.Errorf("%s: no result in output: %s", name, out)
+}
+
+// coldChild opens the ORM and runs the operation named like SelectAll/gorm
+// once, printing the coldResult for coldProcess
+func coldChild(name string) error {
+	opName, orm, _ := strings.Cut(name, "/")
+
+	var op jetOperation
+	for _, o := range operations {
+		if o.name == opName {
+			op = o
+		}
+	}
+	var a bench.Adapter
+	for _, ad := range adapters() {
+		if ad.Name() == orm {
+			a = ad
+		}
+	}
+	if op.run == nil || a == nil {
+		return fmt.Errorf("no operation or adapter for %s", name)
+	}
+
+	dsn := "postgres://ColdProcess-" + name
+	mimic.NewSequenceDSN(dsn, op.script(orm)...)
+
+	var (
+		r             coldResult
+		before, after runtime.MemStats
+	)
+
+	start := time.Now()
+	if err := a.Open(dsn); err != nil {
+		return err
+	}
+	r.open = time.Since(start)
+
+	runtime.ReadMemStats(&before)
+	start = time.Now()
+	err := op.run(a, context.Background())
+	r.op = time.Since(start)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		return err
+	}
+
+	r.bytes = after.TotalAlloc - before.TotalAlloc
+	r.allocs = after.Mallocs - before.Mallocs
+	fmt.Printf("cold %d %d %d %d\n", r.open, r.op, r.bytes, r.allocs)
+	return nil
+}
+
+// TestColdProcess checks that the ColdProcess children report a result
+func TestColdProcess(t *testing.T) {
+	r, err := coldProcess("SelectAll/boil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.op <= 0 || r.allocs == 0 {
+		t.Errorf("implausible result: %+v", r)
+	}
+}
+
+func BenchmarkColdSelectAll(b *testing.B) {
+	runCold(b, selectAllOp)
+}
+
+func BenchmarkColdSelectSubset(b *testing.B) {
+	runCold(b, selectSubsetOp)
+}
+
+func BenchmarkColdSelectComplex(b *testing.B) {
+	runCold(b, selectComplexOp)
+}
+
+func BenchmarkColdInsert(b *testing.B) {
+	runCold(b, insertOp)
+}
+
+func BenchmarkColdUpdate(b *testing.B) {
+	runCold(b, updateOp)
+}
+
+func BenchmarkColdDelete(b *testing.B) {
+	runCold(b, deleteOp)
+}
+
+func BenchmarkColdRawBind(b *testing.B) {
+	runCold(b, rawBindOp)
+}
+
+func BenchmarkColdEagerLoad(b *testing.B) {
+	runCold(b, eagerLoadOp)
+}
+
+func BenchmarkColdProcessSelectAll(b *testing.B) {
+	runColdProcess(b, selectAllOp)
+}
+
+func BenchmarkColdProcessSelectSubset(b *testing.B) {
+	runColdProcess(b, selectSubsetOp)
+}
+
+func BenchmarkColdProcessSelectComplex(b *testing.B) {
+	runColdProcess(b, selectComplexOp)
+}
+
+func BenchmarkColdProcessInsert(b *testing.B) {
+	runColdProcess(b, insertOp)
+}
+
+func BenchmarkColdProcessUpdate(b *testing.B) {
+	runColdProcess(b, updateOp)
+}
+
+func BenchmarkColdProcessDelete(b *testing.B) {
+	runColdProcess(b, deleteOp)
+}
+
+func BenchmarkColdProcessRawBind(b *testing.B) {
+	runColdProcess(b, rawBindOp)
+}
+
+func BenchmarkColdProcessEagerLoad(b *testing.B) {
+	runColdProcess(b, eagerLoadOp)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+	"github.com/aarondl/boilbench/models"
+)
+
+// jetUpdatable are the columns of jets an update can write, all but the
+// primary key
+var jetUpdatable = []string{"pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"}
+
+// columnsCase is a kind of Columns list with the columns the Columns
+// benchmarks insert and update with. An update greylist can only add the
+// primary key, sqlboiler already updates every other column.
+type columnsCase struct {
+	kind           bench.ColumnsKind
+	insert, update []string
+}
+
+var columnsCases = []columnsCase{
+	{kind: bench.Infer},
+	{kind: bench.Whitelist, insert: append([]string{"id"}, jetUpdatable...), update: []string{"name", "color"}},
+	{kind: bench.Blacklist, insert: []string{"identifier"}, update: []string{"cargo", "manifest"}},
+	{kind: bench.Greylist, insert: []string{"color"}, update: []string{"id"}},
+}
+
+// columnsOp inserts or updates a jet with the columns of c, it's answered
+// like base
+func columnsOp(base jetOperation, c columnsCase) jetOperation {
+	op := base
+	op.name = "Columns" + base.name + c.kind.String()
+
+	cols := bench.Columns{Kind: c.kind, Cols: c.insert}
+	if base.name == updateOp.name {
+		cols.Cols = c.update
+	}
+	op.run = func(a bench.Adapter, ctx context.Context) error {
+		return writeColumns(a, ctx, base, cols)
+	}
+
+	switch c.kind {
+	case bench.Whitelist:
+		op.sends, op.omits = cols.Cols, without(jetUpdatable, cols.Cols)
+		if base.name == updateOp.name {
+			op.sends = append(append([]string(nil), cols.Cols...), "id")
+		}
+	case bench.Blacklist:
+		op.sends, op.omits = without(base.sends, cols.Cols), cols.Cols
+	case bench.Greylist:
+		op.sends = append(without(base.sends, cols.Cols), cols.Cols...)
+	}
+	return op
+}
+
+// without returns the columns of cols that aren't in remove
+func without(cols, remove []string) []string {
+	var kept []string
+	for _, c := range cols {
+		if !slices.Contains(remove, c) {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// writeColumns runs the insert or update of base with cols
+func writeColumns(a bench.Adapter, ctx context.Context, base jetOperation, cols bench.Columns) error {
+	ca, ok := a.(bench.ColumnsAdapter)
+	if !ok {
+		return bench.ErrUnsupported
+	}
+	if base.name == updateOp.name {
+		return ca.UpdateColumns(ctx, cols)
+	}
+	return ca.InsertColumns(ctx, cols)
+}
+
+// columnsCycle returns every whitelist of the columns an update can write,
+// each of which sqlboiler caches a statement for
+func columnsCycle() []bench.Columns {
+	var cycle []bench.Columns
+	for mask := 1; mask < 1<<len(jetUpdatable); mask++ {
+		var cols []string
+		for i, col := range jetUpdatable {
+			if mask&(1<<i) != 0 {
+				cols = append(cols, col)
+			}
+		}
+		cycle = append(cycle, bench.Columns{Kind: bench.Whitelist, Cols: cols})
+	}
+	return cycle
+}
+
+// runColumns creates a kind/orm sub-benchmark for every kind of Columns
+// list and adapter
+func runColumns(b *testing.B, base jetOperation) {
+	for _, c := range columnsCases {
+		b.Run(c.kind.String(), func(b *testing.B) {
+			runOperation(b, columnsOp(base, c))
+		})
+	}
+}
+
+// runColumnsCycle creates a Cycle/orm sub-benchmark for every adapter that
+// updates with a different whitelist every run, out of every one there
+// is. How much the heap grows running each once after sqlboiler's caches
+// are emptied is reported as cache-B.
+func runColumnsCycle(b *testing.B) {
+	cycle := columnsCycle()
+
+	for _, a := range adapters() {
+		dsn := "postgres://ColumnsUpdateCycle-" + a.Name()
+		mimic.NewSequenceDSN(dsn, updateOp.script(a.Name())...)
+		if err := a.Open(dsn); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run("Cycle/"+a.Name(), func(b *testing.B) {
+			ctx := context.Background()
+			run := func(cols bench.Columns) error { return writeColumns(a, ctx, updateOp, cols) }
+
+			var before, after runtime.MemStats
+			models.ResetJetCaches()
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			for _, cols := range cycle {
+				err := run(cols)
+				if errors.Is(err, bench.ErrUnsupported) {
+					skipUnsupported(b)
+				} else if err != nil {
+					b.Fatal(err)
+				}
+			}
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+
+			i := 0
+			b.ResetTimer()
+			loop(b, func() error {
+				i++
+				return run(cycle[i%len(cycle)])
+			})
+			b.ReportMetric(float64(max(int64(after.HeapAlloc)-int64(before.HeapAlloc), 0)), "cache-B")
+		})
+	}
+}
+
+// runColumnsMiss creates a Miss/boil sub-benchmark that updates with a
+// whitelist after emptying sqlboiler's caches every run, so that every
+// update builds its statement. The other ORMs build it every time anyway.
+func runColumnsMiss(b *testing.B) {
+	op := columnsOp(updateOp, columnsCases[1])
+	var a bench.Adapter = &models.Adapter{}
+
+	dsn := "postgres://ColumnsUpdateMiss-" + a.Name()
+	mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+	if err := a.Open(dsn); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Miss/"+a.Name(), func(b *testing.B) {
+		ctx := context.Background()
+		checkOperation(b, op, a.Name(), a, dsn)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			models.ResetJetCaches()
+			b.StartTimer()
+
+			if err := op.run(a, ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// TestGoldenColumnsSQL is TestGoldenSQL and TestVerify for the Columns
+// benchmarks, the statements are in testdata/sql/Columns<Op>/<kind>
+func TestGoldenColumnsSQL(t *testing.T) {
+	for _, base := range []jetOperation{insertOp, updateOp} {
+		for _, c := range columnsCases {
+			op := columnsOp(base, c)
+			for _, a := range adapters() {
+				t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+					dsn := "postgres://sql-" + op.name + "-" + a.Name()
+					runChecked(t, op, a, dsn, op.script(a.Name()), "Columns"+base.name+"/"+c.kind.String())
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkColumnsInsert(b *testing.B) {
+	runColumns(b, insertOp)
+}
+
+func BenchmarkColumnsUpdate(b *testing.B) {
+	runColumns(b, updateOp)
+	runColumnsCycle(b)
+	runColumnsMiss(b)
+}
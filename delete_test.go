@@ -1,151 +1,19 @@
 package main
 
 import (
-	"context"
-	"database/sql"
-	"github.com/gobuffalo/pop/v6"
 	"testing"
 
-	"github.com/aarondl/boilbench/gorms"
-	"github.com/aarondl/boilbench/gorps"
-	"github.com/aarondl/boilbench/mimic"
-	"github.com/aarondl/boilbench/models"
-	"github.com/aarondl/boilbench/pops"
-	"github.com/aarondl/boilbench/xorms"
-	"gopkg.in/gorp.v1"
-	"gorm.io/gorm"
-	"xorm.io/xorm"
+	"github.com/aarondl/boilbench/bench"
 )
 
-func BenchmarkGORMDelete(b *testing.B) {
-	store := gorms.Jet{
-		ID: 1,
-	}
-
-	exec := jetExec()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	gormdb, err := gorm.Open(gormMimicDialector, &gorm.Config{})
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("gorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			err := gormdb.Delete(&store).Error
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkGORPDelete(b *testing.B) {
-	store := gorps.Jet{
-		ID: 1,
-	}
-
-	exec := jetExec()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	gorpdb := &gorp.DbMap{Db: db, Dialect: gorp.PostgresDialect{}}
-	if err != nil {
-		panic(err)
-	}
-	gorpdb.AddTable(gorps.Jet{}).SetKeys(true, "ID")
-
-	b.Run("gorp", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_, err := gorpdb.Delete(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
+var deleteOp = jetOperation{
+	name:    "Delete",
+	run:     bench.Adapter.Delete,
+	fixture: fixtures(jetExec),
+	expect:  func() []bench.Jet { return expectJets(jetQueryUpdate()) },
+	sends:   []string{"id"},
 }
 
-func BenchmarkXORMDelete(b *testing.B) {
-	store := xorms.Jet{
-		Id: 1,
-	}
-
-	exec := jetExec()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	xormdb, err := xorm.NewEngine("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("xorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_, err := xormdb.Delete(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkBoilDelete(b *testing.B) {
-	store := models.Jet{
-		ID: 1,
-	}
-
-	exec := jetExec()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("boil", func(b *testing.B) {
-		ctx := context.Background()
-		for i := 0; i < b.N; i++ {
-			_, err := store.Delete(ctx, db)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkPOPDelete(b *testing.B) {
-	store := pops.Jet{
-		ID: 1,
-	}
-
-	dsn := "postgres://BenchmarkPOPDelete"
-	exec := jetExec()
-	exec.NumInput = -1
-	mimic.NewResultDSN(dsn, exec)
-
-	popdb, err := pop.NewConnection(&pop.ConnectionDetails{Driver: "mimic", Dialect: "postgres", URL: dsn})
-	if err != nil {
-		panic(err)
-	}
-
-	err = popdb.Open()
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("pop", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			err := popdb.Destroy(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
+func BenchmarkDelete(b *testing.B) {
+	runOperation(b, deleteOp)
 }
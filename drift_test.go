@@ -0,0 +1,474 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/boilbench/goqus"
+	"github.com/aarondl/boilbench/gorms"
+	"github.com/aarondl/boilbench/gorps"
+	"github.com/aarondl/boilbench/models"
+	"github.com/aarondl/boilbench/pops"
+	"github.com/aarondl/boilbench/schema"
+	"github.com/aarondl/boilbench/xorms"
+	"github.com/aarondl/strmangle"
+	gormschema "gorm.io/gorm/schema"
+	"xorm.io/xorm/names"
+)
+
+// structs are the models of each ORM checked against schema.sql, keyed by
+// table. Join tables only have a model where the ORM needs one.
+var structs = map[string]map[string]interface{}{
+	"boil": {
+		"airports":  models.Airport{},
+		"flights":   models.Flight{},
+		"hangars":   models.Hangar{},
+		"jets":      models.Jet{},
+		"languages": models.Language{},
+		"licenses":  models.License{},
+		"pilots":    models.Pilot{},
+		"wide10":    models.Wide10{},
+		"wide25":    models.Wide25{},
+		"wide50":    models.Wide50{},
+		"wide100":   models.Wide100{},
+	},
+	"gorm": {
+		"airports":        gorms.Airport{},
+		"flights":         gorms.Flight{},
+		"hangars":         gorms.Hangar{},
+		"jets":            gorms.Jet{},
+		"languages":       gorms.Language{},
+		"licenses":        gorms.License{},
+		"pilot_languages": gorms.PilotLanguage{},
+		"pilots":          gorms.Pilot{},
+		"wide10":          gorms.Wide10{},
+		"wide25":          gorms.Wide25{},
+		"wide50":          gorms.Wide50{},
+		"wide100":         gorms.Wide100{},
+	},
+	"xorm": {
+		"airports":        xorms.Airport{},
+		"flights":         xorms.Flight{},
+		"hangars":         xorms.Hangar{},
+		"jets":            xorms.Jet{},
+		"languages":       xorms.Language{},
+		"licenses":        xorms.License{},
+		"pilot_languages": xorms.PilotLanguage{},
+		"pilots":          xorms.Pilot{},
+		"wide10":          xorms.Wide10{},
+		"wide25":          xorms.Wide25{},
+		"wide50":          xorms.Wide50{},
+		"wide100":         xorms.Wide100{},
+	},
+	"gorp": {
+		"airports":        gorps.Airport{},
+		"flights":         gorps.Flight{},
+		"hangars":         gorps.Hangar{},
+		"jets":            gorps.Jet{},
+		"languages":       gorps.Language{},
+		"licenses":        gorps.License{},
+		"pilot_languages": gorps.PilotLanguage{},
+		"pilots":          gorps.Pilot{},
+		"wide10":          gorps.Wide10{},
+		"wide25":          gorps.Wide25{},
+		"wide50":          gorps.Wide50{},
+		"wide100":         gorps.Wide100{},
+	},
+	"pop": {
+		"airports":        pops.Airport{},
+		"flights":         pops.Flight{},
+		"hangars":         pops.Hangar{},
+		"jets":            pops.Jet{},
+		"languages":       pops.Language{},
+		"licenses":        pops.License{},
+		"pilot_languages": pops.PilotLanguage{},
+		"pilots":          pops.Pilot{},
+		"wide10":          pops.Wide10{},
+		"wide25":          pops.Wide25{},
+		"wide50":          pops.Wide50{},
+		"wide100":         pops.Wide100{},
+	},
+	"goqu": {
+		"airports":        goqus.Airport{},
+		"flights":         goqus.Flight{},
+		"hangars":         goqus.Hangar{},
+		"jets":            goqus.Jet{},
+		"languages":       goqus.Language{},
+		"licenses":        goqus.License{},
+		"pilot_languages": goqus.PilotLanguage{},
+		"pilots":          goqus.Pilot{},
+		"wide10":          goqus.Wide10{},
+		"wide25":          goqus.Wide25{},
+		"wide50":          goqus.Wide50{},
+		"wide100":         goqus.Wide100{},
+	},
+}
+
+// boilColumns and boilRels are sqlboiler's column and relationship names
+// per table
+var (
+	boilColumns = map[string]interface{}{
+		"airports":  models.AirportColumns,
+		"flights":   models.FlightColumns,
+		"hangars":   models.HangarColumns,
+		"jets":      models.JetColumns,
+		"languages": models.LanguageColumns,
+		"licenses":  models.LicenseColumns,
+		"pilots":    models.PilotColumns,
+		"wide10":    models.Wide10Columns,
+		"wide25":    models.Wide25Columns,
+		"wide50":    models.Wide50Columns,
+		"wide100":   models.Wide100Columns,
+	}
+	boilRels = map[string]interface{}{
+		"airports":  models.AirportRels,
+		"flights":   models.FlightRels,
+		"hangars":   models.HangarRels,
+		"jets":      models.JetRels,
+		"languages": models.LanguageRels,
+		"licenses":  models.LicenseRels,
+		"pilots":    models.PilotRels,
+		"wide10":    models.Wide10Rels,
+		"wide25":    models.Wide25Rels,
+		"wide50":    models.Wide50Rels,
+		"wide100":   models.Wide100Rels,
+	}
+)
+
+// fixtureColumns are the columns of the mimic results standing in for each table
+var fixtureColumns = map[string][]string{
+	"flights": flightQuery().Cols,
+	"jets":    jetQuery().Cols,
+	"pilots":  pilotQuery().Cols,
+	"wide10":  wideQuery(10).Cols,
+	"wide25":  wideQuery(25).Cols,
+	"wide50":  wideQuery(50).Cols,
+	"wide100": wideQuery(100).Cols,
+}
+
+// TestSchemaDrift checks that the models of every ORM and the mimic
+// fixtures still match schema.sql, so that a change to the schema that
+// wasn't followed by regenerating the models fails here rather than being
+// benchmarked against stale structs.
+func TestSchemaDrift(t *testing.T) {
+	s, err := schema.ParseFile("schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	tableNames := structValues(models.TableNames)
+	for _, table := range s.TableNames() {
+		if !contains(tableNames, table) {
+			report("boil: models.TableNames is missing %s", table)
+		}
+	}
+	for _, table := range tableNames {
+		if s.Table(table) == nil {
+			report("boil: models.TableNames has %s which is not in schema.sql", table)
+		}
+	}
+
+	for _, t := range s.Tables {
+		join := isJoinTable(t)
+
+		if !join {
+			columns, ok := boilColumns[t.Name]
+			if !ok {
+				report("boil: %s has no column names", t.Name)
+			} else {
+				compareColumns(report, "boil: "+t.Name+" column names", t, structValues(columns))
+			}
+		}
+
+		for orm, tables := range structs {
+			v, ok := tables[t.Name]
+			if !ok {
+				if !join || orm != "boil" {
+					report("%s: no model for %s", orm, t.Name)
+				}
+				continue
+			}
+			checkStruct(report, orm, t, reflect.TypeOf(v))
+		}
+
+		if cols, ok := fixtureColumns[t.Name]; ok {
+			compareColumns(report, "mimic: "+t.Name+" fixture", t, cols)
+		}
+
+		checkRelationships(report, s, t)
+	}
+
+	sort.Strings(problems)
+	for _, p := range problems {
+		t.Error(p)
+	}
+	if len(problems) != 0 {
+		t.Log("run ./scripts/gen-models and update the mimic fixtures after changing schema.sql")
+	}
+}
+
+// checkStruct compares the fields of a model with the columns of its table
+func checkStruct(report func(string, ...interface{}), orm string, t *schema.Table, typ reflect.Type) {
+	fields := make(map[string]reflect.StructField)
+	var columns []string
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		column := columnOf(orm, f)
+		if len(column) == 0 {
+			continue
+		}
+		fields[column] = f
+		columns = append(columns, column)
+	}
+	compareColumns(report, fmt.Sprintf("%s: %s", orm, typ), t, columns)
+
+	for _, c := range t.Columns {
+		f, ok := fields[c.Name]
+		if !ok {
+			continue
+		}
+
+		nullable := isNullable(f.Type)
+		switch {
+		case c.Nullable && !nullable:
+			report("%s: %s.%s is %s but %s is nullable", orm, typ, f.Name, f.Type, c.Name)
+		case !c.Nullable && nullable:
+			report("%s: %s.%s is %s but %s is not null", orm, typ, f.Name, f.Type, c.Name)
+		}
+
+		if want, got := kindOfColumn(c), kindOfType(f.Type); len(want) != 0 && want != got {
+			report("%s: %s.%s is %s but %s is %s", orm, typ, f.Name, f.Type, c.Name, c.FullType())
+		}
+	}
+}
+
+// columnOf returns the column a field maps to in an ORM, or nothing for
+// fields that aren't columns
+func columnOf(orm string, f reflect.StructField) string {
+	switch orm {
+	case "boil":
+		name, _, _ := strings.Cut(f.Tag.Get("boil"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+
+	case "gorm":
+		for _, option := range strings.Split(f.Tag.Get("gorm"), ";") {
+			if column, ok := strings.CutPrefix(option, "column:"); ok {
+				return column
+			}
+		}
+		if f.Type.Kind() == reflect.Struct && !isNullable(f.Type) || f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Struct {
+			return ""
+		}
+		return gormschema.NamingStrategy{}.ColumnName("", f.Name)
+
+	case "xorm":
+		tag := f.Tag.Get("xorm")
+		if tag == "-" {
+			return ""
+		}
+		for _, option := range strings.Fields(tag) {
+			if strings.HasPrefix(option, "'") {
+				return strings.Trim(option, "'")
+			}
+		}
+		return names.SnakeMapper{}.Obj2Table(f.Name)
+
+	default:
+		tag := f.Tag.Get("db")
+		if tag == "-" {
+			return ""
+		}
+		if len(tag) != 0 {
+			return tag
+		}
+		return strings.ToLower(f.Name)
+	}
+}
+
+// checkRelationships checks that sqlboiler, gorm and pop have a relationship
+// for every foreign key and many to many join. xorm has no relationships, it
+// joins through a model of the join table instead.
+func checkRelationships(report func(string, ...interface{}), s *schema.Schema, t *schema.Table) {
+	if isJoinTable(t) {
+		a, b := t.ForeignKeys[0], t.ForeignKeys[1]
+		for _, pair := range [][2]schema.ForeignKey{{a, b}, {b, a}} {
+			from, to := pair[0].ForeignTable, pair[1].ForeignTable
+			name := strmangle.TitleCase(to)
+			if rels, ok := boilRels[from]; ok && !contains(structValues(rels), name) {
+				report("boil: %s has no %s relationship through %s", from, name, t.Name)
+			}
+			if !hasGormRelation(from, name, "many2many:"+t.Name) {
+				report("gorm: %s has no %s many2many relationship through %s", from, name, t.Name)
+			}
+			if !hasPopRelation(from, name, "many_to_many", t.Name) {
+				report("pop: %s has no %s many_to_many relationship through %s", from, name, t.Name)
+			}
+		}
+		if _, ok := structs["xorm"][t.Name]; !ok {
+			report("xorm: no model to join %s and %s through %s", a.ForeignTable, b.ForeignTable, t.Name)
+		}
+		return
+	}
+
+	for _, fk := range t.ForeignKeys {
+		if len(fk.Columns) != 1 {
+			continue
+		}
+		name := strmangle.TitleCase(strings.TrimSuffix(fk.Columns[0], "_id"))
+		if rels, ok := boilRels[t.Name]; ok && !contains(structValues(rels), name) {
+			report("boil: %s has no %s relationship for %s", t.Name, name, fk.Name)
+		}
+		if !hasGormRelation(t.Name, name, "foreignKey:") {
+			report("gorm: %s has no %s relationship for %s", t.Name, name, fk.Name)
+		}
+		if !hasPopRelation(t.Name, name, "belongs_to", fk.ForeignTable) {
+			report("pop: %s has no %s belongs_to relationship for %s", t.Name, name, fk.Name)
+		}
+		if !hasPopRelation(fk.ForeignTable, strmangle.TitleCase(t.Name), "has_many", t.Name) {
+			report("pop: %s has no %s has_many relationship for %s", fk.ForeignTable, strmangle.TitleCase(t.Name), fk.Name)
+		}
+	}
+}
+
+// hasGormRelation reports whether the gorm model of table has the field
+// name with a gorm tag option starting with option
+func hasGormRelation(table, name, option string) bool {
+	v, ok := structs["gorm"][table]
+	if !ok {
+		return false
+	}
+	f, ok := reflect.TypeOf(v).FieldByName(name)
+	return ok && strings.Contains(f.Tag.Get("gorm"), option)
+}
+
+// hasPopRelation reports whether the pop model of table has the field name
+// tagged key:"value" and db:"-"
+func hasPopRelation(table, name, key, value string) bool {
+	v, ok := structs["pop"][table]
+	if !ok {
+		return false
+	}
+	f, ok := reflect.TypeOf(v).FieldByName(name)
+	return ok && f.Tag.Get(key) == value && f.Tag.Get("db") == "-"
+}
+
+// isJoinTable reports whether a table only joins two others, sqlboiler
+// generates no model for those
+func isJoinTable(t *schema.Table) bool {
+	return t.PrimaryKey != nil && len(t.Columns) == 2 && len(t.PrimaryKey.Columns) == 2 && len(t.ForeignKeys) == 2
+}
+
+// compareColumns reports the columns of a table missing from got and the
+// names in got which are not columns
+func compareColumns(report func(string, ...interface{}), what string, t *schema.Table, got []string) {
+	for _, c := range t.ColumnNames() {
+		if !contains(got, c) {
+			report("%s is missing column %s", what, c)
+		}
+	}
+	for _, c := range got {
+		if t.Column(c) == nil {
+			report("%s has %s which is not a column of %s", what, c, t.Name)
+		}
+	}
+}
+
+// isNullable reports whether a type can hold NULL, pointers and the types
+// of null packages, which are structs with a Valid field, can
+func isNullable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		return true
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := t.FieldByName("Valid")
+	return ok
+}
+
+// kindOfType classifies a Go type by the values it holds, nullable types by
+// the value they wrap
+func kindOfType(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if isNullable(t) && t.NumField() != 0 {
+		t = t.Field(0).Type
+	}
+
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		return "time"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return "bytes"
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Bool:
+		return "bool"
+	case reflect.String:
+		return "string"
+	}
+	return t.String()
+}
+
+// kindOfColumn classifies a column like kindOfType, nothing means any Go
+// type will do
+func kindOfColumn(c *schema.Column) string {
+	if c.Array {
+		return ""
+	}
+
+	switch c.UDTName {
+	case "int2", "int4", "int8", "oid":
+		return "int"
+	case "float4", "float8":
+		return "float"
+	case "bool":
+		return "bool"
+	case "bytea", "json", "jsonb":
+		return "bytes"
+	case "date", "timestamp", "timestamptz", "time", "timetz":
+		return "time"
+	case "numeric", "money":
+		return ""
+	}
+	return "string"
+}
+
+// structValues returns the string fields of a struct such as
+// models.TableNames
+func structValues(v interface{}) []string {
+	rv := reflect.ValueOf(v)
+	values := make([]string, rv.NumField())
+	for i := range values {
+		values[i] = rv.Field(i).String()
+	}
+	return values
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
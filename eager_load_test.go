@@ -0,0 +1,20 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+var eagerLoadOp = jetOperation{
+	name:    "EagerLoad",
+	run:     bench.Adapter.EagerLoad,
+	fixture: fixtures(jetQuery, pilotQuery),
+	// pop doesn't quote table names, and no ORM's pilots query mentions jets
+	match:  []string{"jets", "pilots"},
+	expect: func() []bench.Jet { return expectPilots(expectJets(jetQuery()), pilotQuery()) },
+}
+
+func BenchmarkEagerLoad(b *testing.B) {
+	runOperation(b, eagerLoadOp)
+}
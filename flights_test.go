@@ -0,0 +1,164 @@
+package main
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+	"github.com/aarondl/null/v8"
+)
+
+// flightRows are the flights of the fixture, the first is the flight the
+// adapters write. Every other flight hasn't arrived and has no notes.
+func flightRows() []bench.Flight {
+	statuses := []string{"scheduled", "boarding", "departed", "landed", "cancelled"}
+
+	rows := make([]bench.Flight, 5)
+	for i := range rows {
+		f := bench.FlightRow
+		f.ID = int64(i + 1)
+		f.Number = fmt.Sprintf("BB%d", 101+i)
+		f.Status = statuses[i]
+		f.DepartsAt = f.DepartsAt.Add(time.Duration(i) * time.Hour)
+		f.Seats = []int64{int64(12 + i), 14, 21}
+		if i%2 == 1 {
+			f.ArrivesAt, f.Notes = null.Time{}, null.String{}
+		}
+		rows[i] = f
+	}
+	return rows
+}
+
+// flightValues are the driver values lib/pq returns for a flight. Only
+// integers, timestamps and text have Go types of their own, numeric, uuid,
+// inet, enums, arrays and jsonb are sent as their text in a []byte.
+func flightValues(f bench.Flight) []driver.Value {
+	seats := make([]string, len(f.Seats))
+	for i, s := range f.Seats {
+		seats[i] = strconv.FormatInt(s, 10)
+	}
+
+	vals := []driver.Value{
+		f.ID, f.JetID, f.Number, []byte(f.Status), f.DepartsAt, nil,
+		[]byte(f.Fare), []byte(f.BookingRef), []byte(f.OperatorIP),
+		[]byte("{" + strings.Join(f.Crew, ",") + "}"), []byte("{" + strings.Join(seats, ",") + "}"),
+		[]byte(f.Metadata), nil,
+	}
+	if f.ArrivesAt.Valid {
+		vals[5] = f.ArrivesAt.Time
+	}
+	if f.Notes.Valid {
+		vals[12] = []byte(f.Notes.String)
+	}
+	return vals
+}
+
+func flightQuery() mimic.QueryResult {
+	rows := flightRows()
+	vals := make([][]driver.Value, len(rows))
+	for i, f := range rows {
+		vals[i] = flightValues(f)
+	}
+
+	return mimic.QueryResult{
+		Query: &mimic.Query{Cols: bench.FlightColumns, Vals: vals},
+	}
+}
+
+// flightQueryAliased returns the flights fixture with go-jet's
+// "table.column" projection aliases
+func flightQueryAliased() mimic.QueryResult {
+	query := flightQuery()
+	cols := make([]string, len(query.Cols))
+	for i, c := range query.Cols {
+		cols[i] = "flights." + c
+	}
+	query.Query = &mimic.Query{Cols: cols, Vals: query.Vals}
+	return query
+}
+
+// flightQueryUpdate is the flight the adapters write, bob reads it back
+func flightQueryUpdate() mimic.QueryResult {
+	query := flightQuery()
+	query.Vals = query.Vals[:1]
+	return query
+}
+
+// flightOperation is an operation on the flights table of an adapter
+type flightOperation = operation[bench.Flights, bench.Flight]
+
+// openFlights returns the flights table of a, adapters without the flights
+// operations return bench.ErrUnsupported
+func openFlights(a bench.Adapter) (bench.Flights, error) {
+	fa, ok := a.(bench.FlightAdapter)
+	if !ok {
+		return nil, bench.ErrUnsupported
+	}
+	return fa.Flights(), nil
+}
+
+var flightOperations = []flightOperation{
+	{
+		name:     "FlightSelectAll",
+		run:      bench.Flights.SelectAll,
+		open:     openFlights,
+		fixture:  fixtures(flightQuery),
+		override: map[string]func() []mimic.QueryResult{"jet": fixtures(flightQueryAliased)},
+		expect:   flightRows,
+	},
+	{
+		name:    "FlightInsert",
+		run:     bench.Flights.Insert,
+		open:    openFlights,
+		fixture: fixtures(jetExecUpdate),
+		expect:  func() []bench.Flight { return flightRows()[:1] },
+		override: map[string]func() []mimic.QueryResult{
+			"gorm": fixtures(jetQueryInsert),
+			"gorp": fixtures(jetQueryInsert),
+			"pop":  fixtures(jetQueryInsert),
+			"xorm": fixtures(jetQueryInsert),
+			"bob":  fixtures(flightQueryUpdate),
+		},
+	},
+	{
+		name:     "FlightUpdate",
+		run:      bench.Flights.Update,
+		open:     openFlights,
+		fixture:  fixtures(jetExecUpdate),
+		override: map[string]func() []mimic.QueryResult{"bob": fixtures(flightQueryUpdate)},
+		expect:   func() []bench.Flight { return flightRows()[:1] },
+	},
+}
+
+func flightOp(name string) flightOperation {
+	for _, op := range flightOperations {
+		if op.name == name {
+			return op
+		}
+	}
+	panic("no flight operation " + name)
+}
+
+// TestVerifyFlights is TestVerify for the flights table
+func TestVerifyFlights(t *testing.T) {
+	for _, op := range flightOperations {
+		verifyOperation(t, op)
+	}
+}
+
+func BenchmarkFlightSelectAll(b *testing.B) {
+	runOperation(b, flightOp("FlightSelectAll"))
+}
+
+func BenchmarkFlightInsert(b *testing.B) {
+	runOperation(b, flightOp("FlightInsert"))
+}
+
+func BenchmarkFlightUpdate(b *testing.B) {
+	runOperation(b, flightOp("FlightUpdate"))
+}
@@ -0,0 +1,168 @@
+// Package gojets holds the go-jet generated table metadata and models.
+package gojets
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	"github.com/aarondl/null/v8"
+	jet "github.com/go-jet/jet/v2/postgres"
+)
+
+// Adapter implements bench.Adapter for go-jet. go-jet aliases every
+// projected column as "table.column" and maps results by those aliases.
+type Adapter struct {
+	db  *sql.DB
+	jet model.Jets
+	rel relations
+
+	// jets are the results of the last select
+	jets []model.Jets
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "jet" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Reset nothing, jet maps the columns of every query to the models anew
+func (a *Adapter) Reset() {}
+
+// Close the connection
+func (a *Adapter) Close() error {
+	return a.db.Close()
+}
+
+// Open a connection to the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = db
+	color := "color-1"
+	a.jet = model.Jets{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      &color,
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(ctx context.Context) error {
+	var store []model.Jets
+	err := jet.SELECT(table.Jets.AllColumns).
+		FROM(table.Jets).
+		QueryContext(ctx, a.db, &store)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(ctx context.Context) error {
+	var store []model.Jets
+	err := jet.SELECT(
+		table.Jets.ID, table.Jets.Name, table.Jets.Color, table.Jets.UUID,
+		table.Jets.Identifier, table.Jets.Cargo, table.Jets.Manifest,
+	).
+		FROM(table.Jets).
+		QueryContext(ctx, a.db, &store)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(ctx context.Context) error {
+	var store []model.Jets
+	err := jet.SELECT(
+		table.Jets.ID, table.Jets.Name, table.Jets.Color, table.Jets.UUID,
+		table.Jets.Identifier, table.Jets.Cargo, table.Jets.Manifest,
+	).
+		FROM(table.Jets).
+		WHERE(table.Jets.ID.GT(jet.Int(1)).AND(table.Jets.Name.NOT_EQ(jet.String("thing")))).
+		LIMIT(1).
+		GROUP_BY(table.Jets.ID).
+		OFFSET(1).
+		QueryContext(ctx, a.db, &store)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(ctx context.Context) error {
+	_, err := table.Jets.INSERT(table.Jets.MutableColumns).
+		MODEL(a.jet).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// Update a jet
+func (a *Adapter) Update(ctx context.Context) error {
+	_, err := table.Jets.UPDATE(table.Jets.MutableColumns).
+		MODEL(a.jet).
+		WHERE(table.Jets.ID.EQ(jet.Int(int64(a.jet.ID)))).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// Delete a jet
+func (a *Adapter) Delete(ctx context.Context) error {
+	_, err := table.Jets.DELETE().
+		WHERE(table.Jets.ID.EQ(jet.Int(int64(a.jet.ID)))).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// RawBind a raw query into jets, the raw sql has to alias the columns the
+// same way go-jet's own projections do.
+func (a *Adapter) RawBind(ctx context.Context) error {
+	var store []model.Jets
+	err := jet.RawStatement(`select id as "jets.id", pilot_id as "jets.pilot_id", airport_id as "jets.airport_id",
+		name as "jets.name", color as "jets.color", uuid as "jets.uuid", identifier as "jets.identifier",
+		cargo as "jets.cargo", manifest as "jets.manifest" from jets`).
+		QueryContext(ctx, a.db, &store)
+	a.jets = store
+	return err
+}
+
+// EagerLoad is not supported, go-jet only loads relationships through joins
+func (a *Adapter) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j model.Jets) bench.Jet {
+	return bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      null.StringFromPtr(j.Color),
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+}
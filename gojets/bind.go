@@ -0,0 +1,33 @@
+package gojets
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Bind the rows of a query into jets with qrm, one row at a time
+func (a *Adapter) Bind(ctx context.Context) error {
+	rows, err := a.db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	sc, err := qrm.NewScanContext(rows)
+	if err != nil {
+		return err
+	}
+
+	var store []model.Jets
+	for rows.Next() {
+		var j model.Jets
+		if err := qrm.ScanOneRowToDest(sc, rows, &j); err != nil {
+			return err
+		}
+		store = append(store, j)
+	}
+	a.jets = store
+	return rows.Err()
+}
@@ -0,0 +1,40 @@
+package gojets
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	jet "github.com/go-jet/jet/v2/postgres"
+)
+
+// BuildSelectComplex builds the query of SelectComplex
+func (a *Adapter) BuildSelectComplex(context.Context) (string, []interface{}, error) {
+	sql, args := jet.SELECT(
+		table.Jets.ID, table.Jets.Name, table.Jets.Color, table.Jets.UUID,
+		table.Jets.Identifier, table.Jets.Cargo, table.Jets.Manifest,
+	).
+		FROM(table.Jets).
+		WHERE(table.Jets.ID.GT(jet.Int(1)).AND(table.Jets.Name.NOT_EQ(jet.String("thing")))).
+		LIMIT(1).
+		GROUP_BY(table.Jets.ID).
+		OFFSET(1).
+		Sql()
+	return sql, args, nil
+}
+
+// BuildInsert builds the statement of Insert
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	sql, args := table.Jets.INSERT(table.Jets.MutableColumns).
+		MODEL(a.jet).
+		Sql()
+	return sql, args, nil
+}
+
+// BuildUpdate builds the statement of Update
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	sql, args := table.Jets.UPDATE(table.Jets.MutableColumns).
+		MODEL(a.jet).
+		WHERE(table.Jets.ID.EQ(jet.Int(int64(a.jet.ID)))).
+		Sql()
+	return sql, args, nil
+}
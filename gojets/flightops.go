@@ -0,0 +1,124 @@
+package gojets
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	"github.com/aarondl/null/v8"
+	jet "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Flights returns the operations against the flights table. go-jet maps
+// numeric to float64 and arrays and jsonb to their text, which the model
+// holds as Postgres sends it.
+func (a *Adapter) Flights() bench.Flights {
+	return &flights{a: a, flight: flightOf(bench.FlightRow)}
+}
+
+// flights implements bench.Flights
+type flights struct {
+	a *Adapter
+
+	// flights are the results of the last select, wrote is set when the
+	// last operation wrote flight instead
+	flight  model.Flights
+	flights []model.Flights
+	wrote   bool
+}
+
+// SelectAll flights
+func (f *flights) SelectAll(ctx context.Context) error {
+	var store []model.Flights
+	err := jet.SELECT(table.Flights.AllColumns).
+		FROM(table.Flights).
+		QueryContext(ctx, f.a.db, &store)
+	f.flights, f.wrote = store, false
+	return err
+}
+
+// Insert a flight
+func (f *flights) Insert(ctx context.Context) error {
+	f.wrote = true
+	_, err := table.Flights.INSERT(table.Flights.MutableColumns).
+		MODEL(f.flight).
+		ExecContext(ctx, f.a.db)
+	return err
+}
+
+// Update a flight
+func (f *flights) Update(ctx context.Context) error {
+	f.wrote = true
+	_, err := table.Flights.UPDATE(table.Flights.MutableColumns).
+		MODEL(f.flight).
+		WHERE(table.Flights.ID.EQ(jet.Int(int64(f.flight.ID)))).
+		ExecContext(ctx, f.a.db)
+	return err
+}
+
+// Results of the last operation
+func (f *flights) Results() []bench.Flight {
+	if f.wrote {
+		return []bench.Flight{canonicalFlight(f.flight)}
+	}
+
+	results := make([]bench.Flight, len(f.flights))
+	for i, fl := range f.flights {
+		results[i] = canonicalFlight(fl)
+	}
+	return results
+}
+
+func flightOf(f bench.Flight) model.Flights {
+	fare, _ := strconv.ParseFloat(f.Fare, 64)
+	crew, _ := pq.StringArray(f.Crew).Value()
+	seats, _ := pq.Int64Array(f.Seats).Value()
+
+	m := model.Flights{
+		ID:         int32(f.ID),
+		JetID:      int32(f.JetID),
+		Number:     f.Number,
+		Status:     model.FlightStatus(f.Status),
+		DepartsAt:  f.DepartsAt,
+		Fare:       fare,
+		BookingRef: uuid.MustParse(f.BookingRef),
+		OperatorIP: f.OperatorIP,
+		Crew:       crew.(string),
+		Seats:      seats.(string),
+		Metadata:   f.Metadata,
+	}
+	if f.ArrivesAt.Valid {
+		m.ArrivesAt = &f.ArrivesAt.Time
+	}
+	if f.Notes.Valid {
+		m.Notes = &f.Notes.String
+	}
+	return m
+}
+
+func canonicalFlight(f model.Flights) bench.Flight {
+	var crew pq.StringArray
+	var seats pq.Int64Array
+	_ = crew.Scan(f.Crew)
+	_ = seats.Scan(f.Seats)
+
+	return bench.Flight{
+		ID:         int64(f.ID),
+		JetID:      int64(f.JetID),
+		Number:     f.Number,
+		Status:     string(f.Status),
+		DepartsAt:  f.DepartsAt,
+		ArrivesAt:  null.TimeFromPtr(f.ArrivesAt),
+		Fare:       strconv.FormatFloat(f.Fare, 'f', -1, 64),
+		BookingRef: f.BookingRef.String(),
+		OperatorIP: f.OperatorIP,
+		Crew:       crew,
+		Seats:      seats,
+		Metadata:   f.Metadata,
+		Notes:      null.StringFromPtr(f.Notes),
+	}
+}
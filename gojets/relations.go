@@ -0,0 +1,86 @@
+package gojets
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	jet "github.com/go-jet/jet/v2/postgres"
+)
+
+// relations are the pilot and the rows the relationship writes work on.
+// go-jet builds statements and maps results, the writes are to the join
+// table and the foreign keys themselves.
+type relations struct {
+	pilot     jet.IntegerExpression
+	languages []model.PilotLanguages
+	ids       []jet.Expression
+}
+
+// Relate sets up a pilot and the rows of n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: jet.Int(1)}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, model.PilotLanguages{PilotID: 1, LanguageID: int32(i)})
+		r.ids = append(r.ids, jet.Int(int64(i)))
+	}
+	a.rel = r
+}
+
+// AttachLanguages inserts the rows joining the pilot to the languages
+func (a *Adapter) AttachLanguages(ctx context.Context) error {
+	_, err := table.PilotLanguages.INSERT(table.PilotLanguages.AllColumns).
+		MODELS(a.rel.languages).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// ReplaceLanguages deletes every row joining the pilot to a language and
+// inserts those joining it to the languages
+func (a *Adapter) ReplaceLanguages(ctx context.Context) error {
+	_, err := table.PilotLanguages.DELETE().
+		WHERE(table.PilotLanguages.PilotID.EQ(a.rel.pilot)).
+		ExecContext(ctx, a.db)
+	if err != nil {
+		return err
+	}
+	return a.AttachLanguages(ctx)
+}
+
+// DetachLanguages deletes the rows joining the pilot to the languages
+func (a *Adapter) DetachLanguages(ctx context.Context) error {
+	r := &a.rel
+	_, err := table.PilotLanguages.DELETE().
+		WHERE(table.PilotLanguages.PilotID.EQ(r.pilot).AND(table.PilotLanguages.LanguageID.IN(r.ids...))).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(ctx context.Context) error {
+	r := &a.rel
+	_, err := table.Jets.UPDATE(table.Jets.PilotID).
+		SET(r.pilot).
+		WHERE(table.Jets.ID.IN(r.ids...)).
+		ExecContext(ctx, a.db)
+	return err
+}
+
+// ReplaceLicenses clears the pilot of its licenses and sets it as the
+// pilot of the licenses
+func (a *Adapter) ReplaceLicenses(ctx context.Context) error {
+	r := &a.rel
+	_, err := table.Licenses.UPDATE(table.Licenses.PilotID).
+		SET(jet.NULL).
+		WHERE(table.Licenses.PilotID.EQ(r.pilot)).
+		ExecContext(ctx, a.db)
+	if err != nil {
+		return err
+	}
+
+	_, err = table.Licenses.UPDATE(table.Licenses.PilotID).
+		SET(r.pilot).
+		WHERE(table.Licenses.ID.IN(r.ids...)).
+		ExecContext(ctx, a.db)
+	return err
+}
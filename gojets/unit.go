@@ -0,0 +1,45 @@
+package gojets
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	jet "github.com/go-jet/jet/v2/postgres"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(ctx context.Context) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var found model.Jets
+	err = jet.SELECT(table.Jets.AllColumns).
+		FROM(table.Jets).
+		WHERE(table.Jets.ID.EQ(jet.Int(1))).
+		QueryContext(ctx, tx, &found)
+	if err != nil {
+		return err
+	}
+
+	_, err = table.Jets.UPDATE(table.Jets.MutableColumns).
+		MODEL(found).
+		WHERE(table.Jets.ID.EQ(jet.Int(int64(found.ID)))).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	_, err = table.Licenses.INSERT(table.Licenses.PilotID).
+		MODEL(model.Licenses{PilotID: &found.PilotID}).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	a.jets = []model.Jets{found}
+	return tx.Commit()
+}
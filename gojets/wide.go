@@ -0,0 +1,164 @@
+package gojets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/gojets/postgres/public/model"
+	"github.com/aarondl/boilbench/gojets/postgres/public/table"
+	jet "github.com/go-jet/jet/v2/postgres"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		t := table.Wide10
+		return newWide[model.Wide10](a, columns, wideTable{t, t.AllColumns, t.MutableColumns, t.ID, t.Text003})
+	case 25:
+		t := table.Wide25
+		return newWide[model.Wide25](a, columns, wideTable{t, t.AllColumns, t.MutableColumns, t.ID, t.Text003})
+	case 50:
+		t := table.Wide50
+		return newWide[model.Wide50](a, columns, wideTable{t, t.AllColumns, t.MutableColumns, t.ID, t.Text003})
+	case 100:
+		t := table.Wide100
+		return newWide[model.Wide100](a, columns, wideTable{t, t.AllColumns, t.MutableColumns, t.ID, t.Text003})
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wideTable is the metadata of a wide table the queries need, every table
+// has a type of its own
+type wideTable struct {
+	jet.Table
+	all     jet.ColumnList
+	mutable jet.ColumnList
+	id      jet.ColumnInteger
+	text    jet.ColumnString
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any] struct {
+	a       *Adapter
+	columns int
+	table   wideTable
+	raw     string
+	id      int64
+
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []T
+	wrote bool
+}
+
+func newWide[T any](a *Adapter, columns int, t wideTable) *wide[T] {
+	// The raw sql aliases the columns the same way go-jet's own projections do
+	name := bench.WideTable(columns)
+	aliases := make([]string, columns)
+	for i, c := range bench.WideColumns(columns) {
+		aliases[i] = fmt.Sprintf(`%s as "%s.%s"`, c, name, c)
+	}
+
+	w := &wide[T]{
+		a:       a,
+		columns: columns,
+		table:   t,
+		raw:     fmt.Sprintf("select %s from %s", strings.Join(aliases, ", "), name),
+	}
+	bench.FillRow(&w.row, columns)
+	w.id = bench.RowOf(w.row, 1).Values[0].(int64)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T]) SelectAll(ctx context.Context) error {
+	var store []T
+	err := jet.SELECT(w.table.all).
+		FROM(w.table).
+		QueryContext(ctx, w.a.db, &store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T]) SelectSubset(ctx context.Context) error {
+	var store []T
+	err := jet.SELECT(w.table.all[:len(bench.WideSubset(w.columns))]).
+		FROM(w.table).
+		QueryContext(ctx, w.a.db, &store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T]) SelectComplex(ctx context.Context) error {
+	var store []T
+	err := jet.SELECT(w.table.all[:len(bench.WideSubset(w.columns))]).
+		FROM(w.table).
+		WHERE(w.table.id.GT(jet.Int(1)).AND(w.table.text.NOT_EQ(jet.String("thing")))).
+		LIMIT(1).
+		GROUP_BY(w.table.id).
+		OFFSET(1).
+		QueryContext(ctx, w.a.db, &store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T]) Insert(ctx context.Context) error {
+	w.wrote = true
+	_, err := w.table.INSERT(w.table.mutable).
+		MODEL(w.row).
+		ExecContext(ctx, w.a.db)
+	return err
+}
+
+// Update a row
+func (w *wide[T]) Update(ctx context.Context) error {
+	w.wrote = true
+	_, err := w.table.UPDATE(w.table.mutable).
+		MODEL(w.row).
+		WHERE(w.table.id.EQ(jet.Int(w.id))).
+		ExecContext(ctx, w.a.db)
+	return err
+}
+
+// Delete a row
+func (w *wide[T]) Delete(ctx context.Context) error {
+	w.wrote = true
+	_, err := w.table.DELETE().
+		WHERE(w.table.id.EQ(jet.Int(w.id))).
+		ExecContext(ctx, w.a.db)
+	return err
+}
+
+// RawBind a raw query into rows
+func (w *wide[T]) RawBind(ctx context.Context) error {
+	var store []T
+	err := jet.RawStatement(w.raw).QueryContext(ctx, w.a.db, &store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad is not supported, go-jet only loads relationships through joins
+func (w *wide[T]) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last operation
+func (w *wide[T]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{bench.RowOf(w.row, w.columns)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = bench.RowOf(r, w.columns)
+	}
+	return results
+}
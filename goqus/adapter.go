@@ -0,0 +1,158 @@
+package goqus
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	"github.com/doug-martin/goqu/v9"
+
+	// Registers the postgres dialect with goqu
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+)
+
+// Adapter implements bench.Adapter for goqu
+type Adapter struct {
+	db  *goqu.Database
+	jet Jet
+	rel relations
+
+	// jets are the results of the last select
+	jets []Jet
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "goqu" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Reset goqu's cache of struct columns, which is global but cleared when
+// IgnoreUntaggedFields changes
+func (a *Adapter) Reset() {
+	goqu.SetIgnoreUntaggedFields(true)
+	goqu.SetIgnoreUntaggedFields(false)
+}
+
+// Close the connection
+func (a *Adapter) Close() error {
+	return a.db.Db.(*sql.DB).Close()
+}
+
+// Open a goqu database on the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = goqu.New("postgres", db)
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(context.Context) error {
+	var store []Jet
+	err := a.db.From("jets").ScanStructs(&store)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(context.Context) error {
+	var store []Jet
+	err := a.db.From("jets").
+		Select("id", "name", "color", "uuid", "identifier", "cargo", "manifest").
+		ScanStructs(&store)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(context.Context) error {
+	var store []Jet
+	err := a.db.From("jets").
+		Select("id", "name", "color", "uuid", "identifier", "cargo", "manifest").
+		Where(goqu.C("id").Gt(1), goqu.C("name").Neq("thing")).
+		Limit(1).
+		GroupBy("id").
+		Offset(1).
+		ScanStructs(&store)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(context.Context) error {
+	_, err := a.db.Insert("jets").Rows(a.jet).Executor().Exec()
+	return err
+}
+
+// Update a jet
+func (a *Adapter) Update(context.Context) error {
+	_, err := a.db.Update("jets").
+		Set(a.jet).
+		Where(goqu.C("id").Eq(a.jet.ID)).
+		Executor().
+		Exec()
+	return err
+}
+
+// Delete a jet
+func (a *Adapter) Delete(context.Context) error {
+	_, err := a.db.Delete("jets").
+		Where(goqu.C("id").Eq(a.jet.ID)).
+		Executor().
+		Exec()
+	return err
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(context.Context) error {
+	var store []Jet
+	err := a.db.ScanStructs(&store, "select * from jets")
+	a.jets = store
+	return err
+}
+
+// EagerLoad is not supported, goqu has no relationships
+func (a *Adapter) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j Jet) bench.Jet {
+	return bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+}
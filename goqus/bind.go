@@ -0,0 +1,28 @@
+package goqus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/doug-martin/goqu/v9/exec"
+)
+
+// Bind the rows of a query into jets with goqu's scanner. It has no way of
+// skipping columns the model doesn't have, those results are unsupported.
+func (a *Adapter) Bind(ctx context.Context) error {
+	rows, err := a.db.Db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var store []Jet
+	err = exec.NewScanner(rows).ScanStructs(&store)
+	if err != nil && strings.Contains(err.Error(), "unable to find corresponding field") {
+		return fmt.Errorf("%w: %v", bench.ErrUnsupported, err)
+	}
+	a.jets = store
+	return err
+}
@@ -0,0 +1,31 @@
+package goqus
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+)
+
+// BuildSelectComplex builds the query of SelectComplex
+func (a *Adapter) BuildSelectComplex(context.Context) (string, []interface{}, error) {
+	return a.db.From("jets").
+		Select("id", "name", "color", "uuid", "identifier", "cargo", "manifest").
+		Where(goqu.C("id").Gt(1), goqu.C("name").Neq("thing")).
+		Limit(1).
+		GroupBy("id").
+		Offset(1).
+		ToSQL()
+}
+
+// BuildInsert builds the statement of Insert
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	return a.db.Insert("jets").Rows(a.jet).ToSQL()
+}
+
+// BuildUpdate builds the statement of Update
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	return a.db.Update("jets").
+		Set(a.jet).
+		Where(goqu.C("id").Eq(a.jet.ID)).
+		ToSQL()
+}
@@ -0,0 +1,87 @@
+package goqus
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+)
+
+// relations are the pilot and the rows the relationship writes work on.
+// goqu builds statements and maps results, the writes are to the join
+// table and the foreign keys themselves.
+type relations struct {
+	pilot     int
+	languages []PilotLanguage
+	ids       []int
+}
+
+// Relate sets up a pilot and the rows of n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: 1}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, PilotLanguage{PilotID: r.pilot, LanguageID: i})
+		r.ids = append(r.ids, i)
+	}
+	a.rel = r
+}
+
+// AttachLanguages inserts the rows joining the pilot to the languages
+func (a *Adapter) AttachLanguages(context.Context) error {
+	_, err := a.db.Insert("pilot_languages").Rows(a.rel.languages).Executor().Exec()
+	return err
+}
+
+// ReplaceLanguages deletes every row joining the pilot to a language and
+// inserts those joining it to the languages
+func (a *Adapter) ReplaceLanguages(ctx context.Context) error {
+	_, err := a.db.Delete("pilot_languages").
+		Where(goqu.C("pilot_id").Eq(a.rel.pilot)).
+		Executor().
+		Exec()
+	if err != nil {
+		return err
+	}
+	return a.AttachLanguages(ctx)
+}
+
+// DetachLanguages deletes the rows joining the pilot to the languages
+func (a *Adapter) DetachLanguages(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Delete("pilot_languages").
+		Where(goqu.C("pilot_id").Eq(r.pilot), goqu.C("language_id").In(r.ids)).
+		Executor().
+		Exec()
+	return err
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Update("jets").
+		Set(goqu.Record{"pilot_id": r.pilot}).
+		Where(goqu.C("id").In(r.ids)).
+		Executor().
+		Exec()
+	return err
+}
+
+// ReplaceLicenses clears the pilot of its licenses and sets it as the
+// pilot of the licenses
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Update("licenses").
+		Set(goqu.Record{"pilot_id": nil}).
+		Where(goqu.C("pilot_id").Eq(r.pilot)).
+		Executor().
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = a.db.Update("licenses").
+		Set(goqu.Record{"pilot_id": r.pilot}).
+		Where(goqu.C("id").In(r.ids)).
+		Executor().
+		Exec()
+	return err
+}
@@ -0,0 +1,32 @@
+package goqus
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(ctx context.Context) error {
+	var jet Jet
+	err := a.db.WithTx(func(tx *goqu.TxDatabase) error {
+		found, err := tx.From("jets").Where(goqu.C("id").Eq(1)).ScanStructContext(ctx, &jet)
+		if err != nil {
+			return err
+		} else if !found {
+			return sql.ErrNoRows
+		}
+
+		_, err = tx.Update("jets").Set(jet).Where(goqu.C("id").Eq(jet.ID)).Executor().ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Insert("licenses").Rows(License{PilotID: null.IntFrom(jet.PilotID)}).Executor().ExecContext(ctx)
+		return err
+	})
+	a.jets = []Jet{jet}
+	return err
+}
@@ -0,0 +1,141 @@
+package goqus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide[Wide10](a, columns)
+	case 25:
+		return newWide[Wide25](a, columns)
+	case 50:
+		return newWide[Wide50](a, columns)
+	case 100:
+		return newWide[Wide100](a, columns)
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any] struct {
+	a       *Adapter
+	columns int
+	table   string
+	// id of row, the wide models have no common type to read it from
+	id interface{}
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []T
+	wrote bool
+}
+
+func newWide[T any](a *Adapter, columns int) *wide[T] {
+	w := &wide[T]{a: a, columns: columns, table: bench.WideTable(columns)}
+	bench.FillRow(&w.row, columns)
+	w.id = bench.RowOf(w.row, 1).Values[0]
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T]) SelectAll(context.Context) error {
+	var store []T
+	err := w.a.db.From(w.table).ScanStructs(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T]) SelectSubset(context.Context) error {
+	var store []T
+	err := w.a.db.From(w.table).
+		Select(w.subset()...).
+		ScanStructs(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T]) SelectComplex(context.Context) error {
+	var store []T
+	err := w.a.db.From(w.table).
+		Select(w.subset()...).
+		Where(goqu.C("id").Gt(1), goqu.C("text_003").Neq("thing")).
+		Limit(1).
+		GroupBy("id").
+		Offset(1).
+		ScanStructs(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T]) Insert(context.Context) error {
+	w.wrote = true
+	_, err := w.a.db.Insert(w.table).Rows(w.row).Executor().Exec()
+	return err
+}
+
+// Update a row
+func (w *wide[T]) Update(context.Context) error {
+	w.wrote = true
+	_, err := w.a.db.Update(w.table).
+		Set(w.row).
+		Where(goqu.C("id").Eq(w.id)).
+		Executor().
+		Exec()
+	return err
+}
+
+// Delete a row
+func (w *wide[T]) Delete(context.Context) error {
+	w.wrote = true
+	_, err := w.a.db.Delete(w.table).
+		Where(goqu.C("id").Eq(w.id)).
+		Executor().
+		Exec()
+	return err
+}
+
+// RawBind a raw query into rows
+func (w *wide[T]) RawBind(context.Context) error {
+	var store []T
+	err := w.a.db.ScanStructs(&store, "select * from "+w.table)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad is not supported, goqu has no relationships
+func (w *wide[T]) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// subset are the columns read by SelectSubset and SelectComplex
+func (w *wide[T]) subset() []interface{} {
+	var columns []interface{}
+	for _, c := range bench.WideSubset(w.columns) {
+		columns = append(columns, c)
+	}
+	return columns
+}
+
+// Results of the last operation
+func (w *wide[T]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{bench.RowOf(w.row, w.columns)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = bench.RowOf(r, w.columns)
+	}
+	return results
+}
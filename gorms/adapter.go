@@ -0,0 +1,161 @@
+package gorms
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Adapter implements bench.Adapter for gorm
+type Adapter struct {
+	db  *gorm.DB
+	jet Jet
+
+	// jets are the results of the last select
+	jets []Jet
+
+	rel relations
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "gorm" }
+
+// Clone the adapter for another goroutine, sharing its connection. Scan
+// sets the logger on the config of the *gorm.DB it's called on, so every
+// goroutine gets a session with a config of its own, which keeps gorm's
+// schema and statement caches.
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db.Session(&gorm.Session{}), jet: a.jet}
+}
+
+// Reset nothing, gorm caches the schemas of models on the *gorm.DB
+func (a *Adapter) Reset() {}
+
+// Close the connection
+func (a *Adapter) Close() error {
+	db, err := a.db.DB()
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// Open a gorm connection to the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	dialector := postgres.New(postgres.Config{
+		DriverName: "mimic",
+		DSN:        dsn,
+	})
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return err
+	}
+
+	a.db = db
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(context.Context) error {
+	var store []Jet
+	err := a.db.Find(&store).Error
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(context.Context) error {
+	var store []Jet
+	err := a.db.Select("id, name, color, uuid, identifier, cargo, manifest").Find(&store).Error
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(context.Context) error {
+	var store []Jet
+	err := a.db.
+		Where("id > ?", 1).
+		Where("name <> ?", "thing").
+		Limit(1).
+		Group("id").
+		Offset(1).
+		Select("id, name, color, uuid, identifier, cargo, manifest").
+		Find(&store).Error
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(context.Context) error {
+	return a.db.Create(&a.jet).Error
+}
+
+// Update a jet
+func (a *Adapter) Update(context.Context) error {
+	return a.db.Model(&a.jet).Updates(a.jet).Error
+}
+
+// Delete a jet
+func (a *Adapter) Delete(context.Context) error {
+	return a.db.Delete(&a.jet).Error
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(context.Context) error {
+	var store []Jet
+	err := a.db.Raw("select * from jets").Scan(&store).Error
+	a.jets = store
+	return err
+}
+
+// EagerLoad jets with their pilots
+func (a *Adapter) EagerLoad(context.Context) error {
+	var store []Jet
+	err := a.db.Preload("Pilot").Find(&store).Error
+	a.jets = store
+	return err
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j Jet) bench.Jet {
+	c := bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+	if j.Pilot.ID != 0 {
+		c.Pilot = &bench.Pilot{ID: int64(j.Pilot.ID), Name: j.Pilot.Name}
+	}
+	return c
+}
@@ -0,0 +1,26 @@
+package gorms
+
+import (
+	"context"
+)
+
+// Bind the rows of a query into jets with ScanRows
+func (a *Adapter) Bind(ctx context.Context) error {
+	db, err := a.db.DB()
+	if err != nil {
+		return err
+	}
+	rows, err := db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// ScanRows scans the row rows is on, and for a slice every row after
+	var store []Jet
+	if rows.Next() {
+		err = a.db.ScanRows(rows, &store)
+	}
+	a.jets = store
+	return err
+}
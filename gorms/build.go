@@ -0,0 +1,42 @@
+package gorms
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// BuildSelectComplex builds the query of SelectComplex in a dry run
+// session, which builds statements without running them
+func (a *Adapter) BuildSelectComplex(context.Context) (string, []interface{}, error) {
+	var store []Jet
+	return statement(a.dryRun().
+		Where("id > ?", 1).
+		Where("name <> ?", "thing").
+		Limit(1).
+		Group("id").
+		Offset(1).
+		Select("id, name, color, uuid, identifier, cargo, manifest").
+		Find(&store))
+}
+
+// BuildInsert builds the statement of Insert in a dry run session
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	jet := a.jet
+	return statement(a.dryRun().Create(&jet))
+}
+
+// BuildUpdate builds the statement of Update in a dry run session
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	jet := a.jet
+	return statement(a.dryRun().Model(&jet).Updates(jet))
+}
+
+func (a *Adapter) dryRun() *gorm.DB {
+	return a.db.Session(&gorm.Session{DryRun: true})
+}
+
+// statement returns the SQL and args a dry run built
+func statement(tx *gorm.DB) (string, []interface{}, error) {
+	return tx.Statement.SQL.String(), tx.Statement.Vars, tx.Error
+}
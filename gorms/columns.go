@@ -0,0 +1,41 @@
+package gorms
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+
+	"gorm.io/gorm"
+)
+
+// InsertColumns inserts a jet, writing the columns of cols. gorm inserts
+// every field, zero or not, so it has nothing to add to with a greylist.
+func (a *Adapter) InsertColumns(_ context.Context, cols bench.Columns) error {
+	tx, err := a.columns(a.db, cols)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&a.jet).Error
+}
+
+// UpdateColumns updates a jet, writing the columns of cols
+func (a *Adapter) UpdateColumns(_ context.Context, cols bench.Columns) error {
+	tx, err := a.columns(a.db.Model(&a.jet), cols)
+	if err != nil {
+		return err
+	}
+	return tx.Updates(a.jet).Error
+}
+
+// columns selects or omits the columns of cols on tx
+func (a *Adapter) columns(tx *gorm.DB, cols bench.Columns) (*gorm.DB, error) {
+	switch cols.Kind {
+	case bench.Whitelist:
+		return tx.Select(cols.Cols), nil
+	case bench.Blacklist:
+		return tx.Omit(cols.Cols...), nil
+	case bench.Greylist:
+		return nil, bench.ErrUnsupported
+	}
+	return tx, nil
+}
@@ -0,0 +1,63 @@
+package gorms
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+)
+
+// relations are the pilot and the rows the relationship writes work on
+type relations struct {
+	pilot     Pilot
+	languages []Language
+	jets      []Jet
+	licenses  []License
+}
+
+// Relate sets up a pilot and n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: Pilot{ID: 1, Name: "test"}}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, Language{ID: i, Language: "test"})
+		r.jets = append(r.jets, Jet{ID: i, PilotID: 2, AirportID: 1, Name: "test", UUID: "test"})
+		r.licenses = append(r.licenses, License{ID: i, PilotID: null.IntFrom(2)})
+	}
+	a.rel = r
+}
+
+// AttachLanguages adds the languages to the pilot, which appends them to
+// its Languages. The languages exist, so only the join table is written.
+func (a *Adapter) AttachLanguages(context.Context) error {
+	r := &a.rel
+	r.pilot.Languages = nil
+	return a.db.Model(&r.pilot).Omit("Languages.*").Association("Languages").Append(r.languages)
+}
+
+// ReplaceLanguages sets the languages as the only ones of the pilot
+func (a *Adapter) ReplaceLanguages(context.Context) error {
+	r := &a.rel
+	r.pilot.Languages = nil
+	return a.db.Model(&r.pilot).Omit("Languages.*").Association("Languages").Replace(r.languages)
+}
+
+// DetachLanguages removes the languages, loaded on the pilot, from it
+func (a *Adapter) DetachLanguages(context.Context) error {
+	r := &a.rel
+	r.pilot.Languages = append(r.pilot.Languages[:0], r.languages...)
+	return a.db.Model(&r.pilot).Association("Languages").Delete(r.languages)
+}
+
+// AttachJets sets the pilot of the jets, gorm upserts them with it
+func (a *Adapter) AttachJets(context.Context) error {
+	r := &a.rel
+	r.pilot.Jets = nil
+	return a.db.Model(&r.pilot).Association("Jets").Append(r.jets)
+}
+
+// ReplaceLicenses sets the licenses as the only ones of the pilot, gorm
+// upserts them with it and clears the pilot of the others
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	r := &a.rel
+	r.pilot.Licenses = nil
+	return a.db.Model(&r.pilot).Association("Licenses").Replace(r.licenses)
+}
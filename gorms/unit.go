@@ -0,0 +1,25 @@
+package gorms
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"gorm.io/gorm"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(context.Context) error {
+	var jet Jet
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&jet, 1).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&jet).Updates(jet).Error; err != nil {
+			return err
+		}
+		return tx.Create(&License{PilotID: null.IntFrom(jet.PilotID)}).Error
+	})
+	a.jets = []Jet{jet}
+	return err
+}
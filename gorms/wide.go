@@ -0,0 +1,130 @@
+package gorms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide(a, columns, func(w Wide10) Pilot { return w.Pilot })
+	case 25:
+		return newWide(a, columns, func(w Wide25) Pilot { return w.Pilot })
+	case 50:
+		return newWide(a, columns, func(w Wide50) Pilot { return w.Pilot })
+	case 100:
+		return newWide(a, columns, func(w Wide100) Pilot { return w.Pilot })
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any] struct {
+	a       *Adapter
+	columns int
+	pilot   func(T) Pilot
+
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []T
+	wrote bool
+}
+
+func newWide[T any](a *Adapter, columns int, pilot func(T) Pilot) *wide[T] {
+	w := &wide[T]{a: a, columns: columns, pilot: pilot}
+	bench.FillRow(&w.row, columns)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T]) SelectAll(context.Context) error {
+	var store []T
+	err := w.a.db.Find(&store).Error
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T]) SelectSubset(context.Context) error {
+	var store []T
+	err := w.a.db.Select(strings.Join(bench.WideSubset(w.columns), ", ")).Find(&store).Error
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T]) SelectComplex(context.Context) error {
+	var store []T
+	err := w.a.db.
+		Where("id > ?", 1).
+		Where("text_003 <> ?", "thing").
+		Limit(1).
+		Group("id").
+		Offset(1).
+		Select(strings.Join(bench.WideSubset(w.columns), ", ")).
+		Find(&store).Error
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T]) Insert(context.Context) error {
+	w.wrote = true
+	return w.a.db.Create(&w.row).Error
+}
+
+// Update a row
+func (w *wide[T]) Update(context.Context) error {
+	w.wrote = true
+	return w.a.db.Model(&w.row).Updates(w.row).Error
+}
+
+// Delete a row
+func (w *wide[T]) Delete(context.Context) error {
+	w.wrote = true
+	return w.a.db.Delete(&w.row).Error
+}
+
+// RawBind a raw query into rows
+func (w *wide[T]) RawBind(context.Context) error {
+	var store []T
+	err := w.a.db.Raw("select * from " + bench.WideTable(w.columns)).Scan(&store).Error
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad rows with their pilots
+func (w *wide[T]) EagerLoad(context.Context) error {
+	var store []T
+	err := w.a.db.Preload("Pilot").Find(&store).Error
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Results of the last operation
+func (w *wide[T]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{w.canonical(w.row)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = w.canonical(r)
+	}
+	return results
+}
+
+func (w *wide[T]) canonical(r T) bench.Row {
+	row := bench.RowOf(r, w.columns)
+	if p := w.pilot(r); p.ID != 0 {
+		row.Pilot = &bench.Pilot{ID: int64(p.ID), Name: p.Name}
+	}
+	return row
+}
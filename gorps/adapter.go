@@ -0,0 +1,139 @@
+package gorps
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	"gopkg.in/gorp.v1"
+)
+
+// Adapter implements bench.Adapter for gorp
+type Adapter struct {
+	db  *gorp.DbMap
+	jet Jet
+	rel relations
+
+	// jets are the results of the last select
+	jets []Jet
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "gorp" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Reset nothing, gorp maps the tables on the DbMap as Open adds them
+func (a *Adapter) Reset() {}
+
+// Close the connection
+func (a *Adapter) Close() error {
+	return a.db.Db.Close()
+}
+
+// Open a gorp connection to the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = &gorp.DbMap{Db: db, Dialect: gorp.PostgresDialect{}}
+	AddTables(a.db)
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(context.Context) error {
+	var store []Jet
+	_, err := a.db.Select(&store, "select * from jets")
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(context.Context) error {
+	var store []Jet
+	_, err := a.db.Select(&store, `select id, name, color, uuid, identifier, cargo, manifest from "jets"`)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(context.Context) error {
+	var store []Jet
+	_, err := a.db.Select(&store, `
+			select id, name, color, uuid, identifier, cargo, manifest from "jets"
+			where id > $1 and name <> $2 group by "id" offset $3 limit $4
+		`, 1, "thing", 1, 1)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(context.Context) error {
+	return a.db.Insert(&a.jet)
+}
+
+// Update a jet
+func (a *Adapter) Update(context.Context) error {
+	_, err := a.db.Update(&a.jet)
+	return err
+}
+
+// Delete a jet
+func (a *Adapter) Delete(context.Context) error {
+	_, err := a.db.Delete(&a.jet)
+	return err
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(context.Context) error {
+	var store []Jet
+	_, err := a.db.Select(&store, "select * from jets")
+	a.jets = store
+	return err
+}
+
+// EagerLoad is not supported, gorp has no relationships
+func (a *Adapter) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j Jet) bench.Jet {
+	return bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+}
@@ -0,0 +1,94 @@
+package gorps
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+)
+
+// Flights returns the operations against the flights table
+func (a *Adapter) Flights() bench.Flights {
+	return &flights{a: a, flight: flightOf(bench.FlightRow)}
+}
+
+// flights implements bench.Flights
+type flights struct {
+	a *Adapter
+
+	// flights are the results of the last select, wrote is set when the
+	// last operation wrote flight instead
+	flight  Flight
+	flights []Flight
+	wrote   bool
+}
+
+// SelectAll flights
+func (f *flights) SelectAll(context.Context) error {
+	var store []Flight
+	_, err := f.a.db.Select(&store, "select * from flights")
+	f.flights, f.wrote = store, false
+	return err
+}
+
+// Insert a flight
+func (f *flights) Insert(context.Context) error {
+	f.wrote = true
+	return f.a.db.Insert(&f.flight)
+}
+
+// Update a flight
+func (f *flights) Update(context.Context) error {
+	f.wrote = true
+	_, err := f.a.db.Update(&f.flight)
+	return err
+}
+
+// Results of the last operation
+func (f *flights) Results() []bench.Flight {
+	if f.wrote {
+		return []bench.Flight{canonicalFlight(f.flight)}
+	}
+
+	results := make([]bench.Flight, len(f.flights))
+	for i, fl := range f.flights {
+		results[i] = canonicalFlight(fl)
+	}
+	return results
+}
+
+func flightOf(f bench.Flight) Flight {
+	return Flight{
+		ID:         int(f.ID),
+		JetID:      int(f.JetID),
+		Number:     f.Number,
+		Status:     f.Status,
+		DepartsAt:  f.DepartsAt,
+		ArrivesAt:  f.ArrivesAt,
+		Fare:       f.Fare,
+		BookingRef: f.BookingRef,
+		OperatorIP: f.OperatorIP,
+		Crew:       f.Crew,
+		Seats:      f.Seats,
+		Metadata:   []byte(f.Metadata),
+		Notes:      null.NewJSON([]byte(f.Notes.String), f.Notes.Valid),
+	}
+}
+
+func canonicalFlight(f Flight) bench.Flight {
+	return bench.Flight{
+		ID:         int64(f.ID),
+		JetID:      int64(f.JetID),
+		Number:     f.Number,
+		Status:     f.Status,
+		DepartsAt:  f.DepartsAt,
+		ArrivesAt:  f.ArrivesAt,
+		Fare:       f.Fare,
+		BookingRef: f.BookingRef,
+		OperatorIP: f.OperatorIP,
+		Crew:       f.Crew,
+		Seats:      f.Seats,
+		Metadata:   string(f.Metadata),
+		Notes:      null.NewString(string(f.Notes.JSON), f.Notes.Valid),
+	}
+}
@@ -0,0 +1,72 @@
+package gorps
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// relations are the pilot and the rows the relationship writes work on.
+// gorp has no relationships, the writes are to the join table and the
+// foreign keys themselves. args are the pilot followed by the ids of the
+// rows, whose placeholders are in.
+type relations struct {
+	languages []interface{}
+	args      []interface{}
+	in        string
+}
+
+// Relate sets up a pilot and the rows of n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	pilot := 1
+	r := relations{args: []interface{}{pilot}}
+
+	placeholders := make([]string, n)
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, &PilotLanguage{PilotID: pilot, LanguageID: i})
+		r.args = append(r.args, i)
+		placeholders[i-1] = "$" + strconv.Itoa(i+1)
+	}
+	r.in = strings.Join(placeholders, ",")
+	a.rel = r
+}
+
+// AttachLanguages inserts the rows joining the pilot to the languages
+func (a *Adapter) AttachLanguages(context.Context) error {
+	return a.db.Insert(a.rel.languages...)
+}
+
+// ReplaceLanguages deletes every row joining the pilot to a language and
+// inserts those joining it to the languages
+func (a *Adapter) ReplaceLanguages(context.Context) error {
+	r := &a.rel
+	if _, err := a.db.Exec("delete from pilot_languages where pilot_id = $1", r.args[0]); err != nil {
+		return err
+	}
+	return a.db.Insert(r.languages...)
+}
+
+// DetachLanguages deletes the rows joining the pilot to the languages
+func (a *Adapter) DetachLanguages(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Exec("delete from pilot_languages where pilot_id = $1 and language_id in ("+r.in+")", r.args...)
+	return err
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Exec("update jets set pilot_id = $1 where id in ("+r.in+")", r.args...)
+	return err
+}
+
+// ReplaceLicenses clears the pilot of its licenses and sets it as the
+// pilot of the licenses
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	r := &a.rel
+	if _, err := a.db.Exec("update licenses set pilot_id = null where pilot_id = $1", r.args[0]); err != nil {
+		return err
+	}
+	_, err := a.db.Exec("update licenses set pilot_id = $1 where id in ("+r.in+")", r.args...)
+	return err
+}
@@ -0,0 +1,35 @@
+package gorps
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(context.Context) error {
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	found, err := tx.Get(Jet{}, 1)
+	if err != nil {
+		return err
+	} else if found == nil {
+		return sql.ErrNoRows
+	}
+	jet := found.(*Jet)
+	if _, err := tx.Update(jet); err != nil {
+		return err
+	}
+	if err := tx.Insert(&License{PilotID: null.IntFrom(jet.PilotID)}); err != nil {
+		return err
+	}
+
+	a.jets = []Jet{*jet}
+	return tx.Commit()
+}
@@ -0,0 +1,116 @@
+package gorps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide[Wide10](a, columns)
+	case 25:
+		return newWide[Wide25](a, columns)
+	case 50:
+		return newWide[Wide50](a, columns)
+	case 100:
+		return newWide[Wide100](a, columns)
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any] struct {
+	a       *Adapter
+	columns int
+	table   string
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []T
+	wrote bool
+}
+
+func newWide[T any](a *Adapter, columns int) *wide[T] {
+	w := &wide[T]{a: a, columns: columns, table: bench.WideTable(columns)}
+	bench.FillRow(&w.row, columns)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T]) SelectAll(context.Context) error {
+	var store []T
+	_, err := w.a.db.Select(&store, "select * from "+w.table)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T]) SelectSubset(context.Context) error {
+	var store []T
+	_, err := w.a.db.Select(&store, fmt.Sprintf(`select %s from "%s"`, strings.Join(bench.WideSubset(w.columns), ", "), w.table))
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T]) SelectComplex(context.Context) error {
+	var store []T
+	_, err := w.a.db.Select(&store, fmt.Sprintf(`
+			select %s from "%s"
+			where id > $1 and text_003 <> $2 group by "id" offset $3 limit $4
+		`, strings.Join(bench.WideSubset(w.columns), ", "), w.table), 1, "thing", 1, 1)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T]) Insert(context.Context) error {
+	w.wrote = true
+	return w.a.db.Insert(&w.row)
+}
+
+// Update a row
+func (w *wide[T]) Update(context.Context) error {
+	w.wrote = true
+	_, err := w.a.db.Update(&w.row)
+	return err
+}
+
+// Delete a row
+func (w *wide[T]) Delete(context.Context) error {
+	w.wrote = true
+	_, err := w.a.db.Delete(&w.row)
+	return err
+}
+
+// RawBind a raw query into rows
+func (w *wide[T]) RawBind(context.Context) error {
+	var store []T
+	_, err := w.a.db.Select(&store, "select * from "+w.table)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad is not supported, gorp has no relationships
+func (w *wide[T]) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last operation
+func (w *wide[T]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{bench.RowOf(w.row, w.columns)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = bench.RowOf(r, w.columns)
+	}
+	return results
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+	"github.com/aarondl/boilbench/models"
+	"github.com/aarondl/boilbench/models_nohooks"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// hookCounts are the numbers of hooks the Hooks benchmarks register at
+// every hook point of Jet
+var hookCounts = []int{0, 1, 5}
+
+// jetHookPoints are every hook point of Jet
+var jetHookPoints = []boil.HookPoint{
+	boil.BeforeInsertHook, boil.BeforeUpdateHook, boil.BeforeDeleteHook, boil.BeforeUpsertHook,
+	boil.AfterInsertHook, boil.AfterSelectHook, boil.AfterUpdateHook, boil.AfterDeleteHook, boil.AfterUpsertHook,
+}
+
+// setJetHooks replaces the hooks of Jet with n of hook at every hook point
+func setJetHooks(n int, hook models.JetHook) {
+	models.ResetJetHooks()
+	for i := 0; i < n; i++ {
+		for _, p := range jetHookPoints {
+			models.AddJetHook(p, hook)
+		}
+	}
+}
+
+// noopHook is the hook the benchmarks register, what a hook does costs the
+// same with any ORM so only calling it is measured
+func noopHook(context.Context, boil.ContextExecutor, *models.Jet) error { return nil }
+
+// upsertOp upserts a jet, which only sqlboiler's adapters do
+var upsertOp = jetOperation{
+	name: "Upsert",
+	run: func(a bench.Adapter, ctx context.Context) error {
+		u, ok := a.(interface{ Upsert(context.Context) error })
+		if !ok {
+			return bench.ErrUnsupported
+		}
+		return u.Upsert(ctx)
+	},
+	fixture: fixtures(jetQueryUpsert),
+	override: map[string]func() []mimic.QueryResult{
+		"boil-softdelete": fixtures(jetQueryUpsertSoftDelete),
+	},
+	expect: func() []bench.Jet { return expectJets(jetQueryUpdate()) },
+	sends:  append([]string{"id"}, jetUpdatable...),
+}
+
+// jetQueryUpsert is what the upsert returns, the id it's inferred to have
+// a default
+func jetQueryUpsert() mimic.QueryResult {
+	return mimic.QueryResult{
+		Query: &mimic.Query{
+			Cols: []string{"id"},
+			Vals: [][]driver.Value{{int64(1)}},
+		},
+	}
+}
+
+// jetQueryUpsertSoftDelete is what the upsert of models_softdelete returns,
+// its jets also have the nullable deleted_at
+func jetQueryUpsertSoftDelete() mimic.QueryResult {
+	return mimic.QueryResult{
+		Query: &mimic.Query{
+			Cols: []string{"deleted_at", "id"},
+			Vals: [][]driver.Value{{nil, int64(1)}},
+		},
+	}
+}
+
+// hookOperations are the operations that run hooks
+var hookOperations = []jetOperation{selectAllOp, insertOp, updateOp, deleteOp, upsertOp}
+
+// hookPointsHit are the numbers of hook points of Jet each hook operation
+// calls for every jet it works on
+var hookPointsHit = map[string]int{
+	"SelectAll": 1,
+	"Insert":    2,
+	"Update":    2,
+	"Delete":    2,
+	"Upsert":    2,
+}
+
+// hookAdapters returns the sqlboiler adapters, with models generated with
+// hooks and with --no-hooks
+func hookAdapters() []bench.Adapter {
+	return []bench.Adapter{&models.Adapter{}, &models_nohooks.Adapter{}}
+}
+
+// runHooks creates an op/hooks/orm sub-benchmark for every number of
+// hooks. The models generated with --no-hooks are only benchmarked at 0,
+// next to the models that have the hook machinery compiled in.
+func runHooks(b *testing.B, op jetOperation) {
+	defer models.ResetJetHooks()
+
+	for _, n := range hookCounts {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			setJetHooks(n, noopHook)
+
+			for _, a := range hookAdapters() {
+				if n != 0 && a.Name() != "boil" {
+					continue
+				}
+
+				dsn := "postgres://Hooks" + op.name + "-" + strconv.Itoa(n) + "-" + a.Name()
+				mimic.NewSequenceDSN(dsn, op.script("boil")...)
+				if err := a.Open(dsn); err != nil {
+					b.Fatal(err)
+				}
+
+				b.Run(a.Name(), func(b *testing.B) {
+					ctx := context.Background()
+					checkOperation(b, op, a.Name(), a, dsn)
+
+					b.ResetTimer()
+					loop(b, func() error { return op.run(a, ctx) })
+				})
+			}
+		})
+	}
+}
+
+// TestVerifyHooks is TestVerify for the Hooks benchmarks, it also checks
+// that every operation calls each of its hooks once per jet, and that the
+// models generated with --no-hooks call none
+func TestVerifyHooks(t *testing.T) {
+	defer models.ResetJetHooks()
+
+	const hooks = 5
+
+	for _, op := range hookOperations {
+		for _, a := range hookAdapters() {
+			t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+				var calls atomic.Int64
+				setJetHooks(hooks, func(context.Context, boil.ContextExecutor, *models.Jet) error {
+					calls.Add(1)
+					return nil
+				})
+
+				dsn := "postgres://verify-hooks-" + op.name + "-" + a.Name()
+				runChecked(t, op, a, dsn, op.script("boil"), "")
+
+				want := int64(0)
+				if a.Name() == "boil" {
+					want = int64(hooks * hookPointsHit[op.name] * len(op.expect()))
+				}
+				if got := calls.Load(); got != want {
+					t.Errorf("%d hooks were called, want %d", got, want)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkHooksSelectAll(b *testing.B) {
+	runHooks(b, selectAllOp)
+}
+
+func BenchmarkHooksInsert(b *testing.B) {
+	runHooks(b, insertOp)
+}
+
+func BenchmarkHooksUpdate(b *testing.B) {
+	runHooks(b, updateOp)
+}
+
+func BenchmarkHooksDelete(b *testing.B) {
+	runHooks(b, deleteOp)
+}
+
+func BenchmarkHooksUpsert(b *testing.B) {
+	runHooks(b, upsertOp)
+}
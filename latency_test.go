@@ -0,0 +1,189 @@
+package main
+
+import (
+	"flag"
+	"math/bits"
+	"sync"
+	"testing"
+	"time"
+)
+
+var latency = flag.Bool("latency", false, "time every operation and report its p50, p90, p99 and max latency")
+
+// Latencies under histogramExact ns get a bucket each, above it every
+// doubling is split in histogramSub buckets, so percentiles are within
+// 1/histogramSub of the latency. That's a handful of KB for any duration.
+const (
+	histogramSub   = 64
+	histogramExact = 2 * histogramSub
+	histogramSize  = histogramExact + 57*histogramSub
+)
+
+// histogram counts latencies in log-linear buckets, recording one is cheap
+// enough not to show up in the ns/op next to it
+type histogram struct {
+	counts [histogramSize]uint64
+	n      uint64
+	max    time.Duration
+}
+
+// bucket returns the bucket d falls in
+func bucket(d time.Duration) int {
+	ns := uint64(d)
+	if d < 0 {
+		ns = 0
+	}
+	if ns < histogramExact {
+		return int(ns)
+	}
+	shift := bits.Len64(ns) - bits.Len64(histogramExact-1)
+	return histogramExact + (shift-1)*histogramSub + int(ns>>shift) - histogramSub
+}
+
+// lowest returns the smallest latency of bucket b
+func lowest(b int) time.Duration {
+	if b < histogramExact {
+		return time.Duration(b)
+	}
+	shift := (b-histogramExact)/histogramSub + 1
+	return time.Duration(uint64((b-histogramExact)%histogramSub+histogramSub) << shift)
+}
+
+func (h *histogram) record(d time.Duration) {
+	h.counts[bucket(d)]++
+	h.n++
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// merge the latencies of o into h
+func (h *histogram) merge(o *histogram) {
+	for i, c := range o.counts {
+		h.counts[i] += c
+	}
+	h.n += o.n
+	if o.max > h.max {
+		h.max = o.max
+	}
+}
+
+// percentile returns the latency p percent of the recorded ones are under
+func (h *histogram) percentile(p float64) time.Duration {
+	rank := uint64(p / 100 * float64(h.n))
+	if rank >= h.n {
+		return h.max
+	}
+
+	var seen uint64
+	for b, c := range h.counts {
+		seen += c
+		if seen > rank {
+			return lowest(b)
+		}
+	}
+	return h.max
+}
+
+// report the percentiles of the latencies as metrics of b
+func (h *histogram) report(b *testing.B) {
+	if h.n == 0 {
+		return
+	}
+	b.ReportMetric(float64(h.percentile(50)), "p50-ns")
+	b.ReportMetric(float64(h.percentile(90)), "p90-ns")
+	b.ReportMetric(float64(h.percentile(99)), "p99-ns")
+	b.ReportMetric(float64(h.max), "max-ns")
+}
+
+// loop runs fn b.N times. With -latency every run is timed and the
+// percentiles of their latency are reported alongside the mean, which
+// hides the tail that garbage collection adds.
+func loop(b *testing.B, fn func() error) {
+	if !*latency {
+		for i := 0; i < b.N; i++ {
+			if err := fn(); err != nil {
+				b.Fatal(err)
+			}
+		}
+		return
+	}
+
+	h := new(histogram)
+	for i := 0; i < b.N; i++ {
+		start := time.Now()
+		err := fn()
+		h.record(time.Since(start))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	h.report(b)
+}
+
+// loopParallel is loop for b.RunParallel, the latencies of every goroutine
+// are reported together. fn is called once per goroutine to make the
+// function it runs.
+func loopParallel(b *testing.B, fn func() func() error) {
+	var (
+		mut sync.Mutex
+		all = new(histogram)
+	)
+
+	b.RunParallel(func(pb *testing.PB) {
+		run := fn()
+		if !*latency {
+			for pb.Next() {
+				if err := run(); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+			return
+		}
+
+		h := new(histogram)
+		defer func() {
+			mut.Lock()
+			all.merge(h)
+			mut.Unlock()
+		}()
+		for pb.Next() {
+			start := time.Now()
+			err := run()
+			h.record(time.Since(start))
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	all.report(b)
+}
+
+func TestHistogram(t *testing.T) {
+	for _, d := range []time.Duration{0, 1, 127, 128, 129, 1000, 12345, time.Millisecond, time.Hour} {
+		low := lowest(bucket(d))
+		if low > d || float64(d-low) > float64(d)/histogramSub {
+			t.Errorf("%d: bucket starts at %d", d, low)
+		}
+	}
+
+	h := new(histogram)
+	for i := 1; i <= 1000; i++ {
+		h.record(time.Duration(i) * time.Microsecond)
+	}
+	o := new(histogram)
+	o.record(time.Second)
+	h.merge(o)
+
+	for _, c := range []struct {
+		p    float64
+		want time.Duration
+	}{{50, 501 * time.Microsecond}, {90, 901 * time.Microsecond}, {99, 991 * time.Microsecond}, {100, time.Second}} {
+		got := h.percentile(c.p)
+		if got > c.want || float64(c.want-got) > float64(c.want)/histogramSub {
+			t.Errorf("p%v: want about %v, got %v", c.p, c.want, got)
+		}
+	}
+}
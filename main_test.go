@@ -2,61 +2,61 @@ package main
 
 import (
 	"database/sql/driver"
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/aarondl/boilbench/bench"
 	"github.com/aarondl/boilbench/mimic"
-	"gorm.io/driver/postgres"
+	"github.com/aarondl/null/v8"
 	"xorm.io/xorm/dialects"
 )
 
+// jetRow is the row of the jet with the given id in the jets fixtures.
+// Every column holds a value of its own, so that an adapter that maps a
+// column to the wrong field fails verification, and jets with an odd id
+// have a color.
+func jetRow(id int64) []driver.Value {
+	var color driver.Value
+	if id%2 == 1 {
+		color = fmt.Sprintf("color-%d", id)
+	}
+
+	return []driver.Value{
+		id, id%5 + 1, id + 10, fmt.Sprintf("name-%d", id), color, fmt.Sprintf("uuid-%d", id),
+		fmt.Sprintf("identifier-%d", id), []byte(fmt.Sprintf("cargo-%d", id)), []byte(fmt.Sprintf("manifest-%d", id)),
+	}
+}
+
 func jetQuery() mimic.QueryResult {
-	return mimic.QueryResult{
-		Query: &mimic.Query{
-			Cols: []string{"id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"},
-			Vals: [][]driver.Value{
-				{
-					int64(1), int64(1), int64(1), "test", nil, "test", "test", []byte("test"), []byte("test"),
-				},
-				{
-					int64(2), int64(2), int64(2), "test", nil, "test", "test", []byte("test"), []byte("test"),
-				},
-				{
-					int64(3), int64(3), int64(3), "test", nil, "test", "test", []byte("test"), []byte("test"),
-				},
-				{
-					int64(4), int64(4), int64(4), "test", nil, "test", "test", []byte("test"), []byte("test"),
-				},
-				{
-					int64(5), int64(5), int64(5), "test", nil, "test", "test", []byte("test"), []byte("test"),
-				},
-			},
-		},
+	return jetRows(5)
+}
+
+// jetQueryAliased returns the jets fixture with go-jet's "table.column"
+// projection aliases so its query result mapping can find the columns.
+func jetQueryAliased() mimic.QueryResult {
+	return aliasJets(jetQuery())
+}
+
+// aliasJets aliases the columns of a jets fixture like jetQueryAliased
+func aliasJets(query mimic.QueryResult) mimic.QueryResult {
+	cols := make([]string, len(query.Cols))
+	for i, c := range query.Cols {
+		cols[i] = "jets." + c
 	}
+	query.Query = &mimic.Query{Cols: cols, Vals: query.Vals}
+	return query
 }
 
 func pilotQuery() mimic.QueryResult {
+	vals := make([][]driver.Value, 5)
+	for i := range vals {
+		id := int64(i + 1)
+		vals[i] = []driver.Value{id, fmt.Sprintf("pilot-%d", id)}
+	}
+
 	return mimic.QueryResult{
-		Query: &mimic.Query{
-			Cols: []string{"id", "name"},
-			Vals: [][]driver.Value{
-				{
-					int64(1), "test",
-				},
-				{
-					int64(2), "test",
-				},
-				{
-					int64(3), "test",
-				},
-				{
-					int64(4), "test",
-				},
-				{
-					int64(5), "test",
-				},
-			},
-		},
+		Query: &mimic.Query{Cols: []string{"id", "name"}, Vals: vals},
 	}
 }
 
@@ -100,26 +100,38 @@ func languageQuery() mimic.QueryResult {
 	}
 }
 
+// jetQueryUpdate is the jet the adapters write, the first of jetQuery.
+// bob reads it back.
 func jetQueryUpdate() mimic.QueryResult {
+	return jetRows(1)
+}
+
+func jetQueryInsert() mimic.QueryResult {
 	return mimic.QueryResult{
 		Query: &mimic.Query{
-			Cols: []string{"id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"},
+			Cols: []string{"id"},
 			Vals: [][]driver.Value{
 				{
-					int64(1), int64(1), int64(1), "test", nil, "test", "test", []byte("test"), []byte("test"),
+					int64(1),
 				},
 			},
 		},
 	}
 }
 
-func jetQueryInsert() mimic.QueryResult {
+// jetExecReturning answers sqlboiler's insert either way: older templates
+// exec it, newer ones return the unset columns with defaults, which includes
+// the nullable color.
+func jetExecReturning() mimic.QueryResult {
 	return mimic.QueryResult{
+		Result: &mimic.Result{
+			NumRows: 1,
+		},
 		Query: &mimic.Query{
-			Cols: []string{"id"},
+			Cols: []string{"color"},
 			Vals: [][]driver.Value{
 				{
-					int64(1),
+					nil,
 				},
 			},
 		},
@@ -142,9 +154,44 @@ func jetExecUpdate() mimic.QueryResult {
 	}
 }
 
-var gormMimicDialector = postgres.New(postgres.Config{
-	DriverName: "mimic",
-})
+// expectJets converts the rows of a jets fixture to the jets that adapters
+// are expected to decode them into
+func expectJets(query mimic.QueryResult) []bench.Jet {
+	jets := make([]bench.Jet, len(query.Vals))
+	for i, row := range query.Vals {
+		var color null.String
+		if row[4] != nil {
+			color = null.StringFrom(row[4].(string))
+		}
+
+		jets[i] = bench.Jet{
+			ID:         row[0].(int64),
+			PilotID:    row[1].(int64),
+			AirportID:  row[2].(int64),
+			Name:       row[3].(string),
+			Color:      color,
+			UUID:       row[5].(string),
+			Identifier: row[6].(string),
+			Cargo:      row[7].([]byte),
+			Manifest:   row[8].([]byte),
+		}
+	}
+	return jets
+}
+
+// expectPilots attaches the pilots of a pilots fixture to jets by pilot_id
+func expectPilots(jets []bench.Jet, query mimic.QueryResult) []bench.Jet {
+	for _, row := range query.Vals {
+		pilot := bench.Pilot{ID: row[0].(int64), Name: row[1].(string)}
+		for i := range jets {
+			if jets[i].PilotID == pilot.ID {
+				p := pilot
+				jets[i].Pilot = &p
+			}
+		}
+	}
+	return jets
+}
 
 func TestMain(m *testing.M) {
 	dialects.RegisterDriver("mimic", &mimic.XormDriver{})
@@ -152,6 +199,14 @@ func TestMain(m *testing.M) {
 		panic("failed to register xorm driver")
 	}
 
+	if name := os.Getenv(coldEnv); len(name) != 0 {
+		if err := coldChild(name); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	code := m.Run()
 	os.Exit(code)
 }
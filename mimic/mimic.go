@@ -6,12 +6,19 @@ import (
 	"errors"
 	"io"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 
+	"xorm.io/xorm/core"
 	"xorm.io/xorm/dialects"
 	"xorm.io/xorm/schemas"
 )
 
-var dsns = map[string]QueryResult{}
+// dsnMut guards dsns and counter, connections are opened by whichever
+// goroutine needs one when operations run in parallel
+var dsnMut sync.RWMutex
+var dsns = map[string][]QueryResult{}
 var counter = 0
 
 type XormDriver struct{}
@@ -20,10 +27,28 @@ func (x *XormDriver) Parse(string, string) (*dialects.URI, error) {
 	return &dialects.URI{DBType: schemas.POSTGRES}, nil
 }
 
+func (x *XormDriver) Features() *dialects.DriverFeatures {
+	return dialects.QueryDriver("postgres").Features()
+}
+
+func (x *XormDriver) GenScanResult(colType string) (interface{}, error) {
+	return dialects.QueryDriver("postgres").GenScanResult(colType)
+}
+
+func (x *XormDriver) Scan(ctx *dialects.ScanContext, rows *core.Rows, types []*sql.ColumnType, v ...interface{}) error {
+	return dialects.QueryDriver("postgres").Scan(ctx, rows, types, v...)
+}
+
 type QueryResult struct {
 	*Result
 	*Query
 	NumInput int
+
+	// Match, when set, makes the result answer the statements containing
+	// it. Results are handed out in order otherwise, which falls apart
+	// when the statements of concurrent operations are spread over many
+	// connections.
+	Match string
 }
 
 type Result struct {
@@ -40,51 +65,89 @@ type mimic struct {
 
 func (m *mimic) Open(dsn string) (driver.Conn, error) {
 	if len(dsn) == 0 {
+		dsnMut.Lock()
 		dsn = strconv.Itoa(counter)
 		counter++
+		dsnMut.Unlock()
 	}
-	return &mimicConn{dsns[dsn]}, nil
+
+	dsnMut.RLock()
+	defer dsnMut.RUnlock()
+	return &mimicConn{Q: dsns[dsn], dsn: dsn}, nil
 }
 
 type mimicConn struct {
-	Q QueryResult
+	Q    []QueryResult
+	next int
+	dsn  string
 }
 
 func (m *mimicConn) Commit() error {
+	record(m.dsn, "COMMIT", nil)
 	return nil
 }
 
 func (m *mimicConn) Rollback() error {
+	record(m.dsn, "ROLLBACK", nil)
 	return nil
 }
 
 func (m *mimicConn) Prepare(query string) (driver.Stmt, error) {
-	return &mimicStmt{m.Q}, nil
+	stmt := &mimicStmt{dsn: m.dsn, query: query}
+	if len(m.Q) == 0 {
+		return stmt, nil
+	}
+
+	for _, q := range m.Q {
+		if len(q.Match) != 0 && strings.Contains(query, q.Match) {
+			stmt.answer(q)
+			return stmt, nil
+		}
+	}
+
+	stmt.answer(m.Q[m.next%len(m.Q)])
+	m.next++
+	return stmt, nil
 }
 
-func (m *mimicConn) Close() error              { return nil }
-func (m *mimicConn) Begin() (driver.Tx, error) { return m, nil }
+func (m *mimicConn) Close() error { return nil }
+func (m *mimicConn) Begin() (driver.Tx, error) {
+	record(m.dsn, "BEGIN", nil)
+	return m, nil
+}
 
+// mimicStmt keeps the parts of its QueryResult that answer it rather than
+// all of it, every statement allocates one and a Match has no use here
 type mimicStmt struct {
-	Q QueryResult
+	result   *Result
+	rows     *Query
+	numInput int
+	dsn      string
+	query    string
+}
+
+func (m *mimicStmt) answer(q QueryResult) {
+	m.result, m.rows, m.numInput = q.Result, q.Query, q.NumInput
 }
 
 func (m *mimicStmt) Close() error  { return nil }
-func (m *mimicStmt) NumInput() int { return m.Q.NumInput }
+func (m *mimicStmt) NumInput() int { return m.numInput }
 func (m *mimicStmt) Exec(args []driver.Value) (driver.Result, error) {
-	if m.Q.Result == nil {
+	record(m.dsn, m.query, args)
+	if m.result == nil {
 		return nil, errors.New("statement was not a result type")
 	}
 
-	return &mimicResult{m.Q.Result.NumRows}, nil
+	return &mimicResult{m.result.NumRows}, nil
 }
 
 func (m *mimicStmt) Query(args []driver.Value) (driver.Rows, error) {
-	if m.Q.Query == nil {
+	record(m.dsn, m.query, args)
+	if m.rows == nil {
 		return nil, errors.New("statement was not a query type")
 	}
 
-	return &mimicRows{columns: m.Q.Query.Cols, values: m.Q.Query.Vals}, nil
+	return &mimicRows{columns: m.rows.Cols, values: m.rows.Vals}, nil
 }
 
 type mimicResult struct {
@@ -120,22 +183,103 @@ func (m *mimicRows) Next(dest []driver.Value) error {
 	return nil
 }
 
+// Statement is a statement run against mimic. Transaction boundaries are
+// logged as BEGIN, COMMIT and ROLLBACK statements without args.
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+var logMut sync.Mutex
+var logs = map[string][]Statement{}
+
+// logging is the number of dsns being recorded, so that statements aren't
+// serialized on logMut when none are
+var logging atomic.Int32
+
+// StartLog starts recording the statements run against dsn
+func StartLog(dsn string) {
+	logMut.Lock()
+	if _, ok := logs[dsn]; !ok {
+		logging.Add(1)
+	}
+	logs[dsn] = []Statement{}
+	logMut.Unlock()
+}
+
+// StopLog stops recording dsn and returns the statements run since StartLog
+func StopLog(dsn string) []Statement {
+	logMut.Lock()
+	defer logMut.Unlock()
+
+	log, ok := logs[dsn]
+	if ok {
+		logging.Add(-1)
+		delete(logs, dsn)
+	}
+	return log
+}
+
+func record(dsn, query string, args []driver.Value) {
+	if logging.Load() == 0 {
+		return
+	}
+
+	logMut.Lock()
+	defer logMut.Unlock()
+
+	log, ok := logs[dsn]
+	if !ok {
+		return
+	}
+
+	logs[dsn] = append(log, Statement{
+		Query: query,
+		Args:  append([]driver.Value(nil), args...),
+	})
+}
+
 func init() {
 	sql.Register("mimic", &mimic{})
 }
 
 func NewResult(q QueryResult) {
-	dsns[strconv.Itoa(counter)] = q
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
+	dsns[strconv.Itoa(counter)] = []QueryResult{q}
 }
 
 func NewQuery(q QueryResult) {
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
+	dsns[strconv.Itoa(counter)] = []QueryResult{q}
+}
+
+// NewSequence registers results that are handed out one per statement in
+// the order given, starting over once the last one has been used. It is
+// used for operations that issue more than one statement, like eager loading.
+// Results with a Match are picked by it instead, see QueryResult.
+func NewSequence(q ...QueryResult) {
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
 	dsns[strconv.Itoa(counter)] = q
 }
 
 func NewResultDSN(dsn string, q QueryResult) {
-	dsns[dsn] = q
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
+	dsns[dsn] = []QueryResult{q}
 }
 
 func NewQueryDSN(dsn string, q QueryResult) {
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
+	dsns[dsn] = []QueryResult{q}
+}
+
+// NewSequenceDSN is like NewSequence but for a specific dsn.
+func NewSequenceDSN(dsn string, q ...QueryResult) {
+	dsnMut.Lock()
+	defer dsnMut.Unlock()
 	dsns[dsn] = q
 }
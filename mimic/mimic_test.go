@@ -3,6 +3,7 @@ package mimic
 import (
 	"database/sql"
 	"database/sql/driver"
+	"sync"
 	"testing"
 )
 
@@ -46,3 +47,119 @@ func TestIt(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSequence(t *testing.T) {
+	NewSequence(
+		QueryResult{
+			Query: &Query{
+				Cols: []string{"id"},
+				Vals: [][]driver.Value{{int64(1)}},
+			},
+		},
+		QueryResult{
+			Result: &Result{NumRows: 5},
+		},
+	)
+
+	db, err := sql.Open("mimic", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		var id int
+		if err = db.QueryRow("SELECT").Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		if id != 1 {
+			t.Error("wrong id:", id)
+		}
+
+		res, err := db.Exec("DELETE")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n, _ := res.RowsAffected(); n != 5 {
+			t.Error("wrong rows affected:", n)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	NewSequenceDSN("log", QueryResult{Result: &Result{NumRows: 1}, NumInput: -1})
+
+	db, err := sql.Open("mimic", "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec("NOT LOGGED"); err != nil {
+		t.Fatal(err)
+	}
+
+	StartLog("log")
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.Exec("DELETE", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	log := StopLog("log")
+	if len(log) != 3 {
+		t.Fatalf("want 3 statements, got %d: %v", len(log), log)
+	}
+	if log[0].Query != "BEGIN" || log[2].Query != "COMMIT" {
+		t.Error("wrong transaction boundaries:", log)
+	}
+	if log[1].Query != "DELETE" || len(log[1].Args) != 1 || log[1].Args[0] != int64(5) {
+		t.Error("wrong statement:", log[1])
+	}
+
+	if _, err = db.Exec("NOT LOGGED"); err != nil {
+		t.Fatal(err)
+	}
+	if log := StopLog("log"); len(log) != 0 {
+		t.Error("statements were logged after StopLog:", log)
+	}
+}
+
+func TestMatchParallel(t *testing.T) {
+	NewSequenceDSN("match",
+		QueryResult{Query: &Query{Cols: []string{"id"}, Vals: [][]driver.Value{{int64(1)}}}, Match: "jets"},
+		QueryResult{Query: &Query{Cols: []string{"id"}, Vals: [][]driver.Value{{int64(2)}}}, Match: "pilots"},
+	)
+
+	db, err := sql.Open("mimic", "match")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Alternating statements over many connections would get the results
+	// of each other if they were handed out in order
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				for query, want := range map[string]int{"SELECT jets": 1, "SELECT pilots": 2} {
+					var id int
+					if err := db.QueryRow(query).Scan(&id); err != nil {
+						t.Error(err)
+						return
+					}
+					if id != want {
+						t.Errorf("%s: want %d, got %d", query, want, id)
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
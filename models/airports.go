@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,13 +13,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // Airport is an object representing the database table.
@@ -39,6 +39,14 @@ var AirportColumns = struct {
 	Size: "size",
 }
 
+var AirportTableColumns = struct {
+	ID   string
+	Size string
+}{
+	ID:   "airports.id",
+	Size: "airports.size",
+}
+
 // Generated where
 
 type whereHelperint struct{ field string }
@@ -72,8 +80,6 @@ func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
 func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
@@ -86,6 +92,23 @@ func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
 func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AirportWhere = struct {
 	ID   whereHelperint
@@ -112,19 +135,36 @@ func (*airportR) NewStruct() *airportR {
 	return &airportR{}
 }
 
+func (o *Airport) GetJets() JetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetJets()
+}
+
+func (r *airportR) GetJets() JetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Jets
+}
+
 // airportL is where Load methods for each relationship are stored.
 type airportL struct{}
 
 var (
 	airportAllColumns            = []string{"id", "size"}
-	airportColumnsWithoutDefault = []string{"size"}
-	airportColumnsWithDefault    = []string{"id"}
+	airportColumnsWithoutDefault = []string{}
+	airportColumnsWithDefault    = []string{"id", "size"}
 	airportPrimaryKeyColumns     = []string{"id"}
+	airportGeneratedColumns      = []string{}
 )
 
 type (
 	// AirportSlice is an alias for a slice of pointers to Airport.
-	// This should generally be used opposed to []Airport.
+	// This should almost always be used instead of []Airport.
 	AirportSlice []*Airport
 	// AirportHook is the signature for custom Airport hook methods
 	AirportHook func(context.Context, boil.ContextExecutor, *Airport) error
@@ -155,24 +195,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var airportBeforeInsertHooks []AirportHook
-var airportBeforeUpdateHooks []AirportHook
-var airportBeforeDeleteHooks []AirportHook
-var airportBeforeUpsertHooks []AirportHook
+var airportAfterSelectMu sync.Mutex
+var airportAfterSelectHooks []AirportHook
 
+var airportBeforeInsertMu sync.Mutex
+var airportBeforeInsertHooks []AirportHook
+var airportAfterInsertMu sync.Mutex
 var airportAfterInsertHooks []AirportHook
-var airportAfterSelectHooks []AirportHook
+
+var airportBeforeUpdateMu sync.Mutex
+var airportBeforeUpdateHooks []AirportHook
+var airportAfterUpdateMu sync.Mutex
 var airportAfterUpdateHooks []AirportHook
+
+var airportBeforeDeleteMu sync.Mutex
+var airportBeforeDeleteHooks []AirportHook
+var airportAfterDeleteMu sync.Mutex
 var airportAfterDeleteHooks []AirportHook
+
+var airportBeforeUpsertMu sync.Mutex
+var airportBeforeUpsertHooks []AirportHook
+var airportAfterUpsertMu sync.Mutex
 var airportAfterUpsertHooks []AirportHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *Airport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Airport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportBeforeInsertHooks {
+	for _, hook := range airportAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -181,13 +233,13 @@ func (o *Airport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *Airport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Airport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportBeforeUpdateHooks {
+	for _, hook := range airportBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -196,13 +248,13 @@ func (o *Airport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *Airport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Airport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportBeforeDeleteHooks {
+	for _, hook := range airportAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -211,13 +263,13 @@ func (o *Airport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Airport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Airport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportBeforeUpsertHooks {
+	for _, hook := range airportBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -226,13 +278,13 @@ func (o *Airport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Airport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Airport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportAfterInsertHooks {
+	for _, hook := range airportAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -241,13 +293,13 @@ func (o *Airport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Airport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Airport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportAfterSelectHooks {
+	for _, hook := range airportBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -256,13 +308,13 @@ func (o *Airport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Airport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Airport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportAfterUpdateHooks {
+	for _, hook := range airportAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -271,13 +323,13 @@ func (o *Airport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Airport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Airport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range airportAfterDeleteHooks {
+	for _, hook := range airportBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -304,24 +356,42 @@ func (o *Airport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecu
 // AddAirportHook registers your hook function for all future operations.
 func AddAirportHook(hookPoint boil.HookPoint, airportHook AirportHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		airportAfterSelectMu.Lock()
+		airportAfterSelectHooks = append(airportAfterSelectHooks, airportHook)
+		airportAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		airportBeforeInsertMu.Lock()
 		airportBeforeInsertHooks = append(airportBeforeInsertHooks, airportHook)
-	case boil.BeforeUpdateHook:
-		airportBeforeUpdateHooks = append(airportBeforeUpdateHooks, airportHook)
-	case boil.BeforeDeleteHook:
-		airportBeforeDeleteHooks = append(airportBeforeDeleteHooks, airportHook)
-	case boil.BeforeUpsertHook:
-		airportBeforeUpsertHooks = append(airportBeforeUpsertHooks, airportHook)
+		airportBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		airportAfterInsertMu.Lock()
 		airportAfterInsertHooks = append(airportAfterInsertHooks, airportHook)
-	case boil.AfterSelectHook:
-		airportAfterSelectHooks = append(airportAfterSelectHooks, airportHook)
+		airportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		airportBeforeUpdateMu.Lock()
+		airportBeforeUpdateHooks = append(airportBeforeUpdateHooks, airportHook)
+		airportBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		airportAfterUpdateMu.Lock()
 		airportAfterUpdateHooks = append(airportAfterUpdateHooks, airportHook)
+		airportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		airportBeforeDeleteMu.Lock()
+		airportBeforeDeleteHooks = append(airportBeforeDeleteHooks, airportHook)
+		airportBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		airportAfterDeleteMu.Lock()
 		airportAfterDeleteHooks = append(airportAfterDeleteHooks, airportHook)
+		airportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		airportBeforeUpsertMu.Lock()
+		airportBeforeUpsertHooks = append(airportBeforeUpsertHooks, airportHook)
+		airportBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		airportAfterUpsertMu.Lock()
 		airportAfterUpsertHooks = append(airportAfterUpsertHooks, airportHook)
+		airportAfterUpsertMu.Unlock()
 	}
 }
 
@@ -333,7 +403,7 @@ func (q airportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Airp
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for airports")
@@ -408,14 +478,7 @@ func (o *Airport) Jets(mods ...qm.QueryMod) jetQuery {
 		qm.Where("\"jets\".\"airport_id\"=?", o.ID),
 	)
 
-	query := Jets(queryMods...)
-	queries.SetFrom(query.Query, "\"jets\"")
-
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"jets\".*"})
-	}
-
-	return query
+	return Jets(queryMods...)
 }
 
 // LoadJets allows an eager lookup of values, cached into the
@@ -425,31 +488,39 @@ func (airportL) LoadJets(ctx context.Context, e boil.ContextExecutor, singular b
 	var object *Airport
 
 	if singular {
-		object = maybeAirport.(*Airport)
+		var ok bool
+		object, ok = maybeAirport.(*Airport)
+		if !ok {
+			object = new(Airport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAirport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAirport))
+			}
+		}
 	} else {
-		slice = *maybeAirport.(*[]*Airport)
+		s, ok := maybeAirport.(*[]*Airport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAirport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAirport))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &airportR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &airportR{}
 			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -457,9 +528,16 @@ func (airportL) LoadJets(ctx context.Context, e boil.ContextExecutor, singular b
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`jets`),
-		qm.WhereIn(`jets.airport_id in ?`, args...),
+		qm.WhereIn(`jets.airport_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -572,7 +650,12 @@ func (o *Airport) AddJets(ctx context.Context, exec boil.ContextExecutor, insert
 // Airports retrieves all the records using an executor.
 func Airports(mods ...qm.QueryMod) airportQuery {
 	mods = append(mods, qm.From("\"airports\""))
-	return airportQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"airports\".*"})
+	}
+
+	return airportQuery{q}
 }
 
 // FindAirport retrieves a single record by ID with an executor.
@@ -592,12 +675,16 @@ func FindAirport(ctx context.Context, exec boil.ContextExecutor, iD int, selectC
 
 	err := q.Bind(ctx, exec, airportObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from airports")
 	}
 
+	if err = airportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return airportObj, err
+	}
+
 	return airportObj, nil
 }
 
@@ -810,7 +897,7 @@ func (o AirportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Airport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Airport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no airports provided for upsert")
 	}
@@ -856,12 +943,13 @@ func (o *Airport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			airportAllColumns,
 			airportColumnsWithDefault,
 			airportColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			airportAllColumns,
 			airportPrimaryKeyColumns,
@@ -871,12 +959,18 @@ func (o *Airport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 			return errors.New("models: unable to upsert airports, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(airportAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(airportPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert airports, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(airportPrimaryKeyColumns))
 			copy(conflict, airportPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"airports\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"airports\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(airportType, airportMapping, insert)
 		if err != nil {
@@ -904,7 +998,7 @@ func (o *Airport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -1089,3 +1183,8 @@ func AirportExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool
 
 	return exists, nil
 }
+
+// Exists checks if the Airport row exists.
+func (o *Airport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AirportExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,23 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+// Bind the rows of a query into jets with queries.Bind
+func (a *Adapter) Bind(ctx context.Context) error {
+	rows, err := a.db.QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var store []*Jet
+	err = queries.Bind(rows, &store)
+	a.jets = store
+	return err
+}
@@ -0,0 +1,70 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/strmangle"
+)
+
+// BuildSelectComplex builds the query of SelectComplex
+func (a *Adapter) BuildSelectComplex(context.Context) (string, []interface{}, error) {
+	q := Jets(
+		qm.Select("id, name, color, uuid, identifier, cargo, manifest"),
+		qm.Where("id > ?", 1),
+		qm.And("name <> ?", "thing"),
+		qm.Limit(1),
+		qm.GroupBy("id"),
+		qm.Offset(1),
+	)
+	sql, args := queries.BuildQuery(q.Query)
+	return sql, args, nil
+}
+
+// BuildInsert builds the insert of the jet the way the generated Insert does
+// before it has cached the statement for its column set
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	nzDefaults := queries.NonZeroDefaultSet(jetColumnsWithDefault, &a.jet)
+	wl, returnColumns := boil.Infer().InsertColumnSet(
+		jetAllColumns,
+		jetColumnsWithDefault,
+		jetColumnsWithoutDefault,
+		nzDefaults,
+	)
+
+	valueMapping, err := queries.BindMapping(jetType, jetMapping, wl)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sql := fmt.Sprintf("INSERT INTO \"jets\" (\"%s\") VALUES (%s)", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+	if len(returnColumns) != 0 {
+		sql += fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+	}
+	return sql, queries.ValuesFromMapping(reflect.ValueOf(&a.jet).Elem(), valueMapping), nil
+}
+
+// BuildUpdate builds the update of every column of the jet the way
+// UpdateAll does, the generated Update builds its statement as it runs
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	q := Jets(qm.Where("id = ?", a.jet.ID))
+	queries.SetUpdate(q.Query, M{
+		JetColumns.PilotID:    a.jet.PilotID,
+		JetColumns.AirportID:  a.jet.AirportID,
+		JetColumns.Name:       a.jet.Name,
+		JetColumns.Color:      a.jet.Color,
+		JetColumns.UUID:       a.jet.UUID,
+		JetColumns.Identifier: a.jet.Identifier,
+		JetColumns.Cargo:      a.jet.Cargo,
+		JetColumns.Manifest:   a.jet.Manifest,
+	})
+	sql, args := queries.BuildQuery(q.Query)
+	return sql, args, nil
+}
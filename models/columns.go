@@ -0,0 +1,48 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// InsertColumns inserts a jet, writing the columns of cols
+func (a *Adapter) InsertColumns(ctx context.Context, cols bench.Columns) error {
+	return a.jet.Insert(ctx, a.db, columns(cols))
+}
+
+// UpdateColumns updates a jet, writing the columns of cols
+func (a *Adapter) UpdateColumns(ctx context.Context, cols bench.Columns) error {
+	_, err := a.jet.Update(ctx, a.db, columns(cols))
+	return err
+}
+
+// columns returns the boil.Columns of the same kind as cols
+func columns(cols bench.Columns) boil.Columns {
+	switch cols.Kind {
+	case bench.Whitelist:
+		return boil.Whitelist(cols.Cols...)
+	case bench.Blacklist:
+		return boil.Blacklist(cols.Cols...)
+	case bench.Greylist:
+		return boil.Greylist(cols.Cols...)
+	}
+	return boil.Infer()
+}
+
+// ResetJetCaches empties the caches of the statements Insert, Update and
+// Upsert build for every set of columns, so that the next of each misses
+func ResetJetCaches() {
+	jetInsertCacheMut.Lock()
+	jetInsertCache = make(map[string]insertCache)
+	jetInsertCacheMut.Unlock()
+	jetUpdateCacheMut.Lock()
+	jetUpdateCache = make(map[string]updateCache)
+	jetUpdateCacheMut.Unlock()
+	jetUpsertCacheMut.Lock()
+	jetUpsertCache = make(map[string]insertCache)
+	jetUpsertCacheMut.Unlock()
+}
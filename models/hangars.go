@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,12 +13,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // Hangar is an object representing the database table.
@@ -38,31 +38,16 @@ var HangarColumns = struct {
 	Name: "name",
 }
 
-// Generated where
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+var HangarTableColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "hangars.id",
+	Name: "hangars.name",
 }
 
+// Generated where
+
 var HangarWhere = struct {
 	ID   whereHelperint
 	Name whereHelperstring
@@ -92,11 +77,12 @@ var (
 	hangarColumnsWithoutDefault = []string{"name"}
 	hangarColumnsWithDefault    = []string{"id"}
 	hangarPrimaryKeyColumns     = []string{"id"}
+	hangarGeneratedColumns      = []string{}
 )
 
 type (
 	// HangarSlice is an alias for a slice of pointers to Hangar.
-	// This should generally be used opposed to []Hangar.
+	// This should almost always be used instead of []Hangar.
 	HangarSlice []*Hangar
 	// HangarHook is the signature for custom Hangar hook methods
 	HangarHook func(context.Context, boil.ContextExecutor, *Hangar) error
@@ -127,24 +113,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var hangarBeforeInsertHooks []HangarHook
-var hangarBeforeUpdateHooks []HangarHook
-var hangarBeforeDeleteHooks []HangarHook
-var hangarBeforeUpsertHooks []HangarHook
+var hangarAfterSelectMu sync.Mutex
+var hangarAfterSelectHooks []HangarHook
 
+var hangarBeforeInsertMu sync.Mutex
+var hangarBeforeInsertHooks []HangarHook
+var hangarAfterInsertMu sync.Mutex
 var hangarAfterInsertHooks []HangarHook
-var hangarAfterSelectHooks []HangarHook
+
+var hangarBeforeUpdateMu sync.Mutex
+var hangarBeforeUpdateHooks []HangarHook
+var hangarAfterUpdateMu sync.Mutex
 var hangarAfterUpdateHooks []HangarHook
+
+var hangarBeforeDeleteMu sync.Mutex
+var hangarBeforeDeleteHooks []HangarHook
+var hangarAfterDeleteMu sync.Mutex
 var hangarAfterDeleteHooks []HangarHook
+
+var hangarBeforeUpsertMu sync.Mutex
+var hangarBeforeUpsertHooks []HangarHook
+var hangarAfterUpsertMu sync.Mutex
 var hangarAfterUpsertHooks []HangarHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *Hangar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Hangar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarBeforeInsertHooks {
+	for _, hook := range hangarAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -153,13 +151,13 @@ func (o *Hangar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *Hangar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Hangar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarBeforeUpdateHooks {
+	for _, hook := range hangarBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -168,13 +166,13 @@ func (o *Hangar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *Hangar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Hangar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarBeforeDeleteHooks {
+	for _, hook := range hangarAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -183,13 +181,13 @@ func (o *Hangar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Hangar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Hangar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarBeforeUpsertHooks {
+	for _, hook := range hangarBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -198,13 +196,13 @@ func (o *Hangar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Hangar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Hangar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarAfterInsertHooks {
+	for _, hook := range hangarAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -213,13 +211,13 @@ func (o *Hangar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Hangar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Hangar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarAfterSelectHooks {
+	for _, hook := range hangarBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -228,13 +226,13 @@ func (o *Hangar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Hangar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Hangar) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarAfterUpdateHooks {
+	for _, hook := range hangarAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -243,13 +241,13 @@ func (o *Hangar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Hangar) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Hangar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range hangarAfterDeleteHooks {
+	for _, hook := range hangarBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -276,24 +274,42 @@ func (o *Hangar) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecut
 // AddHangarHook registers your hook function for all future operations.
 func AddHangarHook(hookPoint boil.HookPoint, hangarHook HangarHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		hangarAfterSelectMu.Lock()
+		hangarAfterSelectHooks = append(hangarAfterSelectHooks, hangarHook)
+		hangarAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		hangarBeforeInsertMu.Lock()
 		hangarBeforeInsertHooks = append(hangarBeforeInsertHooks, hangarHook)
-	case boil.BeforeUpdateHook:
-		hangarBeforeUpdateHooks = append(hangarBeforeUpdateHooks, hangarHook)
-	case boil.BeforeDeleteHook:
-		hangarBeforeDeleteHooks = append(hangarBeforeDeleteHooks, hangarHook)
-	case boil.BeforeUpsertHook:
-		hangarBeforeUpsertHooks = append(hangarBeforeUpsertHooks, hangarHook)
+		hangarBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		hangarAfterInsertMu.Lock()
 		hangarAfterInsertHooks = append(hangarAfterInsertHooks, hangarHook)
-	case boil.AfterSelectHook:
-		hangarAfterSelectHooks = append(hangarAfterSelectHooks, hangarHook)
+		hangarAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		hangarBeforeUpdateMu.Lock()
+		hangarBeforeUpdateHooks = append(hangarBeforeUpdateHooks, hangarHook)
+		hangarBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		hangarAfterUpdateMu.Lock()
 		hangarAfterUpdateHooks = append(hangarAfterUpdateHooks, hangarHook)
+		hangarAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		hangarBeforeDeleteMu.Lock()
+		hangarBeforeDeleteHooks = append(hangarBeforeDeleteHooks, hangarHook)
+		hangarBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		hangarAfterDeleteMu.Lock()
 		hangarAfterDeleteHooks = append(hangarAfterDeleteHooks, hangarHook)
+		hangarAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		hangarBeforeUpsertMu.Lock()
+		hangarBeforeUpsertHooks = append(hangarBeforeUpsertHooks, hangarHook)
+		hangarBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		hangarAfterUpsertMu.Lock()
 		hangarAfterUpsertHooks = append(hangarAfterUpsertHooks, hangarHook)
+		hangarAfterUpsertMu.Unlock()
 	}
 }
 
@@ -305,7 +321,7 @@ func (q hangarQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Hanga
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for hangars")
@@ -372,7 +388,12 @@ func (q hangarQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (boo
 // Hangars retrieves all the records using an executor.
 func Hangars(mods ...qm.QueryMod) hangarQuery {
 	mods = append(mods, qm.From("\"hangars\""))
-	return hangarQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"hangars\".*"})
+	}
+
+	return hangarQuery{q}
 }
 
 // FindHangar retrieves a single record by ID with an executor.
@@ -392,12 +413,16 @@ func FindHangar(ctx context.Context, exec boil.ContextExecutor, iD int, selectCo
 
 	err := q.Bind(ctx, exec, hangarObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from hangars")
 	}
 
+	if err = hangarObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return hangarObj, err
+	}
+
 	return hangarObj, nil
 }
 
@@ -610,7 +635,7 @@ func (o HangarSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, c
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no hangars provided for upsert")
 	}
@@ -656,12 +681,13 @@ func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			hangarAllColumns,
 			hangarColumnsWithDefault,
 			hangarColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			hangarAllColumns,
 			hangarPrimaryKeyColumns,
@@ -671,12 +697,18 @@ func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 			return errors.New("models: unable to upsert hangars, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(hangarAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(hangarPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert hangars, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(hangarPrimaryKeyColumns))
 			copy(conflict, hangarPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"hangars\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"hangars\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(hangarType, hangarMapping, insert)
 		if err != nil {
@@ -704,7 +736,7 @@ func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -889,3 +921,8 @@ func HangarExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool,
 
 	return exists, nil
 }
+
+// Exists checks if the Hangar row exists.
+func (o *Hangar) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return HangarExists(ctx, exec, o.ID)
+}
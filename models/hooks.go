@@ -0,0 +1,35 @@
+package models
+
+// This file is not generated, see adapter.go
+
+// ResetJetHooks removes every hook AddJetHook registered, which sqlboiler
+// has no way to do since hooks are meant to be registered once at init
+func ResetJetHooks() {
+	jetAfterSelectMu.Lock()
+	jetAfterSelectHooks = nil
+	jetAfterSelectMu.Unlock()
+	jetBeforeInsertMu.Lock()
+	jetBeforeInsertHooks = nil
+	jetBeforeInsertMu.Unlock()
+	jetAfterInsertMu.Lock()
+	jetAfterInsertHooks = nil
+	jetAfterInsertMu.Unlock()
+	jetBeforeUpdateMu.Lock()
+	jetBeforeUpdateHooks = nil
+	jetBeforeUpdateMu.Unlock()
+	jetAfterUpdateMu.Lock()
+	jetAfterUpdateHooks = nil
+	jetAfterUpdateMu.Unlock()
+	jetBeforeDeleteMu.Lock()
+	jetBeforeDeleteHooks = nil
+	jetBeforeDeleteMu.Unlock()
+	jetAfterDeleteMu.Lock()
+	jetAfterDeleteHooks = nil
+	jetAfterDeleteMu.Unlock()
+	jetBeforeUpsertMu.Lock()
+	jetBeforeUpsertHooks = nil
+	jetBeforeUpsertMu.Unlock()
+	jetAfterUpsertMu.Lock()
+	jetAfterUpsertHooks = nil
+	jetAfterUpsertMu.Unlock()
+}
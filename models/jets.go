@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,13 +13,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // Jet is an object representing the database table.
@@ -60,6 +60,28 @@ var JetColumns = struct {
 	Manifest:   "manifest",
 }
 
+var JetTableColumns = struct {
+	ID         string
+	PilotID    string
+	AirportID  string
+	Name       string
+	Color      string
+	UUID       string
+	Identifier string
+	Cargo      string
+	Manifest   string
+}{
+	ID:         "jets.id",
+	PilotID:    "jets.pilot_id",
+	AirportID:  "jets.airport_id",
+	Name:       "jets.name",
+	Color:      "jets.color",
+	UUID:       "jets.uuid",
+	Identifier: "jets.identifier",
+	Cargo:      "jets.cargo",
+	Manifest:   "jets.manifest",
+}
+
 // Generated where
 
 type whereHelpernull_String struct{ field string }
@@ -70,8 +92,6 @@ func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
 func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
@@ -84,6 +104,41 @@ func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
 func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 type whereHelper__byte struct{ field string }
 
@@ -120,15 +175,18 @@ var JetWhere = struct {
 var JetRels = struct {
 	Airport string
 	Pilot   string
+	Flights string
 }{
 	Airport: "Airport",
 	Pilot:   "Pilot",
+	Flights: "Flights",
 }
 
 // jetR is where relationships are stored.
 type jetR struct {
-	Airport *Airport `db:"Airport" boil:"Airport" json:"Airport" toml:"Airport" yaml:"Airport"`
-	Pilot   *Pilot   `db:"Pilot" boil:"Pilot" json:"Pilot" toml:"Pilot" yaml:"Pilot"`
+	Airport *Airport    `db:"Airport" boil:"Airport" json:"Airport" toml:"Airport" yaml:"Airport"`
+	Pilot   *Pilot      `db:"Pilot" boil:"Pilot" json:"Pilot" toml:"Pilot" yaml:"Pilot"`
+	Flights FlightSlice `db:"Flights" boil:"Flights" json:"Flights" toml:"Flights" yaml:"Flights"`
 }
 
 // NewStruct creates a new relationship struct
@@ -136,19 +194,68 @@ func (*jetR) NewStruct() *jetR {
 	return &jetR{}
 }
 
+func (o *Jet) GetAirport() *Airport {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAirport()
+}
+
+func (r *jetR) GetAirport() *Airport {
+	if r == nil {
+		return nil
+	}
+
+	return r.Airport
+}
+
+func (o *Jet) GetPilot() *Pilot {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPilot()
+}
+
+func (r *jetR) GetPilot() *Pilot {
+	if r == nil {
+		return nil
+	}
+
+	return r.Pilot
+}
+
+func (o *Jet) GetFlights() FlightSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetFlights()
+}
+
+func (r *jetR) GetFlights() FlightSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Flights
+}
+
 // jetL is where Load methods for each relationship are stored.
 type jetL struct{}
 
 var (
 	jetAllColumns            = []string{"id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"}
-	jetColumnsWithoutDefault = []string{"pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest"}
-	jetColumnsWithDefault    = []string{"id"}
+	jetColumnsWithoutDefault = []string{"pilot_id", "airport_id", "name", "uuid", "identifier", "cargo", "manifest"}
+	jetColumnsWithDefault    = []string{"id", "color"}
 	jetPrimaryKeyColumns     = []string{"id"}
+	jetGeneratedColumns      = []string{}
 )
 
 type (
 	// JetSlice is an alias for a slice of pointers to Jet.
-	// This should generally be used opposed to []Jet.
+	// This should almost always be used instead of []Jet.
 	JetSlice []*Jet
 	// JetHook is the signature for custom Jet hook methods
 	JetHook func(context.Context, boil.ContextExecutor, *Jet) error
@@ -179,24 +286,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var jetBeforeInsertHooks []JetHook
-var jetBeforeUpdateHooks []JetHook
-var jetBeforeDeleteHooks []JetHook
-var jetBeforeUpsertHooks []JetHook
+var jetAfterSelectMu sync.Mutex
+var jetAfterSelectHooks []JetHook
 
+var jetBeforeInsertMu sync.Mutex
+var jetBeforeInsertHooks []JetHook
+var jetAfterInsertMu sync.Mutex
 var jetAfterInsertHooks []JetHook
-var jetAfterSelectHooks []JetHook
+
+var jetBeforeUpdateMu sync.Mutex
+var jetBeforeUpdateHooks []JetHook
+var jetAfterUpdateMu sync.Mutex
 var jetAfterUpdateHooks []JetHook
+
+var jetBeforeDeleteMu sync.Mutex
+var jetBeforeDeleteHooks []JetHook
+var jetAfterDeleteMu sync.Mutex
 var jetAfterDeleteHooks []JetHook
+
+var jetBeforeUpsertMu sync.Mutex
+var jetBeforeUpsertHooks []JetHook
+var jetAfterUpsertMu sync.Mutex
 var jetAfterUpsertHooks []JetHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *Jet) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Jet) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetBeforeInsertHooks {
+	for _, hook := range jetAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -205,13 +324,13 @@ func (o *Jet) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *Jet) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Jet) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetBeforeUpdateHooks {
+	for _, hook := range jetBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -220,13 +339,13 @@ func (o *Jet) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *Jet) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Jet) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetBeforeDeleteHooks {
+	for _, hook := range jetAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -235,13 +354,13 @@ func (o *Jet) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Jet) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Jet) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetBeforeUpsertHooks {
+	for _, hook := range jetBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -250,13 +369,13 @@ func (o *Jet) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Jet) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Jet) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetAfterInsertHooks {
+	for _, hook := range jetAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -265,13 +384,13 @@ func (o *Jet) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor)
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Jet) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Jet) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetAfterSelectHooks {
+	for _, hook := range jetBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -280,13 +399,13 @@ func (o *Jet) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor)
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Jet) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Jet) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetAfterUpdateHooks {
+	for _, hook := range jetAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -295,13 +414,13 @@ func (o *Jet) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor)
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Jet) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Jet) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range jetAfterDeleteHooks {
+	for _, hook := range jetBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -328,24 +447,42 @@ func (o *Jet) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor)
 // AddJetHook registers your hook function for all future operations.
 func AddJetHook(hookPoint boil.HookPoint, jetHook JetHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		jetAfterSelectMu.Lock()
+		jetAfterSelectHooks = append(jetAfterSelectHooks, jetHook)
+		jetAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		jetBeforeInsertMu.Lock()
 		jetBeforeInsertHooks = append(jetBeforeInsertHooks, jetHook)
-	case boil.BeforeUpdateHook:
-		jetBeforeUpdateHooks = append(jetBeforeUpdateHooks, jetHook)
-	case boil.BeforeDeleteHook:
-		jetBeforeDeleteHooks = append(jetBeforeDeleteHooks, jetHook)
-	case boil.BeforeUpsertHook:
-		jetBeforeUpsertHooks = append(jetBeforeUpsertHooks, jetHook)
+		jetBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		jetAfterInsertMu.Lock()
 		jetAfterInsertHooks = append(jetAfterInsertHooks, jetHook)
-	case boil.AfterSelectHook:
-		jetAfterSelectHooks = append(jetAfterSelectHooks, jetHook)
+		jetAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		jetBeforeUpdateMu.Lock()
+		jetBeforeUpdateHooks = append(jetBeforeUpdateHooks, jetHook)
+		jetBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		jetAfterUpdateMu.Lock()
 		jetAfterUpdateHooks = append(jetAfterUpdateHooks, jetHook)
+		jetAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		jetBeforeDeleteMu.Lock()
+		jetBeforeDeleteHooks = append(jetBeforeDeleteHooks, jetHook)
+		jetBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		jetAfterDeleteMu.Lock()
 		jetAfterDeleteHooks = append(jetAfterDeleteHooks, jetHook)
+		jetAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		jetBeforeUpsertMu.Lock()
+		jetBeforeUpsertHooks = append(jetBeforeUpsertHooks, jetHook)
+		jetBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		jetAfterUpsertMu.Lock()
 		jetAfterUpsertHooks = append(jetAfterUpsertHooks, jetHook)
+		jetAfterUpsertMu.Unlock()
 	}
 }
 
@@ -357,7 +494,7 @@ func (q jetQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Jet, err
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for jets")
@@ -429,10 +566,7 @@ func (o *Jet) Airport(mods ...qm.QueryMod) airportQuery {
 
 	queryMods = append(queryMods, mods...)
 
-	query := Airports(queryMods...)
-	queries.SetFrom(query.Query, "\"airports\"")
-
-	return query
+	return Airports(queryMods...)
 }
 
 // Pilot pointed to by the foreign key.
@@ -443,10 +577,21 @@ func (o *Jet) Pilot(mods ...qm.QueryMod) pilotQuery {
 
 	queryMods = append(queryMods, mods...)
 
-	query := Pilots(queryMods...)
-	queries.SetFrom(query.Query, "\"pilots\"")
+	return Pilots(queryMods...)
+}
+
+// Flights retrieves all the flight's Flights with an executor.
+func (o *Jet) Flights(mods ...qm.QueryMod) flightQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
 
-	return query
+	queryMods = append(queryMods,
+		qm.Where("\"flights\".\"jet_id\"=?", o.ID),
+	)
+
+	return Flights(queryMods...)
 }
 
 // LoadAirport allows an eager lookup of values, cached into the
@@ -456,32 +601,41 @@ func (jetL) LoadAirport(ctx context.Context, e boil.ContextExecutor, singular bo
 	var object *Jet
 
 	if singular {
-		object = maybeJet.(*Jet)
+		var ok bool
+		object, ok = maybeJet.(*Jet)
+		if !ok {
+			object = new(Jet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeJet))
+			}
+		}
 	} else {
-		slice = *maybeJet.(*[]*Jet)
+		s, ok := maybeJet.(*[]*Jet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeJet))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &jetR{}
 		}
-		args = append(args, object.AirportID)
+		args[object.AirportID] = struct{}{}
 
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &jetR{}
 			}
 
-			for _, a := range args {
-				if a == obj.AirportID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.AirportID)
+			args[obj.AirportID] = struct{}{}
 
 		}
 	}
@@ -490,9 +644,16 @@ func (jetL) LoadAirport(ctx context.Context, e boil.ContextExecutor, singular bo
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`airports`),
-		qm.WhereIn(`airports.id in ?`, args...),
+		qm.WhereIn(`airports.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -515,7 +676,7 @@ func (jetL) LoadAirport(ctx context.Context, e boil.ContextExecutor, singular bo
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for airports")
 	}
 
-	if len(jetAfterSelectHooks) != 0 {
+	if len(airportAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -560,32 +721,41 @@ func (jetL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular bool
 	var object *Jet
 
 	if singular {
-		object = maybeJet.(*Jet)
+		var ok bool
+		object, ok = maybeJet.(*Jet)
+		if !ok {
+			object = new(Jet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeJet))
+			}
+		}
 	} else {
-		slice = *maybeJet.(*[]*Jet)
+		s, ok := maybeJet.(*[]*Jet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeJet))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &jetR{}
 		}
-		args = append(args, object.PilotID)
+		args[object.PilotID] = struct{}{}
 
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &jetR{}
 			}
 
-			for _, a := range args {
-				if a == obj.PilotID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.PilotID)
+			args[obj.PilotID] = struct{}{}
 
 		}
 	}
@@ -594,9 +764,16 @@ func (jetL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular bool
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`pilots`),
-		qm.WhereIn(`pilots.id in ?`, args...),
+		qm.WhereIn(`pilots.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -619,7 +796,7 @@ func (jetL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular bool
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pilots")
 	}
 
-	if len(jetAfterSelectHooks) != 0 {
+	if len(pilotAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -657,6 +834,119 @@ func (jetL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
+// LoadFlights allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (jetL) LoadFlights(ctx context.Context, e boil.ContextExecutor, singular bool, maybeJet interface{}, mods queries.Applicator) error {
+	var slice []*Jet
+	var object *Jet
+
+	if singular {
+		var ok bool
+		object, ok = maybeJet.(*Jet)
+		if !ok {
+			object = new(Jet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeJet))
+			}
+		}
+	} else {
+		s, ok := maybeJet.(*[]*Jet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeJet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeJet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &jetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &jetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`flights`),
+		qm.WhereIn(`flights.jet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load flights")
+	}
+
+	var resultSlice []*Flight
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice flights")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on flights")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for flights")
+	}
+
+	if len(flightAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Flights = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &flightR{}
+			}
+			foreign.R.Jet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.JetID {
+				local.R.Flights = append(local.R.Flights, foreign)
+				if foreign.R == nil {
+					foreign.R = &flightR{}
+				}
+				foreign.R.Jet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetAirport of the jet to the related item.
 // Sets o.R.Airport to related.
 // Adds o to related.R.Jets.
@@ -751,10 +1041,68 @@ func (o *Jet) SetPilot(ctx context.Context, exec boil.ContextExecutor, insert bo
 	return nil
 }
 
+// AddFlights adds the given related objects to the existing relationships
+// of the jet, optionally inserting them as new records.
+// Appends related to o.R.Flights.
+// Sets related.R.Jet appropriately.
+func (o *Jet) AddFlights(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Flight) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.JetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"flights\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"jet_id"}),
+				strmangle.WhereClause("\"", "\"", 2, flightPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.JetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &jetR{
+			Flights: related,
+		}
+	} else {
+		o.R.Flights = append(o.R.Flights, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &flightR{
+				Jet: o,
+			}
+		} else {
+			rel.R.Jet = o
+		}
+	}
+	return nil
+}
+
 // Jets retrieves all the records using an executor.
 func Jets(mods ...qm.QueryMod) jetQuery {
 	mods = append(mods, qm.From("\"jets\""))
-	return jetQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"jets\".*"})
+	}
+
+	return jetQuery{q}
 }
 
 // FindJet retrieves a single record by ID with an executor.
@@ -774,12 +1122,16 @@ func FindJet(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols
 
 	err := q.Bind(ctx, exec, jetObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from jets")
 	}
 
+	if err = jetObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return jetObj, err
+	}
+
 	return jetObj, nil
 }
 
@@ -992,7 +1344,7 @@ func (o JetSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Jet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Jet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no jets provided for upsert")
 	}
@@ -1038,12 +1390,13 @@ func (o *Jet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCon
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			jetAllColumns,
 			jetColumnsWithDefault,
 			jetColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			jetAllColumns,
 			jetPrimaryKeyColumns,
@@ -1053,12 +1406,18 @@ func (o *Jet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCon
 			return errors.New("models: unable to upsert jets, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(jetAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(jetPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert jets, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(jetPrimaryKeyColumns))
 			copy(conflict, jetPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"jets\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"jets\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(jetType, jetMapping, insert)
 		if err != nil {
@@ -1086,7 +1445,7 @@ func (o *Jet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCon
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -1271,3 +1630,8 @@ func JetExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, er
 
 	return exists, nil
 }
+
+// Exists checks if the Jet row exists.
+func (o *Jet) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return JetExists(ctx, exec, o.ID)
+}
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,12 +13,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // Language is an object representing the database table.
@@ -38,6 +38,14 @@ var LanguageColumns = struct {
 	Language: "language",
 }
 
+var LanguageTableColumns = struct {
+	ID       string
+	Language string
+}{
+	ID:       "languages.id",
+	Language: "languages.language",
+}
+
 // Generated where
 
 var LanguageWhere = struct {
@@ -65,6 +73,22 @@ func (*languageR) NewStruct() *languageR {
 	return &languageR{}
 }
 
+func (o *Language) GetPilots() PilotSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPilots()
+}
+
+func (r *languageR) GetPilots() PilotSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Pilots
+}
+
 // languageL is where Load methods for each relationship are stored.
 type languageL struct{}
 
@@ -73,11 +97,12 @@ var (
 	languageColumnsWithoutDefault = []string{"language"}
 	languageColumnsWithDefault    = []string{"id"}
 	languagePrimaryKeyColumns     = []string{"id"}
+	languageGeneratedColumns      = []string{}
 )
 
 type (
 	// LanguageSlice is an alias for a slice of pointers to Language.
-	// This should generally be used opposed to []Language.
+	// This should almost always be used instead of []Language.
 	LanguageSlice []*Language
 	// LanguageHook is the signature for custom Language hook methods
 	LanguageHook func(context.Context, boil.ContextExecutor, *Language) error
@@ -108,24 +133,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var languageBeforeInsertHooks []LanguageHook
-var languageBeforeUpdateHooks []LanguageHook
-var languageBeforeDeleteHooks []LanguageHook
-var languageBeforeUpsertHooks []LanguageHook
+var languageAfterSelectMu sync.Mutex
+var languageAfterSelectHooks []LanguageHook
 
+var languageBeforeInsertMu sync.Mutex
+var languageBeforeInsertHooks []LanguageHook
+var languageAfterInsertMu sync.Mutex
 var languageAfterInsertHooks []LanguageHook
-var languageAfterSelectHooks []LanguageHook
+
+var languageBeforeUpdateMu sync.Mutex
+var languageBeforeUpdateHooks []LanguageHook
+var languageAfterUpdateMu sync.Mutex
 var languageAfterUpdateHooks []LanguageHook
+
+var languageBeforeDeleteMu sync.Mutex
+var languageBeforeDeleteHooks []LanguageHook
+var languageAfterDeleteMu sync.Mutex
 var languageAfterDeleteHooks []LanguageHook
+
+var languageBeforeUpsertMu sync.Mutex
+var languageBeforeUpsertHooks []LanguageHook
+var languageAfterUpsertMu sync.Mutex
 var languageAfterUpsertHooks []LanguageHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *Language) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Language) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageBeforeInsertHooks {
+	for _, hook := range languageAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -134,13 +171,13 @@ func (o *Language) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *Language) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Language) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageBeforeUpdateHooks {
+	for _, hook := range languageBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -149,13 +186,13 @@ func (o *Language) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *Language) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Language) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageBeforeDeleteHooks {
+	for _, hook := range languageAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -164,13 +201,13 @@ func (o *Language) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Language) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Language) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageBeforeUpsertHooks {
+	for _, hook := range languageBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -179,13 +216,13 @@ func (o *Language) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Language) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Language) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageAfterInsertHooks {
+	for _, hook := range languageAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -194,13 +231,13 @@ func (o *Language) doAfterInsertHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Language) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Language) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageAfterSelectHooks {
+	for _, hook := range languageBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -209,13 +246,13 @@ func (o *Language) doAfterSelectHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Language) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Language) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageAfterUpdateHooks {
+	for _, hook := range languageAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -224,13 +261,13 @@ func (o *Language) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Language) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Language) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range languageAfterDeleteHooks {
+	for _, hook := range languageBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -257,24 +294,42 @@ func (o *Language) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExec
 // AddLanguageHook registers your hook function for all future operations.
 func AddLanguageHook(hookPoint boil.HookPoint, languageHook LanguageHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		languageAfterSelectMu.Lock()
+		languageAfterSelectHooks = append(languageAfterSelectHooks, languageHook)
+		languageAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		languageBeforeInsertMu.Lock()
 		languageBeforeInsertHooks = append(languageBeforeInsertHooks, languageHook)
-	case boil.BeforeUpdateHook:
-		languageBeforeUpdateHooks = append(languageBeforeUpdateHooks, languageHook)
-	case boil.BeforeDeleteHook:
-		languageBeforeDeleteHooks = append(languageBeforeDeleteHooks, languageHook)
-	case boil.BeforeUpsertHook:
-		languageBeforeUpsertHooks = append(languageBeforeUpsertHooks, languageHook)
+		languageBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		languageAfterInsertMu.Lock()
 		languageAfterInsertHooks = append(languageAfterInsertHooks, languageHook)
-	case boil.AfterSelectHook:
-		languageAfterSelectHooks = append(languageAfterSelectHooks, languageHook)
+		languageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		languageBeforeUpdateMu.Lock()
+		languageBeforeUpdateHooks = append(languageBeforeUpdateHooks, languageHook)
+		languageBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		languageAfterUpdateMu.Lock()
 		languageAfterUpdateHooks = append(languageAfterUpdateHooks, languageHook)
+		languageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		languageBeforeDeleteMu.Lock()
+		languageBeforeDeleteHooks = append(languageBeforeDeleteHooks, languageHook)
+		languageBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		languageAfterDeleteMu.Lock()
 		languageAfterDeleteHooks = append(languageAfterDeleteHooks, languageHook)
+		languageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		languageBeforeUpsertMu.Lock()
+		languageBeforeUpsertHooks = append(languageBeforeUpsertHooks, languageHook)
+		languageBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		languageAfterUpsertMu.Lock()
 		languageAfterUpsertHooks = append(languageAfterUpsertHooks, languageHook)
+		languageAfterUpsertMu.Unlock()
 	}
 }
 
@@ -286,7 +341,7 @@ func (q languageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Lan
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for languages")
@@ -362,14 +417,7 @@ func (o *Language) Pilots(mods ...qm.QueryMod) pilotQuery {
 		qm.Where("\"pilot_languages\".\"language_id\"=?", o.ID),
 	)
 
-	query := Pilots(queryMods...)
-	queries.SetFrom(query.Query, "\"pilots\"")
-
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"pilots\".*"})
-	}
-
-	return query
+	return Pilots(queryMods...)
 }
 
 // LoadPilots allows an eager lookup of values, cached into the
@@ -379,31 +427,39 @@ func (languageL) LoadPilots(ctx context.Context, e boil.ContextExecutor, singula
 	var object *Language
 
 	if singular {
-		object = maybeLanguage.(*Language)
+		var ok bool
+		object, ok = maybeLanguage.(*Language)
+		if !ok {
+			object = new(Language)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLanguage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLanguage))
+			}
+		}
 	} else {
-		slice = *maybeLanguage.(*[]*Language)
+		s, ok := maybeLanguage.(*[]*Language)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLanguage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLanguage))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &languageR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &languageR{}
 			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -411,11 +467,18 @@ func (languageL) LoadPilots(ctx context.Context, e boil.ContextExecutor, singula
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
-		qm.Select("\"pilots\".*, \"a\".\"language_id\""),
+		qm.Select("\"pilots\".\"id\", \"pilots\".\"name\", \"a\".\"language_id\""),
 		qm.From("\"pilots\""),
 		qm.InnerJoin("\"pilot_languages\" as \"a\" on \"pilots\".\"id\" = \"a\".\"pilot_id\""),
-		qm.WhereIn("\"a\".\"language_id\" in ?", args...),
+		qm.WhereIn("\"a\".\"language_id\" in ?", argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -558,6 +621,7 @@ func (o *Language) SetPilots(ctx context.Context, exec boil.ContextExecutor, ins
 	if o.R != nil {
 		o.R.Pilots = nil
 	}
+
 	return o.AddPilots(ctx, exec, insert, related...)
 }
 
@@ -565,6 +629,10 @@ func (o *Language) SetPilots(ctx context.Context, exec boil.ContextExecutor, ins
 // Removes related items from R.Pilots (uses pointer comparison, removal does not keep order)
 // Sets related.R.Languages.
 func (o *Language) RemovePilots(ctx context.Context, exec boil.ContextExecutor, related ...*Pilot) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
 	query := fmt.Sprintf(
 		"delete from \"pilot_languages\" where \"language_id\" = $1 and \"pilot_id\" in (%s)",
@@ -630,7 +698,12 @@ func removePilotsFromLanguagesSlice(o *Language, related []*Pilot) {
 // Languages retrieves all the records using an executor.
 func Languages(mods ...qm.QueryMod) languageQuery {
 	mods = append(mods, qm.From("\"languages\""))
-	return languageQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"languages\".*"})
+	}
+
+	return languageQuery{q}
 }
 
 // FindLanguage retrieves a single record by ID with an executor.
@@ -650,12 +723,16 @@ func FindLanguage(ctx context.Context, exec boil.ContextExecutor, iD int, select
 
 	err := q.Bind(ctx, exec, languageObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from languages")
 	}
 
+	if err = languageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return languageObj, err
+	}
+
 	return languageObj, nil
 }
 
@@ -868,7 +945,7 @@ func (o LanguageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Language) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Language) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no languages provided for upsert")
 	}
@@ -914,12 +991,13 @@ func (o *Language) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			languageAllColumns,
 			languageColumnsWithDefault,
 			languageColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			languageAllColumns,
 			languagePrimaryKeyColumns,
@@ -929,12 +1007,18 @@ func (o *Language) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 			return errors.New("models: unable to upsert languages, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(languageAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(languagePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert languages, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(languagePrimaryKeyColumns))
 			copy(conflict, languagePrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"languages\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"languages\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(languageType, languageMapping, insert)
 		if err != nil {
@@ -962,7 +1046,7 @@ func (o *Language) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -1147,3 +1231,8 @@ func LanguageExists(ctx context.Context, exec boil.ContextExecutor, iD int) (boo
 
 	return exists, nil
 }
+
+// Exists checks if the Language row exists.
+func (o *Language) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LanguageExists(ctx, exec, o.ID)
+}
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,13 +13,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // License is an object representing the database table.
@@ -39,6 +39,14 @@ var LicenseColumns = struct {
 	PilotID: "pilot_id",
 }
 
+var LicenseTableColumns = struct {
+	ID      string
+	PilotID string
+}{
+	ID:      "licenses.id",
+	PilotID: "licenses.pilot_id",
+}
+
 // Generated where
 
 var LicenseWhere = struct {
@@ -66,19 +74,36 @@ func (*licenseR) NewStruct() *licenseR {
 	return &licenseR{}
 }
 
+func (o *License) GetPilot() *Pilot {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPilot()
+}
+
+func (r *licenseR) GetPilot() *Pilot {
+	if r == nil {
+		return nil
+	}
+
+	return r.Pilot
+}
+
 // licenseL is where Load methods for each relationship are stored.
 type licenseL struct{}
 
 var (
 	licenseAllColumns            = []string{"id", "pilot_id"}
-	licenseColumnsWithoutDefault = []string{"pilot_id"}
-	licenseColumnsWithDefault    = []string{"id"}
+	licenseColumnsWithoutDefault = []string{}
+	licenseColumnsWithDefault    = []string{"id", "pilot_id"}
 	licensePrimaryKeyColumns     = []string{"id"}
+	licenseGeneratedColumns      = []string{}
 )
 
 type (
 	// LicenseSlice is an alias for a slice of pointers to License.
-	// This should generally be used opposed to []License.
+	// This should almost always be used instead of []License.
 	LicenseSlice []*License
 	// LicenseHook is the signature for custom License hook methods
 	LicenseHook func(context.Context, boil.ContextExecutor, *License) error
@@ -109,24 +134,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var licenseBeforeInsertHooks []LicenseHook
-var licenseBeforeUpdateHooks []LicenseHook
-var licenseBeforeDeleteHooks []LicenseHook
-var licenseBeforeUpsertHooks []LicenseHook
+var licenseAfterSelectMu sync.Mutex
+var licenseAfterSelectHooks []LicenseHook
 
+var licenseBeforeInsertMu sync.Mutex
+var licenseBeforeInsertHooks []LicenseHook
+var licenseAfterInsertMu sync.Mutex
 var licenseAfterInsertHooks []LicenseHook
-var licenseAfterSelectHooks []LicenseHook
+
+var licenseBeforeUpdateMu sync.Mutex
+var licenseBeforeUpdateHooks []LicenseHook
+var licenseAfterUpdateMu sync.Mutex
 var licenseAfterUpdateHooks []LicenseHook
+
+var licenseBeforeDeleteMu sync.Mutex
+var licenseBeforeDeleteHooks []LicenseHook
+var licenseAfterDeleteMu sync.Mutex
 var licenseAfterDeleteHooks []LicenseHook
+
+var licenseBeforeUpsertMu sync.Mutex
+var licenseBeforeUpsertHooks []LicenseHook
+var licenseAfterUpsertMu sync.Mutex
 var licenseAfterUpsertHooks []LicenseHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *License) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *License) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseBeforeInsertHooks {
+	for _, hook := range licenseAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -135,13 +172,13 @@ func (o *License) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *License) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *License) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseBeforeUpdateHooks {
+	for _, hook := range licenseBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -150,13 +187,13 @@ func (o *License) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *License) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *License) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseBeforeDeleteHooks {
+	for _, hook := range licenseAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -165,13 +202,13 @@ func (o *License) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *License) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *License) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseBeforeUpsertHooks {
+	for _, hook := range licenseBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -180,13 +217,13 @@ func (o *License) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *License) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *License) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseAfterInsertHooks {
+	for _, hook := range licenseAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -195,13 +232,13 @@ func (o *License) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *License) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *License) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseAfterSelectHooks {
+	for _, hook := range licenseBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -210,13 +247,13 @@ func (o *License) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *License) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *License) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseAfterUpdateHooks {
+	for _, hook := range licenseAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -225,13 +262,13 @@ func (o *License) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *License) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *License) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range licenseAfterDeleteHooks {
+	for _, hook := range licenseBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -258,24 +295,42 @@ func (o *License) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecu
 // AddLicenseHook registers your hook function for all future operations.
 func AddLicenseHook(hookPoint boil.HookPoint, licenseHook LicenseHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		licenseAfterSelectMu.Lock()
+		licenseAfterSelectHooks = append(licenseAfterSelectHooks, licenseHook)
+		licenseAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		licenseBeforeInsertMu.Lock()
 		licenseBeforeInsertHooks = append(licenseBeforeInsertHooks, licenseHook)
-	case boil.BeforeUpdateHook:
-		licenseBeforeUpdateHooks = append(licenseBeforeUpdateHooks, licenseHook)
-	case boil.BeforeDeleteHook:
-		licenseBeforeDeleteHooks = append(licenseBeforeDeleteHooks, licenseHook)
-	case boil.BeforeUpsertHook:
-		licenseBeforeUpsertHooks = append(licenseBeforeUpsertHooks, licenseHook)
+		licenseBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		licenseAfterInsertMu.Lock()
 		licenseAfterInsertHooks = append(licenseAfterInsertHooks, licenseHook)
-	case boil.AfterSelectHook:
-		licenseAfterSelectHooks = append(licenseAfterSelectHooks, licenseHook)
+		licenseAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		licenseBeforeUpdateMu.Lock()
+		licenseBeforeUpdateHooks = append(licenseBeforeUpdateHooks, licenseHook)
+		licenseBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		licenseAfterUpdateMu.Lock()
 		licenseAfterUpdateHooks = append(licenseAfterUpdateHooks, licenseHook)
+		licenseAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		licenseBeforeDeleteMu.Lock()
+		licenseBeforeDeleteHooks = append(licenseBeforeDeleteHooks, licenseHook)
+		licenseBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		licenseAfterDeleteMu.Lock()
 		licenseAfterDeleteHooks = append(licenseAfterDeleteHooks, licenseHook)
+		licenseAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		licenseBeforeUpsertMu.Lock()
+		licenseBeforeUpsertHooks = append(licenseBeforeUpsertHooks, licenseHook)
+		licenseBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		licenseAfterUpsertMu.Lock()
 		licenseAfterUpsertHooks = append(licenseAfterUpsertHooks, licenseHook)
+		licenseAfterUpsertMu.Unlock()
 	}
 }
 
@@ -287,7 +342,7 @@ func (q licenseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Lice
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for licenses")
@@ -359,10 +414,7 @@ func (o *License) Pilot(mods ...qm.QueryMod) pilotQuery {
 
 	queryMods = append(queryMods, mods...)
 
-	query := Pilots(queryMods...)
-	queries.SetFrom(query.Query, "\"pilots\"")
-
-	return query
+	return Pilots(queryMods...)
 }
 
 // LoadPilot allows an eager lookup of values, cached into the
@@ -372,35 +424,44 @@ func (licenseL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular
 	var object *License
 
 	if singular {
-		object = maybeLicense.(*License)
+		var ok bool
+		object, ok = maybeLicense.(*License)
+		if !ok {
+			object = new(License)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLicense)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLicense))
+			}
+		}
 	} else {
-		slice = *maybeLicense.(*[]*License)
+		s, ok := maybeLicense.(*[]*License)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLicense)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLicense))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &licenseR{}
 		}
 		if !queries.IsNil(object.PilotID) {
-			args = append(args, object.PilotID)
+			args[object.PilotID] = struct{}{}
 		}
 
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &licenseR{}
 			}
 
-			for _, a := range args {
-				if queries.Equal(a, obj.PilotID) {
-					continue Outer
-				}
-			}
-
 			if !queries.IsNil(obj.PilotID) {
-				args = append(args, obj.PilotID)
+				args[obj.PilotID] = struct{}{}
 			}
 
 		}
@@ -410,9 +471,16 @@ func (licenseL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`pilots`),
-		qm.WhereIn(`pilots.id in ?`, args...),
+		qm.WhereIn(`pilots.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -435,7 +503,7 @@ func (licenseL) LoadPilot(ctx context.Context, e boil.ContextExecutor, singular
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pilots")
 	}
 
-	if len(licenseAfterSelectHooks) != 0 {
+	if len(pilotAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -522,7 +590,7 @@ func (o *License) SetPilot(ctx context.Context, exec boil.ContextExecutor, inser
 
 // RemovePilot relationship.
 // Sets o.R.Pilot to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
+// Removes o from all passed in related items' relationships struct.
 func (o *License) RemovePilot(ctx context.Context, exec boil.ContextExecutor, related *Pilot) error {
 	var err error
 
@@ -556,7 +624,12 @@ func (o *License) RemovePilot(ctx context.Context, exec boil.ContextExecutor, re
 // Licenses retrieves all the records using an executor.
 func Licenses(mods ...qm.QueryMod) licenseQuery {
 	mods = append(mods, qm.From("\"licenses\""))
-	return licenseQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"licenses\".*"})
+	}
+
+	return licenseQuery{q}
 }
 
 // FindLicense retrieves a single record by ID with an executor.
@@ -576,12 +649,16 @@ func FindLicense(ctx context.Context, exec boil.ContextExecutor, iD int, selectC
 
 	err := q.Bind(ctx, exec, licenseObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from licenses")
 	}
 
+	if err = licenseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return licenseObj, err
+	}
+
 	return licenseObj, nil
 }
 
@@ -794,7 +871,7 @@ func (o LicenseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *License) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *License) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no licenses provided for upsert")
 	}
@@ -840,12 +917,13 @@ func (o *License) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			licenseAllColumns,
 			licenseColumnsWithDefault,
 			licenseColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			licenseAllColumns,
 			licensePrimaryKeyColumns,
@@ -855,12 +933,18 @@ func (o *License) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 			return errors.New("models: unable to upsert licenses, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(licenseAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(licensePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert licenses, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(licensePrimaryKeyColumns))
 			copy(conflict, licensePrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"licenses\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"licenses\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(licenseType, licenseMapping, insert)
 		if err != nil {
@@ -888,7 +972,7 @@ func (o *License) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -1073,3 +1157,8 @@ func LicenseExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool
 
 	return exists, nil
 }
+
+// Exists checks if the License row exists.
+func (o *License) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LicenseExists(ctx, exec, o.ID)
+}
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.2.0 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -13,12 +13,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/friendsofgo/errors"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
 	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
 )
 
 // Pilot is an object representing the database table.
@@ -38,6 +38,14 @@ var PilotColumns = struct {
 	Name: "name",
 }
 
+var PilotTableColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "pilots.id",
+	Name: "pilots.name",
+}
+
 // Generated where
 
 var PilotWhere = struct {
@@ -53,10 +61,18 @@ var PilotRels = struct {
 	Jets      string
 	Licenses  string
 	Languages string
+	Wide10s   string
+	Wide100s  string
+	Wide25s   string
+	Wide50s   string
 }{
 	Jets:      "Jets",
 	Licenses:  "Licenses",
 	Languages: "Languages",
+	Wide10s:   "Wide10s",
+	Wide100s:  "Wide100s",
+	Wide25s:   "Wide25s",
+	Wide50s:   "Wide50s",
 }
 
 // pilotR is where relationships are stored.
@@ -64,6 +80,10 @@ type pilotR struct {
 	Jets      JetSlice      `db:"Jets" boil:"Jets" json:"Jets" toml:"Jets" yaml:"Jets"`
 	Licenses  LicenseSlice  `db:"Licenses" boil:"Licenses" json:"Licenses" toml:"Licenses" yaml:"Licenses"`
 	Languages LanguageSlice `db:"Languages" boil:"Languages" json:"Languages" toml:"Languages" yaml:"Languages"`
+	Wide10s   Wide10Slice   `db:"Wide10s" boil:"Wide10s" json:"Wide10s" toml:"Wide10s" yaml:"Wide10s"`
+	Wide100s  Wide100Slice  `db:"Wide100s" boil:"Wide100s" json:"Wide100s" toml:"Wide100s" yaml:"Wide100s"`
+	Wide25s   Wide25Slice   `db:"Wide25s" boil:"Wide25s" json:"Wide25s" toml:"Wide25s" yaml:"Wide25s"`
+	Wide50s   Wide50Slice   `db:"Wide50s" boil:"Wide50s" json:"Wide50s" toml:"Wide50s" yaml:"Wide50s"`
 }
 
 // NewStruct creates a new relationship struct
@@ -71,6 +91,118 @@ func (*pilotR) NewStruct() *pilotR {
 	return &pilotR{}
 }
 
+func (o *Pilot) GetJets() JetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetJets()
+}
+
+func (r *pilotR) GetJets() JetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Jets
+}
+
+func (o *Pilot) GetLicenses() LicenseSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLicenses()
+}
+
+func (r *pilotR) GetLicenses() LicenseSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Licenses
+}
+
+func (o *Pilot) GetLanguages() LanguageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLanguages()
+}
+
+func (r *pilotR) GetLanguages() LanguageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Languages
+}
+
+func (o *Pilot) GetWide10s() Wide10Slice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWide10s()
+}
+
+func (r *pilotR) GetWide10s() Wide10Slice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Wide10s
+}
+
+func (o *Pilot) GetWide100s() Wide100Slice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWide100s()
+}
+
+func (r *pilotR) GetWide100s() Wide100Slice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Wide100s
+}
+
+func (o *Pilot) GetWide25s() Wide25Slice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWide25s()
+}
+
+func (r *pilotR) GetWide25s() Wide25Slice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Wide25s
+}
+
+func (o *Pilot) GetWide50s() Wide50Slice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWide50s()
+}
+
+func (r *pilotR) GetWide50s() Wide50Slice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Wide50s
+}
+
 // pilotL is where Load methods for each relationship are stored.
 type pilotL struct{}
 
@@ -79,11 +211,12 @@ var (
 	pilotColumnsWithoutDefault = []string{"name"}
 	pilotColumnsWithDefault    = []string{"id"}
 	pilotPrimaryKeyColumns     = []string{"id"}
+	pilotGeneratedColumns      = []string{}
 )
 
 type (
 	// PilotSlice is an alias for a slice of pointers to Pilot.
-	// This should generally be used opposed to []Pilot.
+	// This should almost always be used instead of []Pilot.
 	PilotSlice []*Pilot
 	// PilotHook is the signature for custom Pilot hook methods
 	PilotHook func(context.Context, boil.ContextExecutor, *Pilot) error
@@ -114,24 +247,36 @@ var (
 	_ = qmhelper.Where
 )
 
-var pilotBeforeInsertHooks []PilotHook
-var pilotBeforeUpdateHooks []PilotHook
-var pilotBeforeDeleteHooks []PilotHook
-var pilotBeforeUpsertHooks []PilotHook
+var pilotAfterSelectMu sync.Mutex
+var pilotAfterSelectHooks []PilotHook
 
+var pilotBeforeInsertMu sync.Mutex
+var pilotBeforeInsertHooks []PilotHook
+var pilotAfterInsertMu sync.Mutex
 var pilotAfterInsertHooks []PilotHook
-var pilotAfterSelectHooks []PilotHook
+
+var pilotBeforeUpdateMu sync.Mutex
+var pilotBeforeUpdateHooks []PilotHook
+var pilotAfterUpdateMu sync.Mutex
 var pilotAfterUpdateHooks []PilotHook
+
+var pilotBeforeDeleteMu sync.Mutex
+var pilotBeforeDeleteHooks []PilotHook
+var pilotAfterDeleteMu sync.Mutex
 var pilotAfterDeleteHooks []PilotHook
+
+var pilotBeforeUpsertMu sync.Mutex
+var pilotBeforeUpsertHooks []PilotHook
+var pilotAfterUpsertMu sync.Mutex
 var pilotAfterUpsertHooks []PilotHook
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *Pilot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Pilot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotBeforeInsertHooks {
+	for _, hook := range pilotAfterSelectHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -140,13 +285,13 @@ func (o *Pilot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *Pilot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Pilot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotBeforeUpdateHooks {
+	for _, hook := range pilotBeforeInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -155,13 +300,13 @@ func (o *Pilot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *Pilot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Pilot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotBeforeDeleteHooks {
+	for _, hook := range pilotAfterInsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -170,13 +315,13 @@ func (o *Pilot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Pilot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Pilot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotBeforeUpsertHooks {
+	for _, hook := range pilotBeforeUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -185,13 +330,13 @@ func (o *Pilot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Pilot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Pilot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotAfterInsertHooks {
+	for _, hook := range pilotAfterUpdateHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -200,13 +345,13 @@ func (o *Pilot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Pilot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Pilot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotAfterSelectHooks {
+	for _, hook := range pilotBeforeDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -215,13 +360,13 @@ func (o *Pilot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Pilot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Pilot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotAfterUpdateHooks {
+	for _, hook := range pilotAfterDeleteHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -230,13 +375,13 @@ func (o *Pilot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Pilot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Pilot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
 	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, hook := range pilotAfterDeleteHooks {
+	for _, hook := range pilotBeforeUpsertHooks {
 		if err := hook(ctx, exec, o); err != nil {
 			return err
 		}
@@ -263,24 +408,42 @@ func (o *Pilot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecuto
 // AddPilotHook registers your hook function for all future operations.
 func AddPilotHook(hookPoint boil.HookPoint, pilotHook PilotHook) {
 	switch hookPoint {
+	case boil.AfterSelectHook:
+		pilotAfterSelectMu.Lock()
+		pilotAfterSelectHooks = append(pilotAfterSelectHooks, pilotHook)
+		pilotAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		pilotBeforeInsertMu.Lock()
 		pilotBeforeInsertHooks = append(pilotBeforeInsertHooks, pilotHook)
-	case boil.BeforeUpdateHook:
-		pilotBeforeUpdateHooks = append(pilotBeforeUpdateHooks, pilotHook)
-	case boil.BeforeDeleteHook:
-		pilotBeforeDeleteHooks = append(pilotBeforeDeleteHooks, pilotHook)
-	case boil.BeforeUpsertHook:
-		pilotBeforeUpsertHooks = append(pilotBeforeUpsertHooks, pilotHook)
+		pilotBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		pilotAfterInsertMu.Lock()
 		pilotAfterInsertHooks = append(pilotAfterInsertHooks, pilotHook)
-	case boil.AfterSelectHook:
-		pilotAfterSelectHooks = append(pilotAfterSelectHooks, pilotHook)
+		pilotAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pilotBeforeUpdateMu.Lock()
+		pilotBeforeUpdateHooks = append(pilotBeforeUpdateHooks, pilotHook)
+		pilotBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		pilotAfterUpdateMu.Lock()
 		pilotAfterUpdateHooks = append(pilotAfterUpdateHooks, pilotHook)
+		pilotAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pilotBeforeDeleteMu.Lock()
+		pilotBeforeDeleteHooks = append(pilotBeforeDeleteHooks, pilotHook)
+		pilotBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		pilotAfterDeleteMu.Lock()
 		pilotAfterDeleteHooks = append(pilotAfterDeleteHooks, pilotHook)
+		pilotAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pilotBeforeUpsertMu.Lock()
+		pilotBeforeUpsertHooks = append(pilotBeforeUpsertHooks, pilotHook)
+		pilotBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		pilotAfterUpsertMu.Lock()
 		pilotAfterUpsertHooks = append(pilotAfterUpsertHooks, pilotHook)
+		pilotAfterUpsertMu.Unlock()
 	}
 }
 
@@ -292,7 +455,7 @@ func (q pilotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Pilot,
 
 	err := q.Bind(ctx, exec, o)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: failed to execute a one query for pilots")
@@ -367,14 +530,7 @@ func (o *Pilot) Jets(mods ...qm.QueryMod) jetQuery {
 		qm.Where("\"jets\".\"pilot_id\"=?", o.ID),
 	)
 
-	query := Jets(queryMods...)
-	queries.SetFrom(query.Query, "\"jets\"")
-
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"jets\".*"})
-	}
-
-	return query
+	return Jets(queryMods...)
 }
 
 // Licenses retrieves all the license's Licenses with an executor.
@@ -388,14 +544,7 @@ func (o *Pilot) Licenses(mods ...qm.QueryMod) licenseQuery {
 		qm.Where("\"licenses\".\"pilot_id\"=?", o.ID),
 	)
 
-	query := Licenses(queryMods...)
-	queries.SetFrom(query.Query, "\"licenses\"")
-
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"licenses\".*"})
-	}
-
-	return query
+	return Licenses(queryMods...)
 }
 
 // Languages retrieves all the language's Languages with an executor.
@@ -410,14 +559,63 @@ func (o *Pilot) Languages(mods ...qm.QueryMod) languageQuery {
 		qm.Where("\"pilot_languages\".\"pilot_id\"=?", o.ID),
 	)
 
-	query := Languages(queryMods...)
-	queries.SetFrom(query.Query, "\"languages\"")
+	return Languages(queryMods...)
+}
+
+// Wide10s retrieves all the wide10's Wide10s with an executor.
+func (o *Pilot) Wide10s(mods ...qm.QueryMod) wide10Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wide10\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Wide10s(queryMods...)
+}
+
+// Wide100s retrieves all the wide100's Wide100s with an executor.
+func (o *Pilot) Wide100s(mods ...qm.QueryMod) wide100Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wide100\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Wide100s(queryMods...)
+}
+
+// Wide25s retrieves all the wide25's Wide25s with an executor.
+func (o *Pilot) Wide25s(mods ...qm.QueryMod) wide25Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wide25\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Wide25s(queryMods...)
+}
 
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"languages\".*"})
+// Wide50s retrieves all the wide50's Wide50s with an executor.
+func (o *Pilot) Wide50s(mods ...qm.QueryMod) wide50Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	return query
+	queryMods = append(queryMods,
+		qm.Where("\"wide50\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Wide50s(queryMods...)
 }
 
 // LoadJets allows an eager lookup of values, cached into the
@@ -427,31 +625,39 @@ func (pilotL) LoadJets(ctx context.Context, e boil.ContextExecutor, singular boo
 	var object *Pilot
 
 	if singular {
-		object = maybePilot.(*Pilot)
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
 	} else {
-		slice = *maybePilot.(*[]*Pilot)
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &pilotR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &pilotR{}
 			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -459,9 +665,16 @@ func (pilotL) LoadJets(ctx context.Context, e boil.ContextExecutor, singular boo
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`jets`),
-		qm.WhereIn(`jets.pilot_id in ?`, args...),
+		qm.WhereIn(`jets.pilot_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -525,31 +738,39 @@ func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular
 	var object *Pilot
 
 	if singular {
-		object = maybePilot.(*Pilot)
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
 	} else {
-		slice = *maybePilot.(*[]*Pilot)
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &pilotR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &pilotR{}
 			}
-
-			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -557,9 +778,16 @@ func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`licenses`),
-		qm.WhereIn(`licenses.pilot_id in ?`, args...),
+		qm.WhereIn(`licenses.pilot_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -593,7 +821,476 @@ func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular
 		object.R.Licenses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &licenseR{}
+				foreign.R = &licenseR{}
+			}
+			foreign.R.Pilot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PilotID) {
+				local.R.Licenses = append(local.R.Licenses, foreign)
+				if foreign.R == nil {
+					foreign.R = &licenseR{}
+				}
+				foreign.R.Pilot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLanguages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"languages\".\"id\", \"languages\".\"language\", \"a\".\"pilot_id\""),
+		qm.From("\"languages\""),
+		qm.InnerJoin("\"pilot_languages\" as \"a\" on \"languages\".\"id\" = \"a\".\"language_id\""),
+		qm.WhereIn("\"a\".\"pilot_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load languages")
+	}
+
+	var resultSlice []*Language
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Language)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Language, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for languages")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice languages")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on languages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for languages")
+	}
+
+	if len(languageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Languages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &languageR{}
+			}
+			foreign.R.Pilots = append(foreign.R.Pilots, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Languages = append(local.R.Languages, foreign)
+				if foreign.R == nil {
+					foreign.R = &languageR{}
+				}
+				foreign.R.Pilots = append(foreign.R.Pilots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWide10s allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadWide10s(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`wide10`),
+		qm.WhereIn(`wide10.pilot_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wide10")
+	}
+
+	var resultSlice []*Wide10
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wide10")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wide10")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wide10")
+	}
+
+	if len(wide10AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Wide10s = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &wide10R{}
+			}
+			foreign.R.Pilot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PilotID {
+				local.R.Wide10s = append(local.R.Wide10s, foreign)
+				if foreign.R == nil {
+					foreign.R = &wide10R{}
+				}
+				foreign.R.Pilot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWide100s allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadWide100s(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`wide100`),
+		qm.WhereIn(`wide100.pilot_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wide100")
+	}
+
+	var resultSlice []*Wide100
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wide100")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wide100")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wide100")
+	}
+
+	if len(wide100AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Wide100s = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &wide100R{}
+			}
+			foreign.R.Pilot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PilotID {
+				local.R.Wide100s = append(local.R.Wide100s, foreign)
+				if foreign.R == nil {
+					foreign.R = &wide100R{}
+				}
+				foreign.R.Pilot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWide25s allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadWide25s(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`wide25`),
+		qm.WhereIn(`wide25.pilot_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wide25")
+	}
+
+	var resultSlice []*Wide25
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wide25")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wide25")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wide25")
+	}
+
+	if len(wide25AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Wide25s = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &wide25R{}
 			}
 			foreign.R.Pilot = object
 		}
@@ -602,10 +1299,10 @@ func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.PilotID) {
-				local.R.Licenses = append(local.R.Licenses, foreign)
+			if local.ID == foreign.PilotID {
+				local.R.Wide25s = append(local.R.Wide25s, foreign)
 				if foreign.R == nil {
-					foreign.R = &licenseR{}
+					foreign.R = &wide25R{}
 				}
 				foreign.R.Pilot = local
 				break
@@ -616,38 +1313,46 @@ func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadLanguages allows an eager lookup of values, cached into the
+// LoadWide50s allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+func (pilotL) LoadWide50s(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
 	var slice []*Pilot
 	var object *Pilot
 
 	if singular {
-		object = maybePilot.(*Pilot)
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
 	} else {
-		slice = *maybePilot.(*[]*Pilot)
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &pilotR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &pilotR{}
 			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -655,11 +1360,16 @@ func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singula
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
-		qm.Select("\"languages\".*, \"a\".\"pilot_id\""),
-		qm.From("\"languages\""),
-		qm.InnerJoin("\"pilot_languages\" as \"a\" on \"languages\".\"id\" = \"a\".\"language_id\""),
-		qm.WhereIn("\"a\".\"pilot_id\" in ?", args...),
+		qm.From(`wide50`),
+		qm.WhereIn(`wide50.pilot_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -667,36 +1377,22 @@ func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load languages")
+		return errors.Wrap(err, "failed to eager load wide50")
 	}
 
-	var resultSlice []*Language
-
-	var localJoinCols []int
-	for results.Next() {
-		one := new(Language)
-		var localJoinCol int
-
-		err = results.Scan(&one.ID, &one.Language, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for languages")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice languages")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*Wide50
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wide50")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on languages")
+		return errors.Wrap(err, "failed to close results in eager load on wide50")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for languages")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wide50")
 	}
 
-	if len(languageAfterSelectHooks) != 0 {
+	if len(wide50AfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -704,25 +1400,24 @@ func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.Languages = resultSlice
+		object.R.Wide50s = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &languageR{}
+				foreign.R = &wide50R{}
 			}
-			foreign.R.Pilots = append(foreign.R.Pilots, object)
+			foreign.R.Pilot = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Languages = append(local.R.Languages, foreign)
+			if local.ID == foreign.PilotID {
+				local.R.Wide50s = append(local.R.Wide50s, foreign)
 				if foreign.R == nil {
-					foreign.R = &languageR{}
+					foreign.R = &wide50R{}
 				}
-				foreign.R.Pilots = append(foreign.R.Pilots, local)
+				foreign.R.Pilot = local
 				break
 			}
 		}
@@ -865,9 +1560,9 @@ func (o *Pilot) SetLicenses(ctx context.Context, exec boil.ContextExecutor, inse
 
 			rel.R.Pilot = nil
 		}
-
 		o.R.Licenses = nil
 	}
+
 	return o.AddLicenses(ctx, exec, insert, related...)
 }
 
@@ -875,6 +1570,10 @@ func (o *Pilot) SetLicenses(ctx context.Context, exec boil.ContextExecutor, inse
 // Removes related items from R.Licenses (uses pointer comparison, removal does not keep order)
 // Sets related.R.Pilot.
 func (o *Pilot) RemoveLicenses(ctx context.Context, exec boil.ContextExecutor, related ...*License) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
 	for _, rel := range related {
 		queries.SetScanner(&rel.PilotID, nil)
@@ -978,6 +1677,7 @@ func (o *Pilot) SetLanguages(ctx context.Context, exec boil.ContextExecutor, ins
 	if o.R != nil {
 		o.R.Languages = nil
 	}
+
 	return o.AddLanguages(ctx, exec, insert, related...)
 }
 
@@ -985,6 +1685,10 @@ func (o *Pilot) SetLanguages(ctx context.Context, exec boil.ContextExecutor, ins
 // Removes related items from R.Languages (uses pointer comparison, removal does not keep order)
 // Sets related.R.Pilots.
 func (o *Pilot) RemoveLanguages(ctx context.Context, exec boil.ContextExecutor, related ...*Language) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
 	query := fmt.Sprintf(
 		"delete from \"pilot_languages\" where \"pilot_id\" = $1 and \"language_id\" in (%s)",
@@ -1047,10 +1751,227 @@ func removeLanguagesFromPilotsSlice(o *Pilot, related []*Language) {
 	}
 }
 
+// AddWide10s adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Wide10s.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddWide10s(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Wide10) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PilotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wide10\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wide10PrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PilotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Wide10s: related,
+		}
+	} else {
+		o.R.Wide10s = append(o.R.Wide10s, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wide10R{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
+// AddWide100s adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Wide100s.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddWide100s(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Wide100) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PilotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wide100\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wide100PrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PilotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Wide100s: related,
+		}
+	} else {
+		o.R.Wide100s = append(o.R.Wide100s, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wide100R{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
+// AddWide25s adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Wide25s.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddWide25s(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Wide25) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PilotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wide25\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wide25PrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PilotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Wide25s: related,
+		}
+	} else {
+		o.R.Wide25s = append(o.R.Wide25s, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wide25R{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
+// AddWide50s adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Wide50s.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddWide50s(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Wide50) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PilotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wide50\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wide50PrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PilotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Wide50s: related,
+		}
+	} else {
+		o.R.Wide50s = append(o.R.Wide50s, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wide50R{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
 // Pilots retrieves all the records using an executor.
 func Pilots(mods ...qm.QueryMod) pilotQuery {
 	mods = append(mods, qm.From("\"pilots\""))
-	return pilotQuery{NewQuery(mods...)}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"pilots\".*"})
+	}
+
+	return pilotQuery{q}
 }
 
 // FindPilot retrieves a single record by ID with an executor.
@@ -1070,12 +1991,16 @@ func FindPilot(ctx context.Context, exec boil.ContextExecutor, iD int, selectCol
 
 	err := q.Bind(ctx, exec, pilotObj)
 	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
 		return nil, errors.Wrap(err, "models: unable to select from pilots")
 	}
 
+	if err = pilotObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pilotObj, err
+	}
+
 	return pilotObj, nil
 }
 
@@ -1288,7 +2213,7 @@ func (o PilotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, co
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("models: no pilots provided for upsert")
 	}
@@ -1334,12 +2259,13 @@ func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			pilotAllColumns,
 			pilotColumnsWithDefault,
 			pilotColumnsWithoutDefault,
 			nzDefaults,
 		)
+
 		update := updateColumns.UpdateColumnSet(
 			pilotAllColumns,
 			pilotPrimaryKeyColumns,
@@ -1349,12 +2275,18 @@ func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 			return errors.New("models: unable to upsert pilots, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(pilotAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pilotPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert pilots, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(pilotPrimaryKeyColumns))
 			copy(conflict, pilotPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"pilots\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"pilots\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(pilotType, pilotMapping, insert)
 		if err != nil {
@@ -1382,7 +2314,7 @@ func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
@@ -1567,3 +2499,8 @@ func PilotExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool,
 
 	return exists, nil
 }
+
+// Exists checks if the Pilot row exists.
+func (o *Pilot) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PilotExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,95 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+)
+
+// relations are the pilot and the rows the relationship writes work on
+type relations struct {
+	pilot     *Pilot
+	languages []*Language
+	jets      []*Jet
+	licenses  []*License
+}
+
+// Relate sets up a pilot and n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: &Pilot{ID: 1, Name: "test"}}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, &Language{ID: i, Language: "test"})
+		r.jets = append(r.jets, &Jet{ID: i, PilotID: 2, AirportID: 1, Name: "test", UUID: "test"})
+		r.licenses = append(r.licenses, &License{ID: i, PilotID: null.IntFrom(2)})
+	}
+	a.rel = r
+}
+
+// forget empties the relationships loaded on the pilot and its rows, which
+// every write appends to
+func (r *relations) forget() {
+	r.pilot.R = nil
+	for _, l := range r.languages {
+		l.R = nil
+	}
+	for _, j := range r.jets {
+		j.R = nil
+	}
+	for _, l := range r.licenses {
+		l.R = nil
+	}
+}
+
+// AttachLanguages adds the languages to the pilot
+func (a *Adapter) AttachLanguages(ctx context.Context) error {
+	r := &a.rel
+	r.forget()
+	if err := r.pilot.AddLanguages(ctx, a.db, false, r.languages...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReplaceLanguages sets the languages as the only ones of the pilot
+func (a *Adapter) ReplaceLanguages(ctx context.Context) error {
+	r := &a.rel
+	r.forget()
+	if err := r.pilot.SetLanguages(ctx, a.db, false, r.languages...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DetachLanguages removes the languages, loaded on the pilot, from it
+func (a *Adapter) DetachLanguages(ctx context.Context) error {
+	r := &a.rel
+	r.forget()
+	r.pilot.R = r.pilot.R.NewStruct()
+	r.pilot.R.Languages = append(r.pilot.R.Languages, r.languages...)
+	if err := r.pilot.RemoveLanguages(ctx, a.db, r.languages...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(ctx context.Context) error {
+	r := &a.rel
+	r.forget()
+	if err := r.pilot.AddJets(ctx, a.db, false, r.jets...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReplaceLicenses sets the licenses as the only ones of the pilot
+func (a *Adapter) ReplaceLicenses(ctx context.Context) error {
+	r := &a.rel
+	r.forget()
+	if err := r.pilot.SetLicenses(ctx, a.db, false, r.licenses...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction. It's begun on the adapter's handle like boil.BeginTx
+// begins it on the global one.
+func (a *Adapter) Unit(ctx context.Context) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	jet, err := FindJet(ctx, tx, 1)
+	if err != nil {
+		return err
+	}
+	if _, err := jet.Update(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+	license := License{PilotID: null.IntFrom(jet.PilotID)}
+	if err := license.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+
+	a.jets = []*Jet{jet}
+	return tx.Commit()
+}
@@ -0,0 +1,152 @@
+package models
+
+// This file is not generated, see adapter.go
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide(a, columns, Wide10Rels.Pilot, func(ctx context.Context, mods ...qm.QueryMod) ([]*Wide10, error) {
+			return Wide10s(mods...).All(ctx, a.db)
+		})
+	case 25:
+		return newWide(a, columns, Wide25Rels.Pilot, func(ctx context.Context, mods ...qm.QueryMod) ([]*Wide25, error) {
+			return Wide25s(mods...).All(ctx, a.db)
+		})
+	case 50:
+		return newWide(a, columns, Wide50Rels.Pilot, func(ctx context.Context, mods ...qm.QueryMod) ([]*Wide50, error) {
+			return Wide50s(mods...).All(ctx, a.db)
+		})
+	case 100:
+		return newWide(a, columns, Wide100Rels.Pilot, func(ctx context.Context, mods ...qm.QueryMod) ([]*Wide100, error) {
+			return Wide100s(mods...).All(ctx, a.db)
+		})
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wideModel is implemented by the models of the wide tables
+type wideModel[T any] interface {
+	*T
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+	Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error)
+	Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error)
+	GetPilot() *Pilot
+}
+
+// wide implements bench.Wide for the model T of a wide table, all queries
+// it with the mods, which sqlboiler generates a function per table for
+type wide[T any, P wideModel[T]] struct {
+	a       *Adapter
+	columns int
+	pilot   string
+	all     func(ctx context.Context, mods ...qm.QueryMod) ([]*T, error)
+
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []*T
+	wrote bool
+}
+
+func newWide[T any, P wideModel[T]](a *Adapter, columns int, pilot string, all func(context.Context, ...qm.QueryMod) ([]*T, error)) *wide[T, P] {
+	w := &wide[T, P]{a: a, columns: columns, pilot: pilot, all: all}
+	bench.FillRow(&w.row, columns)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T, P]) SelectAll(ctx context.Context) error {
+	store, err := w.all(ctx)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T, P]) SelectSubset(ctx context.Context) error {
+	store, err := w.all(ctx, qm.Select(strings.Join(bench.WideSubset(w.columns), ", ")))
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table
+func (w *wide[T, P]) SelectComplex(ctx context.Context) error {
+	store, err := w.all(ctx,
+		qm.Select(strings.Join(bench.WideSubset(w.columns), ", ")),
+		qm.Where("id > ?", 1),
+		qm.And("text_003 <> ?", "thing"),
+		qm.Limit(1),
+		qm.GroupBy("id"),
+		qm.Offset(1),
+	)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T, P]) Insert(ctx context.Context) error {
+	w.wrote = true
+	return P(&w.row).Insert(ctx, w.a.db, boil.Infer())
+}
+
+// Update a row
+func (w *wide[T, P]) Update(ctx context.Context) error {
+	w.wrote = true
+	_, err := P(&w.row).Update(ctx, w.a.db, boil.Infer())
+	return err
+}
+
+// Delete a row
+func (w *wide[T, P]) Delete(ctx context.Context) error {
+	w.wrote = true
+	_, err := P(&w.row).Delete(ctx, w.a.db)
+	return err
+}
+
+// RawBind a raw query into rows
+func (w *wide[T, P]) RawBind(ctx context.Context) error {
+	var store []*T
+	err := queries.Raw("select * from "+bench.WideTable(w.columns)).Bind(ctx, w.a.db, &store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad rows with their pilots
+func (w *wide[T, P]) EagerLoad(ctx context.Context) error {
+	store, err := w.all(ctx, qm.Load(w.pilot))
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Results of the last operation
+func (w *wide[T, P]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{w.canonical(&w.row)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = w.canonical(r)
+	}
+	return results
+}
+
+func (w *wide[T, P]) canonical(r *T) bench.Row {
+	row := bench.RowOf(r, w.columns)
+	if p := P(r).GetPilot(); p != nil {
+		row.Pilot = &bench.Pilot{ID: int64(p.ID), Name: p.Name}
+	}
+	return row
+}
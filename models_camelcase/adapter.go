@@ -0,0 +1,147 @@
+package models_camelcase
+
+// This file is not generated. scripts/gen-variants regenerates this package
+// without wiping it so that the adapter survives.
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Adapter implements bench.Adapter for sqlboiler models generated with
+// --struct-tag-casing camel and snake case boil tags
+type Adapter struct {
+	db  *sql.DB
+	jet Jet
+
+	// jets are the results of the last select
+	jets []*Jet
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "boil-camelcase" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Open a connection to the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := sql.Open("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = db
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(ctx context.Context) error {
+	store, err := Jets().All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(ctx context.Context) error {
+	store, err := Jets(qm.Select("id, name, color, uuid, identifier, cargo, manifest")).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(ctx context.Context) error {
+	store, err := Jets(
+		qm.Select("id, name, color, uuid, identifier, cargo, manifest"),
+		qm.Where("id > ?", 1),
+		qm.And("name <> ?", "thing"),
+		qm.Limit(1),
+		qm.GroupBy("id"),
+		qm.Offset(1),
+	).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(ctx context.Context) error {
+	return a.jet.Insert(ctx, a.db, boil.Infer())
+}
+
+// Update a jet
+func (a *Adapter) Update(ctx context.Context) error {
+	_, err := a.jet.Update(ctx, a.db, boil.Infer())
+	return err
+}
+
+// Delete a jet
+func (a *Adapter) Delete(ctx context.Context) error {
+	_, err := a.jet.Delete(ctx, a.db)
+	return err
+}
+
+// Upsert a jet, updating it when its id is taken
+func (a *Adapter) Upsert(ctx context.Context) error {
+	return a.jet.Upsert(ctx, a.db, true, []string{JetColumns.ID}, boil.Infer(), boil.Infer())
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(ctx context.Context) error {
+	var store []*Jet
+	err := queries.Raw("select * from jets").Bind(ctx, a.db, &store)
+	a.jets = store
+	return err
+}
+
+// EagerLoad jets with their pilots
+func (a *Adapter) EagerLoad(ctx context.Context) error {
+	store, err := Jets(qm.Load(JetRels.Pilot)).All(ctx, a.db)
+	a.jets = store
+	return err
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j *Jet) bench.Jet {
+	c := bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+	if j.R != nil && j.R.Pilot != nil {
+		c.Pilot = &bench.Pilot{ID: int64(j.R.Pilot.ID), Name: j.R.Pilot.Name}
+	}
+	return c
+}
@@ -0,0 +1,924 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_noautotimestamps
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Hangar is an object representing the database table.
+type Hangar struct {
+	ID   int    `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name string `db:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+
+	R *hangarR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L hangarL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HangarColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "id",
+	Name: "name",
+}
+
+var HangarTableColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "hangars.id",
+	Name: "hangars.name",
+}
+
+// Generated where
+
+var HangarWhere = struct {
+	ID   whereHelperint
+	Name whereHelperstring
+}{
+	ID:   whereHelperint{field: "\"hangars\".\"id\""},
+	Name: whereHelperstring{field: "\"hangars\".\"name\""},
+}
+
+// HangarRels is where relationship names are stored.
+var HangarRels = struct {
+}{}
+
+// hangarR is where relationships are stored.
+type hangarR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*hangarR) NewStruct() *hangarR {
+	return &hangarR{}
+}
+
+// hangarL is where Load methods for each relationship are stored.
+type hangarL struct{}
+
+var (
+	hangarAllColumns            = []string{"id", "name"}
+	hangarColumnsWithoutDefault = []string{"name"}
+	hangarColumnsWithDefault    = []string{"id"}
+	hangarPrimaryKeyColumns     = []string{"id"}
+	hangarGeneratedColumns      = []string{}
+)
+
+type (
+	// HangarSlice is an alias for a slice of pointers to Hangar.
+	// This should almost always be used instead of []Hangar.
+	HangarSlice []*Hangar
+	// HangarHook is the signature for custom Hangar hook methods
+	HangarHook func(context.Context, boil.ContextExecutor, *Hangar) error
+
+	hangarQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	hangarType                 = reflect.TypeOf(&Hangar{})
+	hangarMapping              = queries.MakeStructMapping(hangarType)
+	hangarPrimaryKeyMapping, _ = queries.BindMapping(hangarType, hangarMapping, hangarPrimaryKeyColumns)
+	hangarInsertCacheMut       sync.RWMutex
+	hangarInsertCache          = make(map[string]insertCache)
+	hangarUpdateCacheMut       sync.RWMutex
+	hangarUpdateCache          = make(map[string]updateCache)
+	hangarUpsertCacheMut       sync.RWMutex
+	hangarUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var hangarAfterSelectMu sync.Mutex
+var hangarAfterSelectHooks []HangarHook
+
+var hangarBeforeInsertMu sync.Mutex
+var hangarBeforeInsertHooks []HangarHook
+var hangarAfterInsertMu sync.Mutex
+var hangarAfterInsertHooks []HangarHook
+
+var hangarBeforeUpdateMu sync.Mutex
+var hangarBeforeUpdateHooks []HangarHook
+var hangarAfterUpdateMu sync.Mutex
+var hangarAfterUpdateHooks []HangarHook
+
+var hangarBeforeDeleteMu sync.Mutex
+var hangarBeforeDeleteHooks []HangarHook
+var hangarAfterDeleteMu sync.Mutex
+var hangarAfterDeleteHooks []HangarHook
+
+var hangarBeforeUpsertMu sync.Mutex
+var hangarBeforeUpsertHooks []HangarHook
+var hangarAfterUpsertMu sync.Mutex
+var hangarAfterUpsertHooks []HangarHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Hangar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Hangar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Hangar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Hangar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Hangar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Hangar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Hangar) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Hangar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Hangar) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range hangarAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHangarHook registers your hook function for all future operations.
+func AddHangarHook(hookPoint boil.HookPoint, hangarHook HangarHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		hangarAfterSelectMu.Lock()
+		hangarAfterSelectHooks = append(hangarAfterSelectHooks, hangarHook)
+		hangarAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		hangarBeforeInsertMu.Lock()
+		hangarBeforeInsertHooks = append(hangarBeforeInsertHooks, hangarHook)
+		hangarBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		hangarAfterInsertMu.Lock()
+		hangarAfterInsertHooks = append(hangarAfterInsertHooks, hangarHook)
+		hangarAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		hangarBeforeUpdateMu.Lock()
+		hangarBeforeUpdateHooks = append(hangarBeforeUpdateHooks, hangarHook)
+		hangarBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		hangarAfterUpdateMu.Lock()
+		hangarAfterUpdateHooks = append(hangarAfterUpdateHooks, hangarHook)
+		hangarAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		hangarBeforeDeleteMu.Lock()
+		hangarBeforeDeleteHooks = append(hangarBeforeDeleteHooks, hangarHook)
+		hangarBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		hangarAfterDeleteMu.Lock()
+		hangarAfterDeleteHooks = append(hangarAfterDeleteHooks, hangarHook)
+		hangarAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		hangarBeforeUpsertMu.Lock()
+		hangarBeforeUpsertHooks = append(hangarBeforeUpsertHooks, hangarHook)
+		hangarBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		hangarAfterUpsertMu.Lock()
+		hangarAfterUpsertHooks = append(hangarAfterUpsertHooks, hangarHook)
+		hangarAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single hangar record from the query.
+func (q hangarQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Hangar, error) {
+	o := &Hangar{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_noautotimestamps: failed to execute a one query for hangars")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Hangar records from the query.
+func (q hangarQuery) All(ctx context.Context, exec boil.ContextExecutor) (HangarSlice, error) {
+	var o []*Hangar
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_noautotimestamps: failed to assign all query results to Hangar slice")
+	}
+
+	if len(hangarAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Hangar records in the query.
+func (q hangarQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to count hangars rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q hangarQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_noautotimestamps: failed to check if hangars exists")
+	}
+
+	return count > 0, nil
+}
+
+// Hangars retrieves all the records using an executor.
+func Hangars(mods ...qm.QueryMod) hangarQuery {
+	mods = append(mods, qm.From("\"hangars\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"hangars\".*"})
+	}
+
+	return hangarQuery{q}
+}
+
+// FindHangar retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHangar(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Hangar, error) {
+	hangarObj := &Hangar{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"hangars\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, hangarObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_noautotimestamps: unable to select from hangars")
+	}
+
+	if err = hangarObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return hangarObj, err
+	}
+
+	return hangarObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Hangar) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_noautotimestamps: no hangars provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(hangarColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	hangarInsertCacheMut.RLock()
+	cache, cached := hangarInsertCache[key]
+	hangarInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			hangarAllColumns,
+			hangarColumnsWithDefault,
+			hangarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(hangarType, hangarMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(hangarType, hangarMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"hangars\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"hangars\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to insert into hangars")
+	}
+
+	if !cached {
+		hangarInsertCacheMut.Lock()
+		hangarInsertCache[key] = cache
+		hangarInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Hangar.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Hangar) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	hangarUpdateCacheMut.RLock()
+	cache, cached := hangarUpdateCache[key]
+	hangarUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			hangarAllColumns,
+			hangarPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models_noautotimestamps: unable to update hangars, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"hangars\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, hangarPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(hangarType, hangarMapping, append(wl, hangarPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update hangars row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by update for hangars")
+	}
+
+	if !cached {
+		hangarUpdateCacheMut.Lock()
+		hangarUpdateCache[key] = cache
+		hangarUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q hangarQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update all for hangars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to retrieve rows affected for hangars")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HangarSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_noautotimestamps: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), hangarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"hangars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, hangarPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update all in hangar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to retrieve rows affected all in update all hangar")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Hangar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models_noautotimestamps: no hangars provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(hangarColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	hangarUpsertCacheMut.RLock()
+	cache, cached := hangarUpsertCache[key]
+	hangarUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			hangarAllColumns,
+			hangarColumnsWithDefault,
+			hangarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			hangarAllColumns,
+			hangarPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models_noautotimestamps: unable to upsert hangars, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(hangarAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(hangarPrimaryKeyColumns) == 0 {
+				return errors.New("models_noautotimestamps: unable to upsert hangars, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(hangarPrimaryKeyColumns))
+			copy(conflict, hangarPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"hangars\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(hangarType, hangarMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(hangarType, hangarMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to upsert hangars")
+	}
+
+	if !cached {
+		hangarUpsertCacheMut.Lock()
+		hangarUpsertCache[key] = cache
+		hangarUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Hangar record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Hangar) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_noautotimestamps: no Hangar provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), hangarPrimaryKeyMapping)
+	sql := "DELETE FROM \"hangars\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete from hangars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by delete for hangars")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q hangarQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_noautotimestamps: no hangarQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete all from hangars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by deleteall for hangars")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HangarSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(hangarBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), hangarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"hangars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, hangarPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete all from hangar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by deleteall for hangars")
+	}
+
+	if len(hangarAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Hangar) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindHangar(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HangarSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HangarSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), hangarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"hangars\".* FROM \"hangars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, hangarPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to reload all in HangarSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HangarExists checks if the Hangar row exists.
+func HangarExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"hangars\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_noautotimestamps: unable to check if hangars exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Hangar row exists.
+func (o *Hangar) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return HangarExists(ctx, exec, o.ID)
+}
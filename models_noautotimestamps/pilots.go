@@ -0,0 +1,1706 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_noautotimestamps
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Pilot is an object representing the database table.
+type Pilot struct {
+	ID   int    `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name string `db:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+
+	R *pilotR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pilotL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PilotColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "id",
+	Name: "name",
+}
+
+var PilotTableColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "pilots.id",
+	Name: "pilots.name",
+}
+
+// Generated where
+
+var PilotWhere = struct {
+	ID   whereHelperint
+	Name whereHelperstring
+}{
+	ID:   whereHelperint{field: "\"pilots\".\"id\""},
+	Name: whereHelperstring{field: "\"pilots\".\"name\""},
+}
+
+// PilotRels is where relationship names are stored.
+var PilotRels = struct {
+	Jets      string
+	Licenses  string
+	Languages string
+}{
+	Jets:      "Jets",
+	Licenses:  "Licenses",
+	Languages: "Languages",
+}
+
+// pilotR is where relationships are stored.
+type pilotR struct {
+	Jets      JetSlice      `db:"Jets" boil:"Jets" json:"Jets" toml:"Jets" yaml:"Jets"`
+	Licenses  LicenseSlice  `db:"Licenses" boil:"Licenses" json:"Licenses" toml:"Licenses" yaml:"Licenses"`
+	Languages LanguageSlice `db:"Languages" boil:"Languages" json:"Languages" toml:"Languages" yaml:"Languages"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pilotR) NewStruct() *pilotR {
+	return &pilotR{}
+}
+
+func (o *Pilot) GetJets() JetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetJets()
+}
+
+func (r *pilotR) GetJets() JetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Jets
+}
+
+func (o *Pilot) GetLicenses() LicenseSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLicenses()
+}
+
+func (r *pilotR) GetLicenses() LicenseSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Licenses
+}
+
+func (o *Pilot) GetLanguages() LanguageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLanguages()
+}
+
+func (r *pilotR) GetLanguages() LanguageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Languages
+}
+
+// pilotL is where Load methods for each relationship are stored.
+type pilotL struct{}
+
+var (
+	pilotAllColumns            = []string{"id", "name"}
+	pilotColumnsWithoutDefault = []string{"name"}
+	pilotColumnsWithDefault    = []string{"id"}
+	pilotPrimaryKeyColumns     = []string{"id"}
+	pilotGeneratedColumns      = []string{}
+)
+
+type (
+	// PilotSlice is an alias for a slice of pointers to Pilot.
+	// This should almost always be used instead of []Pilot.
+	PilotSlice []*Pilot
+	// PilotHook is the signature for custom Pilot hook methods
+	PilotHook func(context.Context, boil.ContextExecutor, *Pilot) error
+
+	pilotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pilotType                 = reflect.TypeOf(&Pilot{})
+	pilotMapping              = queries.MakeStructMapping(pilotType)
+	pilotPrimaryKeyMapping, _ = queries.BindMapping(pilotType, pilotMapping, pilotPrimaryKeyColumns)
+	pilotInsertCacheMut       sync.RWMutex
+	pilotInsertCache          = make(map[string]insertCache)
+	pilotUpdateCacheMut       sync.RWMutex
+	pilotUpdateCache          = make(map[string]updateCache)
+	pilotUpsertCacheMut       sync.RWMutex
+	pilotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pilotAfterSelectMu sync.Mutex
+var pilotAfterSelectHooks []PilotHook
+
+var pilotBeforeInsertMu sync.Mutex
+var pilotBeforeInsertHooks []PilotHook
+var pilotAfterInsertMu sync.Mutex
+var pilotAfterInsertHooks []PilotHook
+
+var pilotBeforeUpdateMu sync.Mutex
+var pilotBeforeUpdateHooks []PilotHook
+var pilotAfterUpdateMu sync.Mutex
+var pilotAfterUpdateHooks []PilotHook
+
+var pilotBeforeDeleteMu sync.Mutex
+var pilotBeforeDeleteHooks []PilotHook
+var pilotAfterDeleteMu sync.Mutex
+var pilotAfterDeleteHooks []PilotHook
+
+var pilotBeforeUpsertMu sync.Mutex
+var pilotBeforeUpsertHooks []PilotHook
+var pilotAfterUpsertMu sync.Mutex
+var pilotAfterUpsertHooks []PilotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Pilot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Pilot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Pilot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Pilot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Pilot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Pilot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Pilot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Pilot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Pilot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pilotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPilotHook registers your hook function for all future operations.
+func AddPilotHook(hookPoint boil.HookPoint, pilotHook PilotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pilotAfterSelectMu.Lock()
+		pilotAfterSelectHooks = append(pilotAfterSelectHooks, pilotHook)
+		pilotAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pilotBeforeInsertMu.Lock()
+		pilotBeforeInsertHooks = append(pilotBeforeInsertHooks, pilotHook)
+		pilotBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pilotAfterInsertMu.Lock()
+		pilotAfterInsertHooks = append(pilotAfterInsertHooks, pilotHook)
+		pilotAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pilotBeforeUpdateMu.Lock()
+		pilotBeforeUpdateHooks = append(pilotBeforeUpdateHooks, pilotHook)
+		pilotBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pilotAfterUpdateMu.Lock()
+		pilotAfterUpdateHooks = append(pilotAfterUpdateHooks, pilotHook)
+		pilotAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pilotBeforeDeleteMu.Lock()
+		pilotBeforeDeleteHooks = append(pilotBeforeDeleteHooks, pilotHook)
+		pilotBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pilotAfterDeleteMu.Lock()
+		pilotAfterDeleteHooks = append(pilotAfterDeleteHooks, pilotHook)
+		pilotAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pilotBeforeUpsertMu.Lock()
+		pilotBeforeUpsertHooks = append(pilotBeforeUpsertHooks, pilotHook)
+		pilotBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pilotAfterUpsertMu.Lock()
+		pilotAfterUpsertHooks = append(pilotAfterUpsertHooks, pilotHook)
+		pilotAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pilot record from the query.
+func (q pilotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Pilot, error) {
+	o := &Pilot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_noautotimestamps: failed to execute a one query for pilots")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Pilot records from the query.
+func (q pilotQuery) All(ctx context.Context, exec boil.ContextExecutor) (PilotSlice, error) {
+	var o []*Pilot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_noautotimestamps: failed to assign all query results to Pilot slice")
+	}
+
+	if len(pilotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Pilot records in the query.
+func (q pilotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to count pilots rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pilotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_noautotimestamps: failed to check if pilots exists")
+	}
+
+	return count > 0, nil
+}
+
+// Jets retrieves all the jet's Jets with an executor.
+func (o *Pilot) Jets(mods ...qm.QueryMod) jetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"jets\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Jets(queryMods...)
+}
+
+// Licenses retrieves all the license's Licenses with an executor.
+func (o *Pilot) Licenses(mods ...qm.QueryMod) licenseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"licenses\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Licenses(queryMods...)
+}
+
+// Languages retrieves all the language's Languages with an executor.
+func (o *Pilot) Languages(mods ...qm.QueryMod) languageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"pilot_languages\" on \"languages\".\"id\" = \"pilot_languages\".\"language_id\""),
+		qm.Where("\"pilot_languages\".\"pilot_id\"=?", o.ID),
+	)
+
+	return Languages(queryMods...)
+}
+
+// LoadJets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadJets(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`jets`),
+		qm.WhereIn(`jets.pilot_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load jets")
+	}
+
+	var resultSlice []*Jet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice jets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on jets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for jets")
+	}
+
+	if len(jetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Jets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &jetR{}
+			}
+			foreign.R.Pilot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PilotID {
+				local.R.Jets = append(local.R.Jets, foreign)
+				if foreign.R == nil {
+					foreign.R = &jetR{}
+				}
+				foreign.R.Pilot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLicenses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadLicenses(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`licenses`),
+		qm.WhereIn(`licenses.pilot_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load licenses")
+	}
+
+	var resultSlice []*License
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice licenses")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on licenses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for licenses")
+	}
+
+	if len(licenseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Licenses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &licenseR{}
+			}
+			foreign.R.Pilot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PilotID) {
+				local.R.Licenses = append(local.R.Licenses, foreign)
+				if foreign.R == nil {
+					foreign.R = &licenseR{}
+				}
+				foreign.R.Pilot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLanguages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pilotL) LoadLanguages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {
+	var slice []*Pilot
+	var object *Pilot
+
+	if singular {
+		var ok bool
+		object, ok = maybePilot.(*Pilot)
+		if !ok {
+			object = new(Pilot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePilot))
+			}
+		}
+	} else {
+		s, ok := maybePilot.(*[]*Pilot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePilot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePilot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pilotR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pilotR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"languages\".\"id\", \"languages\".\"language\", \"a\".\"pilot_id\""),
+		qm.From("\"languages\""),
+		qm.InnerJoin("\"pilot_languages\" as \"a\" on \"languages\".\"id\" = \"a\".\"language_id\""),
+		qm.WhereIn("\"a\".\"pilot_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load languages")
+	}
+
+	var resultSlice []*Language
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Language)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Language, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for languages")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice languages")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on languages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for languages")
+	}
+
+	if len(languageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Languages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &languageR{}
+			}
+			foreign.R.Pilots = append(foreign.R.Pilots, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Languages = append(local.R.Languages, foreign)
+				if foreign.R == nil {
+					foreign.R = &languageR{}
+				}
+				foreign.R.Pilots = append(foreign.R.Pilots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddJets adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Jets.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddJets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Jet) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PilotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"jets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, jetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PilotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Jets: related,
+		}
+	} else {
+		o.R.Jets = append(o.R.Jets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &jetR{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
+// AddLicenses adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Licenses.
+// Sets related.R.Pilot appropriately.
+func (o *Pilot) AddLicenses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*License) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PilotID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"licenses\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pilot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, licensePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PilotID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pilotR{
+			Licenses: related,
+		}
+	} else {
+		o.R.Licenses = append(o.R.Licenses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &licenseR{
+				Pilot: o,
+			}
+		} else {
+			rel.R.Pilot = o
+		}
+	}
+	return nil
+}
+
+// SetLicenses removes all previously related items of the
+// pilot replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Pilot's Licenses accordingly.
+// Replaces o.R.Licenses with related.
+// Sets related.R.Pilot's Licenses accordingly.
+func (o *Pilot) SetLicenses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*License) error {
+	query := "update \"licenses\" set \"pilot_id\" = null where \"pilot_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Licenses {
+			queries.SetScanner(&rel.PilotID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Pilot = nil
+		}
+		o.R.Licenses = nil
+	}
+
+	return o.AddLicenses(ctx, exec, insert, related...)
+}
+
+// RemoveLicenses relationships from objects passed in.
+// Removes related items from R.Licenses (uses pointer comparison, removal does not keep order)
+// Sets related.R.Pilot.
+func (o *Pilot) RemoveLicenses(ctx context.Context, exec boil.ContextExecutor, related ...*License) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PilotID, nil)
+		if rel.R != nil {
+			rel.R.Pilot = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("pilot_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Licenses {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Licenses)
+			if ln > 1 && i < ln-1 {
+				o.R.Licenses[i] = o.R.Licenses[ln-1]
+			}
+			o.R.Licenses = o.R.Licenses[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddLanguages adds the given related objects to the existing relationships
+// of the pilot, optionally inserting them as new records.
+// Appends related to o.R.Languages.
+// Sets related.R.Pilots appropriately.
+func (o *Pilot) AddLanguages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Language) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"pilot_languages\" (\"pilot_id\", \"language_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &pilotR{
+			Languages: related,
+		}
+	} else {
+		o.R.Languages = append(o.R.Languages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &languageR{
+				Pilots: PilotSlice{o},
+			}
+		} else {
+			rel.R.Pilots = append(rel.R.Pilots, o)
+		}
+	}
+	return nil
+}
+
+// SetLanguages removes all previously related items of the
+// pilot replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Pilots's Languages accordingly.
+// Replaces o.R.Languages with related.
+// Sets related.R.Pilots's Languages accordingly.
+func (o *Pilot) SetLanguages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Language) error {
+	query := "delete from \"pilot_languages\" where \"pilot_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeLanguagesFromPilotsSlice(o, related)
+	if o.R != nil {
+		o.R.Languages = nil
+	}
+
+	return o.AddLanguages(ctx, exec, insert, related...)
+}
+
+// RemoveLanguages relationships from objects passed in.
+// Removes related items from R.Languages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Pilots.
+func (o *Pilot) RemoveLanguages(ctx context.Context, exec boil.ContextExecutor, related ...*Language) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"pilot_languages\" where \"pilot_id\" = $1 and \"language_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeLanguagesFromPilotsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Languages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Languages)
+			if ln > 1 && i < ln-1 {
+				o.R.Languages[i] = o.R.Languages[ln-1]
+			}
+			o.R.Languages = o.R.Languages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeLanguagesFromPilotsSlice(o *Pilot, related []*Language) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Pilots {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Pilots)
+			if ln > 1 && i < ln-1 {
+				rel.R.Pilots[i] = rel.R.Pilots[ln-1]
+			}
+			rel.R.Pilots = rel.R.Pilots[:ln-1]
+			break
+		}
+	}
+}
+
+// Pilots retrieves all the records using an executor.
+func Pilots(mods ...qm.QueryMod) pilotQuery {
+	mods = append(mods, qm.From("\"pilots\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"pilots\".*"})
+	}
+
+	return pilotQuery{q}
+}
+
+// FindPilot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPilot(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Pilot, error) {
+	pilotObj := &Pilot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"pilots\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pilotObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_noautotimestamps: unable to select from pilots")
+	}
+
+	if err = pilotObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pilotObj, err
+	}
+
+	return pilotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Pilot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_noautotimestamps: no pilots provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pilotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pilotInsertCacheMut.RLock()
+	cache, cached := pilotInsertCache[key]
+	pilotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pilotAllColumns,
+			pilotColumnsWithDefault,
+			pilotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pilotType, pilotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pilotType, pilotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"pilots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"pilots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to insert into pilots")
+	}
+
+	if !cached {
+		pilotInsertCacheMut.Lock()
+		pilotInsertCache[key] = cache
+		pilotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Pilot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Pilot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pilotUpdateCacheMut.RLock()
+	cache, cached := pilotUpdateCache[key]
+	pilotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pilotAllColumns,
+			pilotPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models_noautotimestamps: unable to update pilots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"pilots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pilotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pilotType, pilotMapping, append(wl, pilotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update pilots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by update for pilots")
+	}
+
+	if !cached {
+		pilotUpdateCacheMut.Lock()
+		pilotUpdateCache[key] = cache
+		pilotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pilotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update all for pilots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to retrieve rows affected for pilots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PilotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_noautotimestamps: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pilotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"pilots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pilotPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to update all in pilot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to retrieve rows affected all in update all pilot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Pilot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models_noautotimestamps: no pilots provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pilotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pilotUpsertCacheMut.RLock()
+	cache, cached := pilotUpsertCache[key]
+	pilotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pilotAllColumns,
+			pilotColumnsWithDefault,
+			pilotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pilotAllColumns,
+			pilotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models_noautotimestamps: unable to upsert pilots, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pilotAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pilotPrimaryKeyColumns) == 0 {
+				return errors.New("models_noautotimestamps: unable to upsert pilots, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pilotPrimaryKeyColumns))
+			copy(conflict, pilotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"pilots\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pilotType, pilotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pilotType, pilotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to upsert pilots")
+	}
+
+	if !cached {
+		pilotUpsertCacheMut.Lock()
+		pilotUpsertCache[key] = cache
+		pilotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Pilot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Pilot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_noautotimestamps: no Pilot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pilotPrimaryKeyMapping)
+	sql := "DELETE FROM \"pilots\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete from pilots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by delete for pilots")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pilotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_noautotimestamps: no pilotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete all from pilots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by deleteall for pilots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PilotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pilotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pilotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"pilots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pilotPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: unable to delete all from pilot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_noautotimestamps: failed to get rows affected by deleteall for pilots")
+	}
+
+	if len(pilotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Pilot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPilot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PilotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PilotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pilotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"pilots\".* FROM \"pilots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pilotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_noautotimestamps: unable to reload all in PilotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PilotExists checks if the Pilot row exists.
+func PilotExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"pilots\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_noautotimestamps: unable to check if pilots exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Pilot row exists.
+func (o *Pilot) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PilotExists(ctx, exec, o.ID)
+}
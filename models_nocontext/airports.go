@@ -0,0 +1,1145 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_nocontext
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Airport is an object representing the database table.
+type Airport struct {
+	ID   int      `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Size null.Int `db:"size" boil:"size" json:"size,omitempty" toml:"size" yaml:"size,omitempty"`
+
+	R *airportR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L airportL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AirportColumns = struct {
+	ID   string
+	Size string
+}{
+	ID:   "id",
+	Size: "size",
+}
+
+var AirportTableColumns = struct {
+	ID   string
+	Size string
+}{
+	ID:   "airports.id",
+	Size: "airports.size",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var AirportWhere = struct {
+	ID   whereHelperint
+	Size whereHelpernull_Int
+}{
+	ID:   whereHelperint{field: "\"airports\".\"id\""},
+	Size: whereHelpernull_Int{field: "\"airports\".\"size\""},
+}
+
+// AirportRels is where relationship names are stored.
+var AirportRels = struct {
+	Jets string
+}{
+	Jets: "Jets",
+}
+
+// airportR is where relationships are stored.
+type airportR struct {
+	Jets JetSlice `db:"Jets" boil:"Jets" json:"Jets" toml:"Jets" yaml:"Jets"`
+}
+
+// NewStruct creates a new relationship struct
+func (*airportR) NewStruct() *airportR {
+	return &airportR{}
+}
+
+func (o *Airport) GetJets() JetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetJets()
+}
+
+func (r *airportR) GetJets() JetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Jets
+}
+
+// airportL is where Load methods for each relationship are stored.
+type airportL struct{}
+
+var (
+	airportAllColumns            = []string{"id", "size"}
+	airportColumnsWithoutDefault = []string{}
+	airportColumnsWithDefault    = []string{"id", "size"}
+	airportPrimaryKeyColumns     = []string{"id"}
+	airportGeneratedColumns      = []string{}
+)
+
+type (
+	// AirportSlice is an alias for a slice of pointers to Airport.
+	// This should almost always be used instead of []Airport.
+	AirportSlice []*Airport
+	// AirportHook is the signature for custom Airport hook methods
+	AirportHook func(boil.Executor, *Airport) error
+
+	airportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	airportType                 = reflect.TypeOf(&Airport{})
+	airportMapping              = queries.MakeStructMapping(airportType)
+	airportPrimaryKeyMapping, _ = queries.BindMapping(airportType, airportMapping, airportPrimaryKeyColumns)
+	airportInsertCacheMut       sync.RWMutex
+	airportInsertCache          = make(map[string]insertCache)
+	airportUpdateCacheMut       sync.RWMutex
+	airportUpdateCache          = make(map[string]updateCache)
+	airportUpsertCacheMut       sync.RWMutex
+	airportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var airportAfterSelectMu sync.Mutex
+var airportAfterSelectHooks []AirportHook
+
+var airportBeforeInsertMu sync.Mutex
+var airportBeforeInsertHooks []AirportHook
+var airportAfterInsertMu sync.Mutex
+var airportAfterInsertHooks []AirportHook
+
+var airportBeforeUpdateMu sync.Mutex
+var airportBeforeUpdateHooks []AirportHook
+var airportAfterUpdateMu sync.Mutex
+var airportAfterUpdateHooks []AirportHook
+
+var airportBeforeDeleteMu sync.Mutex
+var airportBeforeDeleteHooks []AirportHook
+var airportAfterDeleteMu sync.Mutex
+var airportAfterDeleteHooks []AirportHook
+
+var airportBeforeUpsertMu sync.Mutex
+var airportBeforeUpsertHooks []AirportHook
+var airportAfterUpsertMu sync.Mutex
+var airportAfterUpsertHooks []AirportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Airport) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Airport) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Airport) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Airport) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Airport) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Airport) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Airport) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Airport) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Airport) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range airportAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAirportHook registers your hook function for all future operations.
+func AddAirportHook(hookPoint boil.HookPoint, airportHook AirportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		airportAfterSelectMu.Lock()
+		airportAfterSelectHooks = append(airportAfterSelectHooks, airportHook)
+		airportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		airportBeforeInsertMu.Lock()
+		airportBeforeInsertHooks = append(airportBeforeInsertHooks, airportHook)
+		airportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		airportAfterInsertMu.Lock()
+		airportAfterInsertHooks = append(airportAfterInsertHooks, airportHook)
+		airportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		airportBeforeUpdateMu.Lock()
+		airportBeforeUpdateHooks = append(airportBeforeUpdateHooks, airportHook)
+		airportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		airportAfterUpdateMu.Lock()
+		airportAfterUpdateHooks = append(airportAfterUpdateHooks, airportHook)
+		airportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		airportBeforeDeleteMu.Lock()
+		airportBeforeDeleteHooks = append(airportBeforeDeleteHooks, airportHook)
+		airportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		airportAfterDeleteMu.Lock()
+		airportAfterDeleteHooks = append(airportAfterDeleteHooks, airportHook)
+		airportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		airportBeforeUpsertMu.Lock()
+		airportBeforeUpsertHooks = append(airportBeforeUpsertHooks, airportHook)
+		airportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		airportAfterUpsertMu.Lock()
+		airportAfterUpsertHooks = append(airportAfterUpsertHooks, airportHook)
+		airportAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single airport record from the query.
+func (q airportQuery) One(exec boil.Executor) (*Airport, error) {
+	o := &Airport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: failed to execute a one query for airports")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Airport records from the query.
+func (q airportQuery) All(exec boil.Executor) (AirportSlice, error) {
+	var o []*Airport
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_nocontext: failed to assign all query results to Airport slice")
+	}
+
+	if len(airportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Airport records in the query.
+func (q airportQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to count airports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q airportQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: failed to check if airports exists")
+	}
+
+	return count > 0, nil
+}
+
+// Jets retrieves all the jet's Jets with an executor.
+func (o *Airport) Jets(mods ...qm.QueryMod) jetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"jets\".\"airport_id\"=?", o.ID),
+	)
+
+	return Jets(queryMods...)
+}
+
+// LoadJets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (airportL) LoadJets(e boil.Executor, singular bool, maybeAirport interface{}, mods queries.Applicator) error {
+	var slice []*Airport
+	var object *Airport
+
+	if singular {
+		var ok bool
+		object, ok = maybeAirport.(*Airport)
+		if !ok {
+			object = new(Airport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAirport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAirport))
+			}
+		}
+	} else {
+		s, ok := maybeAirport.(*[]*Airport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAirport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAirport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &airportR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &airportR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`jets`),
+		qm.WhereIn(`jets.airport_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load jets")
+	}
+
+	var resultSlice []*Jet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice jets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on jets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for jets")
+	}
+
+	if len(jetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Jets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &jetR{}
+			}
+			foreign.R.Airport = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AirportID {
+				local.R.Jets = append(local.R.Jets, foreign)
+				if foreign.R == nil {
+					foreign.R = &jetR{}
+				}
+				foreign.R.Airport = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddJets adds the given related objects to the existing relationships
+// of the airport, optionally inserting them as new records.
+// Appends related to o.R.Jets.
+// Sets related.R.Airport appropriately.
+func (o *Airport) AddJets(exec boil.Executor, insert bool, related ...*Jet) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AirportID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"jets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"airport_id"}),
+				strmangle.WhereClause("\"", "\"", 2, jetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AirportID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &airportR{
+			Jets: related,
+		}
+	} else {
+		o.R.Jets = append(o.R.Jets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &jetR{
+				Airport: o,
+			}
+		} else {
+			rel.R.Airport = o
+		}
+	}
+	return nil
+}
+
+// Airports retrieves all the records using an executor.
+func Airports(mods ...qm.QueryMod) airportQuery {
+	mods = append(mods, qm.From("\"airports\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"airports\".*"})
+	}
+
+	return airportQuery{q}
+}
+
+// FindAirport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAirport(exec boil.Executor, iD int, selectCols ...string) (*Airport, error) {
+	airportObj := &Airport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"airports\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, airportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: unable to select from airports")
+	}
+
+	if err = airportObj.doAfterSelectHooks(exec); err != nil {
+		return airportObj, err
+	}
+
+	return airportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Airport) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_nocontext: no airports provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(airportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	airportInsertCacheMut.RLock()
+	cache, cached := airportInsertCache[key]
+	airportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			airportAllColumns,
+			airportColumnsWithDefault,
+			airportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(airportType, airportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(airportType, airportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"airports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"airports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to insert into airports")
+	}
+
+	if !cached {
+		airportInsertCacheMut.Lock()
+		airportInsertCache[key] = cache
+		airportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the Airport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Airport) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	airportUpdateCacheMut.RLock()
+	cache, cached := airportUpdateCache[key]
+	airportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			airportAllColumns,
+			airportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_nocontext: unable to update airports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"airports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, airportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(airportType, airportMapping, append(wl, airportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update airports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by update for airports")
+	}
+
+	if !cached {
+		airportUpdateCacheMut.Lock()
+		airportUpdateCache[key] = cache
+		airportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q airportQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all for airports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected for airports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AirportSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_nocontext: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), airportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"airports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, airportPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all in airport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected all in update all airport")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Airport) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models_nocontext: no airports provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(airportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	airportUpsertCacheMut.RLock()
+	cache, cached := airportUpsertCache[key]
+	airportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			airportAllColumns,
+			airportColumnsWithDefault,
+			airportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			airportAllColumns,
+			airportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models_nocontext: unable to upsert airports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(airportAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(airportPrimaryKeyColumns) == 0 {
+				return errors.New("models_nocontext: unable to upsert airports, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(airportPrimaryKeyColumns))
+			copy(conflict, airportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"airports\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(airportType, airportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(airportType, airportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to upsert airports")
+	}
+
+	if !cached {
+		airportUpsertCacheMut.Lock()
+		airportUpsertCache[key] = cache
+		airportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single Airport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Airport) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_nocontext: no Airport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), airportPrimaryKeyMapping)
+	sql := "DELETE FROM \"airports\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete from airports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by delete for airports")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q airportQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_nocontext: no airportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from airports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for airports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AirportSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(airportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), airportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"airports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, airportPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from airport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for airports")
+	}
+
+	if len(airportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Airport) Reload(exec boil.Executor) error {
+	ret, err := FindAirport(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AirportSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AirportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), airportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"airports\".* FROM \"airports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, airportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to reload all in AirportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AirportExists checks if the Airport row exists.
+func AirportExists(exec boil.Executor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"airports\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: unable to check if airports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Airport row exists.
+func (o *Airport) Exists(exec boil.Executor) (bool, error) {
+	return AirportExists(exec, o.ID)
+}
@@ -0,0 +1,1379 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_nocontext
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Flight is an object representing the database table.
+type Flight struct {
+	ID         int               `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	JetID      int               `db:"jet_id" boil:"jet_id" json:"jet_id" toml:"jet_id" yaml:"jet_id"`
+	Number     string            `db:"number" boil:"number" json:"number" toml:"number" yaml:"number"`
+	Status     FlightStatus      `db:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	DepartsAt  time.Time         `db:"departs_at" boil:"departs_at" json:"departs_at" toml:"departs_at" yaml:"departs_at"`
+	ArrivesAt  null.Time         `db:"arrives_at" boil:"arrives_at" json:"arrives_at,omitempty" toml:"arrives_at" yaml:"arrives_at,omitempty"`
+	Fare       types.Decimal     `db:"fare" boil:"fare" json:"fare" toml:"fare" yaml:"fare"`
+	BookingRef string            `db:"booking_ref" boil:"booking_ref" json:"booking_ref" toml:"booking_ref" yaml:"booking_ref"`
+	OperatorIP string            `db:"operator_ip" boil:"operator_ip" json:"operator_ip" toml:"operator_ip" yaml:"operator_ip"`
+	Crew       types.StringArray `db:"crew" boil:"crew" json:"crew" toml:"crew" yaml:"crew"`
+	Seats      types.Int64Array  `db:"seats" boil:"seats" json:"seats" toml:"seats" yaml:"seats"`
+	Metadata   types.JSON        `db:"metadata" boil:"metadata" json:"metadata" toml:"metadata" yaml:"metadata"`
+	Notes      null.JSON         `db:"notes" boil:"notes" json:"notes,omitempty" toml:"notes" yaml:"notes,omitempty"`
+
+	R *flightR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L flightL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FlightColumns = struct {
+	ID         string
+	JetID      string
+	Number     string
+	Status     string
+	DepartsAt  string
+	ArrivesAt  string
+	Fare       string
+	BookingRef string
+	OperatorIP string
+	Crew       string
+	Seats      string
+	Metadata   string
+	Notes      string
+}{
+	ID:         "id",
+	JetID:      "jet_id",
+	Number:     "number",
+	Status:     "status",
+	DepartsAt:  "departs_at",
+	ArrivesAt:  "arrives_at",
+	Fare:       "fare",
+	BookingRef: "booking_ref",
+	OperatorIP: "operator_ip",
+	Crew:       "crew",
+	Seats:      "seats",
+	Metadata:   "metadata",
+	Notes:      "notes",
+}
+
+var FlightTableColumns = struct {
+	ID         string
+	JetID      string
+	Number     string
+	Status     string
+	DepartsAt  string
+	ArrivesAt  string
+	Fare       string
+	BookingRef string
+	OperatorIP string
+	Crew       string
+	Seats      string
+	Metadata   string
+	Notes      string
+}{
+	ID:         "flights.id",
+	JetID:      "flights.jet_id",
+	Number:     "flights.number",
+	Status:     "flights.status",
+	DepartsAt:  "flights.departs_at",
+	ArrivesAt:  "flights.arrives_at",
+	Fare:       "flights.fare",
+	BookingRef: "flights.booking_ref",
+	OperatorIP: "flights.operator_ip",
+	Crew:       "flights.crew",
+	Seats:      "flights.seats",
+	Metadata:   "flights.metadata",
+	Notes:      "flights.notes",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
+func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperFlightStatus struct{ field string }
+
+func (w whereHelperFlightStatus) EQ(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperFlightStatus) NEQ(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperFlightStatus) LT(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperFlightStatus) LTE(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperFlightStatus) GT(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperFlightStatus) GTE(x FlightStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperFlightStatus) IN(slice []FlightStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperFlightStatus) NIN(slice []FlightStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertypes_Decimal struct{ field string }
+
+func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Decimal) NEQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Decimal) LT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Decimal) LTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Decimal) GT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var FlightWhere = struct {
+	ID         whereHelperint
+	JetID      whereHelperint
+	Number     whereHelperstring
+	Status     whereHelperFlightStatus
+	DepartsAt  whereHelpertime_Time
+	ArrivesAt  whereHelpernull_Time
+	Fare       whereHelpertypes_Decimal
+	BookingRef whereHelperstring
+	OperatorIP whereHelperstring
+	Crew       whereHelpertypes_StringArray
+	Seats      whereHelpertypes_Int64Array
+	Metadata   whereHelpertypes_JSON
+	Notes      whereHelpernull_JSON
+}{
+	ID:         whereHelperint{field: "\"flights\".\"id\""},
+	JetID:      whereHelperint{field: "\"flights\".\"jet_id\""},
+	Number:     whereHelperstring{field: "\"flights\".\"number\""},
+	Status:     whereHelperFlightStatus{field: "\"flights\".\"status\""},
+	DepartsAt:  whereHelpertime_Time{field: "\"flights\".\"departs_at\""},
+	ArrivesAt:  whereHelpernull_Time{field: "\"flights\".\"arrives_at\""},
+	Fare:       whereHelpertypes_Decimal{field: "\"flights\".\"fare\""},
+	BookingRef: whereHelperstring{field: "\"flights\".\"booking_ref\""},
+	OperatorIP: whereHelperstring{field: "\"flights\".\"operator_ip\""},
+	Crew:       whereHelpertypes_StringArray{field: "\"flights\".\"crew\""},
+	Seats:      whereHelpertypes_Int64Array{field: "\"flights\".\"seats\""},
+	Metadata:   whereHelpertypes_JSON{field: "\"flights\".\"metadata\""},
+	Notes:      whereHelpernull_JSON{field: "\"flights\".\"notes\""},
+}
+
+// FlightRels is where relationship names are stored.
+var FlightRels = struct {
+	Jet string
+}{
+	Jet: "Jet",
+}
+
+// flightR is where relationships are stored.
+type flightR struct {
+	Jet *Jet `db:"Jet" boil:"Jet" json:"Jet" toml:"Jet" yaml:"Jet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*flightR) NewStruct() *flightR {
+	return &flightR{}
+}
+
+func (o *Flight) GetJet() *Jet {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetJet()
+}
+
+func (r *flightR) GetJet() *Jet {
+	if r == nil {
+		return nil
+	}
+
+	return r.Jet
+}
+
+// flightL is where Load methods for each relationship are stored.
+type flightL struct{}
+
+var (
+	flightAllColumns            = []string{"id", "jet_id", "number", "status", "departs_at", "arrives_at", "fare", "booking_ref", "operator_ip", "crew", "seats", "metadata", "notes"}
+	flightColumnsWithoutDefault = []string{"jet_id", "number", "status", "departs_at", "fare", "booking_ref", "operator_ip", "crew", "seats", "metadata"}
+	flightColumnsWithDefault    = []string{"id", "arrives_at", "notes"}
+	flightPrimaryKeyColumns     = []string{"id"}
+	flightGeneratedColumns      = []string{}
+)
+
+type (
+	// FlightSlice is an alias for a slice of pointers to Flight.
+	// This should almost always be used instead of []Flight.
+	FlightSlice []*Flight
+	// FlightHook is the signature for custom Flight hook methods
+	FlightHook func(boil.Executor, *Flight) error
+
+	flightQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	flightType                 = reflect.TypeOf(&Flight{})
+	flightMapping              = queries.MakeStructMapping(flightType)
+	flightPrimaryKeyMapping, _ = queries.BindMapping(flightType, flightMapping, flightPrimaryKeyColumns)
+	flightInsertCacheMut       sync.RWMutex
+	flightInsertCache          = make(map[string]insertCache)
+	flightUpdateCacheMut       sync.RWMutex
+	flightUpdateCache          = make(map[string]updateCache)
+	flightUpsertCacheMut       sync.RWMutex
+	flightUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var flightAfterSelectMu sync.Mutex
+var flightAfterSelectHooks []FlightHook
+
+var flightBeforeInsertMu sync.Mutex
+var flightBeforeInsertHooks []FlightHook
+var flightAfterInsertMu sync.Mutex
+var flightAfterInsertHooks []FlightHook
+
+var flightBeforeUpdateMu sync.Mutex
+var flightBeforeUpdateHooks []FlightHook
+var flightAfterUpdateMu sync.Mutex
+var flightAfterUpdateHooks []FlightHook
+
+var flightBeforeDeleteMu sync.Mutex
+var flightBeforeDeleteHooks []FlightHook
+var flightAfterDeleteMu sync.Mutex
+var flightAfterDeleteHooks []FlightHook
+
+var flightBeforeUpsertMu sync.Mutex
+var flightBeforeUpsertHooks []FlightHook
+var flightAfterUpsertMu sync.Mutex
+var flightAfterUpsertHooks []FlightHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Flight) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Flight) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Flight) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Flight) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Flight) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Flight) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Flight) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Flight) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Flight) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range flightAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFlightHook registers your hook function for all future operations.
+func AddFlightHook(hookPoint boil.HookPoint, flightHook FlightHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		flightAfterSelectMu.Lock()
+		flightAfterSelectHooks = append(flightAfterSelectHooks, flightHook)
+		flightAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		flightBeforeInsertMu.Lock()
+		flightBeforeInsertHooks = append(flightBeforeInsertHooks, flightHook)
+		flightBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		flightAfterInsertMu.Lock()
+		flightAfterInsertHooks = append(flightAfterInsertHooks, flightHook)
+		flightAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		flightBeforeUpdateMu.Lock()
+		flightBeforeUpdateHooks = append(flightBeforeUpdateHooks, flightHook)
+		flightBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		flightAfterUpdateMu.Lock()
+		flightAfterUpdateHooks = append(flightAfterUpdateHooks, flightHook)
+		flightAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		flightBeforeDeleteMu.Lock()
+		flightBeforeDeleteHooks = append(flightBeforeDeleteHooks, flightHook)
+		flightBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		flightAfterDeleteMu.Lock()
+		flightAfterDeleteHooks = append(flightAfterDeleteHooks, flightHook)
+		flightAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		flightBeforeUpsertMu.Lock()
+		flightBeforeUpsertHooks = append(flightBeforeUpsertHooks, flightHook)
+		flightBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		flightAfterUpsertMu.Lock()
+		flightAfterUpsertHooks = append(flightAfterUpsertHooks, flightHook)
+		flightAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single flight record from the query.
+func (q flightQuery) One(exec boil.Executor) (*Flight, error) {
+	o := &Flight{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: failed to execute a one query for flights")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Flight records from the query.
+func (q flightQuery) All(exec boil.Executor) (FlightSlice, error) {
+	var o []*Flight
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_nocontext: failed to assign all query results to Flight slice")
+	}
+
+	if len(flightAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Flight records in the query.
+func (q flightQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to count flights rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q flightQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: failed to check if flights exists")
+	}
+
+	return count > 0, nil
+}
+
+// Jet pointed to by the foreign key.
+func (o *Flight) Jet(mods ...qm.QueryMod) jetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.JetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Jets(queryMods...)
+}
+
+// LoadJet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (flightL) LoadJet(e boil.Executor, singular bool, maybeFlight interface{}, mods queries.Applicator) error {
+	var slice []*Flight
+	var object *Flight
+
+	if singular {
+		var ok bool
+		object, ok = maybeFlight.(*Flight)
+		if !ok {
+			object = new(Flight)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFlight)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFlight))
+			}
+		}
+	} else {
+		s, ok := maybeFlight.(*[]*Flight)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFlight)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFlight))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &flightR{}
+		}
+		args[object.JetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &flightR{}
+			}
+
+			args[obj.JetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`jets`),
+		qm.WhereIn(`jets.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Jet")
+	}
+
+	var resultSlice []*Jet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Jet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for jets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for jets")
+	}
+
+	if len(jetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Jet = foreign
+		if foreign.R == nil {
+			foreign.R = &jetR{}
+		}
+		foreign.R.Flights = append(foreign.R.Flights, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.JetID == foreign.ID {
+				local.R.Jet = foreign
+				if foreign.R == nil {
+					foreign.R = &jetR{}
+				}
+				foreign.R.Flights = append(foreign.R.Flights, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetJet of the flight to the related item.
+// Sets o.R.Jet to related.
+// Adds o to related.R.Flights.
+func (o *Flight) SetJet(exec boil.Executor, insert bool, related *Jet) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"flights\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"jet_id"}),
+		strmangle.WhereClause("\"", "\"", 2, flightPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.JetID = related.ID
+	if o.R == nil {
+		o.R = &flightR{
+			Jet: related,
+		}
+	} else {
+		o.R.Jet = related
+	}
+
+	if related.R == nil {
+		related.R = &jetR{
+			Flights: FlightSlice{o},
+		}
+	} else {
+		related.R.Flights = append(related.R.Flights, o)
+	}
+
+	return nil
+}
+
+// Flights retrieves all the records using an executor.
+func Flights(mods ...qm.QueryMod) flightQuery {
+	mods = append(mods, qm.From("\"flights\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"flights\".*"})
+	}
+
+	return flightQuery{q}
+}
+
+// FindFlight retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFlight(exec boil.Executor, iD int, selectCols ...string) (*Flight, error) {
+	flightObj := &Flight{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"flights\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, flightObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: unable to select from flights")
+	}
+
+	if err = flightObj.doAfterSelectHooks(exec); err != nil {
+		return flightObj, err
+	}
+
+	return flightObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Flight) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_nocontext: no flights provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(flightColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	flightInsertCacheMut.RLock()
+	cache, cached := flightInsertCache[key]
+	flightInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			flightAllColumns,
+			flightColumnsWithDefault,
+			flightColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(flightType, flightMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(flightType, flightMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"flights\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"flights\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to insert into flights")
+	}
+
+	if !cached {
+		flightInsertCacheMut.Lock()
+		flightInsertCache[key] = cache
+		flightInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the Flight.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Flight) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	flightUpdateCacheMut.RLock()
+	cache, cached := flightUpdateCache[key]
+	flightUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			flightAllColumns,
+			flightPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_nocontext: unable to update flights, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"flights\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, flightPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(flightType, flightMapping, append(wl, flightPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update flights row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by update for flights")
+	}
+
+	if !cached {
+		flightUpdateCacheMut.Lock()
+		flightUpdateCache[key] = cache
+		flightUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q flightQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all for flights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected for flights")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FlightSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_nocontext: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), flightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"flights\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, flightPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all in flight slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected all in update all flight")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Flight) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models_nocontext: no flights provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(flightColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	flightUpsertCacheMut.RLock()
+	cache, cached := flightUpsertCache[key]
+	flightUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			flightAllColumns,
+			flightColumnsWithDefault,
+			flightColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			flightAllColumns,
+			flightPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models_nocontext: unable to upsert flights, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(flightAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(flightPrimaryKeyColumns) == 0 {
+				return errors.New("models_nocontext: unable to upsert flights, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(flightPrimaryKeyColumns))
+			copy(conflict, flightPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"flights\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(flightType, flightMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(flightType, flightMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to upsert flights")
+	}
+
+	if !cached {
+		flightUpsertCacheMut.Lock()
+		flightUpsertCache[key] = cache
+		flightUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single Flight record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Flight) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_nocontext: no Flight provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), flightPrimaryKeyMapping)
+	sql := "DELETE FROM \"flights\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete from flights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by delete for flights")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q flightQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_nocontext: no flightQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from flights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for flights")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FlightSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(flightBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), flightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"flights\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, flightPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from flight slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for flights")
+	}
+
+	if len(flightAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Flight) Reload(exec boil.Executor) error {
+	ret, err := FindFlight(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FlightSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FlightSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), flightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"flights\".* FROM \"flights\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, flightPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to reload all in FlightSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FlightExists checks if the Flight row exists.
+func FlightExists(exec boil.Executor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"flights\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: unable to check if flights exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Flight row exists.
+func (o *Flight) Exists(exec boil.Executor) (bool, error) {
+	return FlightExists(exec, o.ID)
+}
@@ -0,0 +1,1191 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models_nocontext
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Language is an object representing the database table.
+type Language struct {
+	ID       int    `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Language string `db:"language" boil:"language" json:"language" toml:"language" yaml:"language"`
+
+	R *languageR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L languageL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LanguageColumns = struct {
+	ID       string
+	Language string
+}{
+	ID:       "id",
+	Language: "language",
+}
+
+var LanguageTableColumns = struct {
+	ID       string
+	Language string
+}{
+	ID:       "languages.id",
+	Language: "languages.language",
+}
+
+// Generated where
+
+var LanguageWhere = struct {
+	ID       whereHelperint
+	Language whereHelperstring
+}{
+	ID:       whereHelperint{field: "\"languages\".\"id\""},
+	Language: whereHelperstring{field: "\"languages\".\"language\""},
+}
+
+// LanguageRels is where relationship names are stored.
+var LanguageRels = struct {
+	Pilots string
+}{
+	Pilots: "Pilots",
+}
+
+// languageR is where relationships are stored.
+type languageR struct {
+	Pilots PilotSlice `db:"Pilots" boil:"Pilots" json:"Pilots" toml:"Pilots" yaml:"Pilots"`
+}
+
+// NewStruct creates a new relationship struct
+func (*languageR) NewStruct() *languageR {
+	return &languageR{}
+}
+
+func (o *Language) GetPilots() PilotSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPilots()
+}
+
+func (r *languageR) GetPilots() PilotSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Pilots
+}
+
+// languageL is where Load methods for each relationship are stored.
+type languageL struct{}
+
+var (
+	languageAllColumns            = []string{"id", "language"}
+	languageColumnsWithoutDefault = []string{"language"}
+	languageColumnsWithDefault    = []string{"id"}
+	languagePrimaryKeyColumns     = []string{"id"}
+	languageGeneratedColumns      = []string{}
+)
+
+type (
+	// LanguageSlice is an alias for a slice of pointers to Language.
+	// This should almost always be used instead of []Language.
+	LanguageSlice []*Language
+	// LanguageHook is the signature for custom Language hook methods
+	LanguageHook func(boil.Executor, *Language) error
+
+	languageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	languageType                 = reflect.TypeOf(&Language{})
+	languageMapping              = queries.MakeStructMapping(languageType)
+	languagePrimaryKeyMapping, _ = queries.BindMapping(languageType, languageMapping, languagePrimaryKeyColumns)
+	languageInsertCacheMut       sync.RWMutex
+	languageInsertCache          = make(map[string]insertCache)
+	languageUpdateCacheMut       sync.RWMutex
+	languageUpdateCache          = make(map[string]updateCache)
+	languageUpsertCacheMut       sync.RWMutex
+	languageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var languageAfterSelectMu sync.Mutex
+var languageAfterSelectHooks []LanguageHook
+
+var languageBeforeInsertMu sync.Mutex
+var languageBeforeInsertHooks []LanguageHook
+var languageAfterInsertMu sync.Mutex
+var languageAfterInsertHooks []LanguageHook
+
+var languageBeforeUpdateMu sync.Mutex
+var languageBeforeUpdateHooks []LanguageHook
+var languageAfterUpdateMu sync.Mutex
+var languageAfterUpdateHooks []LanguageHook
+
+var languageBeforeDeleteMu sync.Mutex
+var languageBeforeDeleteHooks []LanguageHook
+var languageAfterDeleteMu sync.Mutex
+var languageAfterDeleteHooks []LanguageHook
+
+var languageBeforeUpsertMu sync.Mutex
+var languageBeforeUpsertHooks []LanguageHook
+var languageAfterUpsertMu sync.Mutex
+var languageAfterUpsertHooks []LanguageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Language) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Language) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Language) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Language) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Language) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Language) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Language) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Language) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Language) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range languageAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLanguageHook registers your hook function for all future operations.
+func AddLanguageHook(hookPoint boil.HookPoint, languageHook LanguageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		languageAfterSelectMu.Lock()
+		languageAfterSelectHooks = append(languageAfterSelectHooks, languageHook)
+		languageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		languageBeforeInsertMu.Lock()
+		languageBeforeInsertHooks = append(languageBeforeInsertHooks, languageHook)
+		languageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		languageAfterInsertMu.Lock()
+		languageAfterInsertHooks = append(languageAfterInsertHooks, languageHook)
+		languageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		languageBeforeUpdateMu.Lock()
+		languageBeforeUpdateHooks = append(languageBeforeUpdateHooks, languageHook)
+		languageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		languageAfterUpdateMu.Lock()
+		languageAfterUpdateHooks = append(languageAfterUpdateHooks, languageHook)
+		languageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		languageBeforeDeleteMu.Lock()
+		languageBeforeDeleteHooks = append(languageBeforeDeleteHooks, languageHook)
+		languageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		languageAfterDeleteMu.Lock()
+		languageAfterDeleteHooks = append(languageAfterDeleteHooks, languageHook)
+		languageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		languageBeforeUpsertMu.Lock()
+		languageBeforeUpsertHooks = append(languageBeforeUpsertHooks, languageHook)
+		languageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		languageAfterUpsertMu.Lock()
+		languageAfterUpsertHooks = append(languageAfterUpsertHooks, languageHook)
+		languageAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single language record from the query.
+func (q languageQuery) One(exec boil.Executor) (*Language, error) {
+	o := &Language{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: failed to execute a one query for languages")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Language records from the query.
+func (q languageQuery) All(exec boil.Executor) (LanguageSlice, error) {
+	var o []*Language
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models_nocontext: failed to assign all query results to Language slice")
+	}
+
+	if len(languageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Language records in the query.
+func (q languageQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to count languages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q languageQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: failed to check if languages exists")
+	}
+
+	return count > 0, nil
+}
+
+// Pilots retrieves all the pilot's Pilots with an executor.
+func (o *Language) Pilots(mods ...qm.QueryMod) pilotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"pilot_languages\" on \"pilots\".\"id\" = \"pilot_languages\".\"pilot_id\""),
+		qm.Where("\"pilot_languages\".\"language_id\"=?", o.ID),
+	)
+
+	return Pilots(queryMods...)
+}
+
+// LoadPilots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (languageL) LoadPilots(e boil.Executor, singular bool, maybeLanguage interface{}, mods queries.Applicator) error {
+	var slice []*Language
+	var object *Language
+
+	if singular {
+		var ok bool
+		object, ok = maybeLanguage.(*Language)
+		if !ok {
+			object = new(Language)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLanguage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLanguage))
+			}
+		}
+	} else {
+		s, ok := maybeLanguage.(*[]*Language)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLanguage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLanguage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &languageR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &languageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"pilots\".\"id\", \"pilots\".\"name\", \"a\".\"language_id\""),
+		qm.From("\"pilots\""),
+		qm.InnerJoin("\"pilot_languages\" as \"a\" on \"pilots\".\"id\" = \"a\".\"pilot_id\""),
+		qm.WhereIn("\"a\".\"language_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pilots")
+	}
+
+	var resultSlice []*Pilot
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Pilot)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Name, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for pilots")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice pilots")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pilots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pilots")
+	}
+
+	if len(pilotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Pilots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pilotR{}
+			}
+			foreign.R.Languages = append(foreign.R.Languages, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Pilots = append(local.R.Pilots, foreign)
+				if foreign.R == nil {
+					foreign.R = &pilotR{}
+				}
+				foreign.R.Languages = append(foreign.R.Languages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPilots adds the given related objects to the existing relationships
+// of the language, optionally inserting them as new records.
+// Appends related to o.R.Pilots.
+// Sets related.R.Languages appropriately.
+func (o *Language) AddPilots(exec boil.Executor, insert bool, related ...*Pilot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"pilot_languages\" (\"language_id\", \"pilot_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		_, err = exec.Exec(query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &languageR{
+			Pilots: related,
+		}
+	} else {
+		o.R.Pilots = append(o.R.Pilots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pilotR{
+				Languages: LanguageSlice{o},
+			}
+		} else {
+			rel.R.Languages = append(rel.R.Languages, o)
+		}
+	}
+	return nil
+}
+
+// SetPilots removes all previously related items of the
+// language replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Languages's Pilots accordingly.
+// Replaces o.R.Pilots with related.
+// Sets related.R.Languages's Pilots accordingly.
+func (o *Language) SetPilots(exec boil.Executor, insert bool, related ...*Pilot) error {
+	query := "delete from \"pilot_languages\" where \"language_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePilotsFromLanguagesSlice(o, related)
+	if o.R != nil {
+		o.R.Pilots = nil
+	}
+
+	return o.AddPilots(exec, insert, related...)
+}
+
+// RemovePilots relationships from objects passed in.
+// Removes related items from R.Pilots (uses pointer comparison, removal does not keep order)
+// Sets related.R.Languages.
+func (o *Language) RemovePilots(exec boil.Executor, related ...*Pilot) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"pilot_languages\" where \"language_id\" = $1 and \"pilot_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePilotsFromLanguagesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Pilots {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Pilots)
+			if ln > 1 && i < ln-1 {
+				o.R.Pilots[i] = o.R.Pilots[ln-1]
+			}
+			o.R.Pilots = o.R.Pilots[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePilotsFromLanguagesSlice(o *Language, related []*Pilot) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Languages {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Languages)
+			if ln > 1 && i < ln-1 {
+				rel.R.Languages[i] = rel.R.Languages[ln-1]
+			}
+			rel.R.Languages = rel.R.Languages[:ln-1]
+			break
+		}
+	}
+}
+
+// Languages retrieves all the records using an executor.
+func Languages(mods ...qm.QueryMod) languageQuery {
+	mods = append(mods, qm.From("\"languages\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"languages\".*"})
+	}
+
+	return languageQuery{q}
+}
+
+// FindLanguage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLanguage(exec boil.Executor, iD int, selectCols ...string) (*Language, error) {
+	languageObj := &Language{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"languages\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, languageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models_nocontext: unable to select from languages")
+	}
+
+	if err = languageObj.doAfterSelectHooks(exec); err != nil {
+		return languageObj, err
+	}
+
+	return languageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Language) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models_nocontext: no languages provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(languageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	languageInsertCacheMut.RLock()
+	cache, cached := languageInsertCache[key]
+	languageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			languageAllColumns,
+			languageColumnsWithDefault,
+			languageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(languageType, languageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(languageType, languageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"languages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"languages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to insert into languages")
+	}
+
+	if !cached {
+		languageInsertCacheMut.Lock()
+		languageInsertCache[key] = cache
+		languageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the Language.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Language) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	languageUpdateCacheMut.RLock()
+	cache, cached := languageUpdateCache[key]
+	languageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			languageAllColumns,
+			languagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models_nocontext: unable to update languages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"languages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, languagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(languageType, languageMapping, append(wl, languagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update languages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by update for languages")
+	}
+
+	if !cached {
+		languageUpdateCacheMut.Lock()
+		languageUpdateCache[key] = cache
+		languageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q languageQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all for languages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected for languages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LanguageSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models_nocontext: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), languagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"languages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, languagePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to update all in language slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to retrieve rows affected all in update all language")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Language) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models_nocontext: no languages provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(languageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	languageUpsertCacheMut.RLock()
+	cache, cached := languageUpsertCache[key]
+	languageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			languageAllColumns,
+			languageColumnsWithDefault,
+			languageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			languageAllColumns,
+			languagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models_nocontext: unable to upsert languages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(languageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(languagePrimaryKeyColumns) == 0 {
+				return errors.New("models_nocontext: unable to upsert languages, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(languagePrimaryKeyColumns))
+			copy(conflict, languagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"languages\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(languageType, languageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(languageType, languageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to upsert languages")
+	}
+
+	if !cached {
+		languageUpsertCacheMut.Lock()
+		languageUpsertCache[key] = cache
+		languageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single Language record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Language) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models_nocontext: no Language provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), languagePrimaryKeyMapping)
+	sql := "DELETE FROM \"languages\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete from languages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by delete for languages")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q languageQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models_nocontext: no languageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from languages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for languages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LanguageSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(languageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), languagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"languages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, languagePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: unable to delete all from language slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models_nocontext: failed to get rows affected by deleteall for languages")
+	}
+
+	if len(languageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Language) Reload(exec boil.Executor) error {
+	ret, err := FindLanguage(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LanguageSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LanguageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), languagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"languages\".* FROM \"languages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, languagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models_nocontext: unable to reload all in LanguageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LanguageExists checks if the Language row exists.
+func LanguageExists(exec boil.Executor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"languages\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models_nocontext: unable to check if languages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Language row exists.
+func (o *Language) Exists(exec boil.Executor) (bool, error) {
+	return LanguageExists(exec, o.ID)
+}
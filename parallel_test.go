@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// parallelProcs are the GOMAXPROCS the Parallel benchmarks are run at, the
+// throughput of an ORM that holds locks in its caches stops growing with
+// them
+var parallelProcs = []int{1, 2, 4, 8, 16}
+
+// parallelGoroutines is the number of goroutines TestVerifyParallel runs
+// every operation from at once
+const parallelGoroutines = 8
+
+// runParallel creates an op/procs/orm sub-benchmark for every GOMAXPROCS
+// and adapter. Each runs op with b.RunParallel, from one goroutine per
+// GOMAXPROCS, on clones of the adapter sharing its connection.
+func runParallel(b *testing.B, op jetOperation) {
+	for _, procs := range parallelProcs {
+		b.Run(strconv.Itoa(procs), func(b *testing.B) {
+			defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+			for _, a := range adapters() {
+				dsn := fmt.Sprintf("postgres://Parallel%s-%d-%s", op.name, procs, a.Name())
+				mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+
+				if err := a.Open(dsn); err != nil {
+					b.Fatal(err)
+				}
+
+				b.Run(a.Name(), func(b *testing.B) {
+					pa, ok := a.(bench.ParallelAdapter)
+					if !ok {
+						skip(b, "not parallel")
+					}
+					checkOperation(b, op, a.Name(), a, dsn)
+
+					b.ResetTimer()
+					loopParallel(b, func() func() error {
+						ctx, c := context.Background(), pa.Clone()
+						return func() error { return op.run(c, ctx) }
+					})
+				})
+			}
+		})
+	}
+}
+
+// TestVerifyParallel is TestVerify for operations run from many goroutines
+// at once, it's meant to be run with -race. Like the benchmarks, op is run
+// once first so that the ORMs' caches are warm.
+func TestVerifyParallel(t *testing.T) {
+	for _, op := range operations {
+		for _, a := range adapters() {
+			op, a := op, a
+			t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+				pa, ok := a.(bench.ParallelAdapter)
+				if !ok {
+					t.Skip("not parallel")
+				}
+
+				dsn := "postgres://verify-parallel-" + op.name + "-" + a.Name()
+				mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+				if err := a.Open(dsn); err != nil {
+					t.Fatal(err)
+				}
+				if err := op.run(a, context.Background()); errors.Is(err, bench.ErrUnsupported) {
+					t.Skip("unsupported")
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				// Writes are verified once they're all done, against the
+				// statements of every goroutine
+				var wg sync.WaitGroup
+				errs := make([]error, parallelGoroutines)
+				log, _ := runLogged(dsn, func() error {
+					for i := range errs {
+						wg.Add(1)
+						go func(i int) {
+							defer wg.Done()
+
+							c := pa.Clone()
+							for j := 0; j < 10 && errs[i] == nil; j++ {
+								if errs[i] = op.run(c, context.Background()); errs[i] == nil && !op.writes() {
+									errs[i] = op.verify(a.Name(), c, nil)
+								}
+							}
+						}(i)
+					}
+					wg.Wait()
+					return nil
+				})
+
+				for _, err := range errs {
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				if op.writes() {
+					if err := op.verify(a.Name(), pa, log); err != nil {
+						t.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkParallelSelectAll(b *testing.B) {
+	runParallel(b, selectAllOp)
+}
+
+func BenchmarkParallelSelectSubset(b *testing.B) {
+	runParallel(b, selectSubsetOp)
+}
+
+func BenchmarkParallelSelectComplex(b *testing.B) {
+	runParallel(b, selectComplexOp)
+}
+
+func BenchmarkParallelInsert(b *testing.B) {
+	runParallel(b, insertOp)
+}
+
+func BenchmarkParallelUpdate(b *testing.B) {
+	runParallel(b, updateOp)
+}
+
+func BenchmarkParallelDelete(b *testing.B) {
+	runParallel(b, deleteOp)
+}
+
+func BenchmarkParallelRawBind(b *testing.B) {
+	runParallel(b, rawBindOp)
+}
+
+func BenchmarkParallelEagerLoad(b *testing.B) {
+	runParallel(b, eagerLoadOp)
+}
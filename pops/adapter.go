@@ -0,0 +1,150 @@
+package pops
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	"github.com/gobuffalo/pop/v6"
+)
+
+// Adapter implements bench.Adapter for pop
+type Adapter struct {
+	db  *pop.Connection
+	jet Jet
+	rel relations
+
+	// jets are the results of the last select
+	jets []Jet
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "pop" }
+
+// Clone the adapter for another goroutine, sharing its connection. pop
+// keeps the eager mode on the *pop.Connection and Create resets it, so
+// every goroutine gets a copy of the connection the way buffalo gives one
+// to every request.
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db.WithContext(context.Background()), jet: a.jet}
+}
+
+// Open a pop connection to the mimic driver. Pop needs the dsn to look like
+// a postgres url.
+func (a *Adapter) Open(dsn string) error {
+	db, err := pop.NewConnection(&pop.ConnectionDetails{Driver: "mimic", Dialect: "postgres", URL: dsn})
+	if err != nil {
+		return err
+	}
+
+	if err = db.Open(); err != nil {
+		return err
+	}
+
+	a.db = db
+	a.jet = Jet{
+		ID:         1,
+		PilotID:    2,
+		AirportID:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		UUID:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(context.Context) error {
+	var store []Jet
+	err := a.db.All(&store)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(context.Context) error {
+	var store []Jet
+	err := a.db.Select("id, name, color, uuid, identifier, cargo, manifest").All(&store)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(context.Context) error {
+	var store []Jet
+	err := a.db.Select(
+		"id, name, color, uuid, identifier, cargo, manifest").
+		Where("id > ? AND name <> ?", 1, "thing").
+		Limit(1).
+		GroupBy("id").
+		// Offset does not exist, you need to use a paginator.
+		// The Paginator however does another query, which breaks
+		// this integration here, so I have removed it.
+		//
+		//
+		// Paginate(1, 1).
+		All(&store)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(context.Context) error {
+	return a.db.Create(&a.jet)
+}
+
+// Update a jet
+func (a *Adapter) Update(context.Context) error {
+	return a.db.Update(&a.jet)
+}
+
+// Delete a jet
+func (a *Adapter) Delete(context.Context) error {
+	return a.db.Destroy(&a.jet)
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(context.Context) error {
+	var store []Jet
+	err := a.db.RawQuery("select * from jets").All(&store)
+	a.jets = store
+	return err
+}
+
+// EagerLoad jets with their pilots
+func (a *Adapter) EagerLoad(context.Context) error {
+	var store []Jet
+	err := a.db.EagerPreload("Pilot").All(&store)
+	a.jets = store
+	return err
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j Jet) bench.Jet {
+	c := bench.Jet{
+		ID:         int64(j.ID),
+		PilotID:    int64(j.PilotID),
+		AirportID:  int64(j.AirportID),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.UUID,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+	if j.Pilot.ID != 0 {
+		c.Pilot = &bench.Pilot{ID: int64(j.Pilot.ID), Name: j.Pilot.Name}
+	}
+	return c
+}
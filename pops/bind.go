@@ -0,0 +1,28 @@
+package pops
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Bind the rows of a query into jets with sqlx, which pop maps its models
+// with. sqlx rejects columns the model doesn't have unless it's unsafe, as
+// pop's is with ConnectionDetails.Unsafe.
+func (a *Adapter) Bind(ctx context.Context) error {
+	db, ok := a.db.Store.(interface{ Unsafe() *sqlx.DB })
+	if !ok {
+		return errors.New("pop's store isn't an *sqlx.DB")
+	}
+	rows, err := db.Unsafe().QueryxContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var store []Jet
+	err = sqlx.StructScan(rows, &store)
+	a.jets = store
+	return err
+}
@@ -0,0 +1,29 @@
+package pops
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/gobuffalo/pop/v6"
+)
+
+// BuildSelectComplex builds the query of SelectComplex
+func (a *Adapter) BuildSelectComplex(ctx context.Context) (string, []interface{}, error) {
+	sql, args := a.db.Select(
+		"id, name, color, uuid, identifier, cargo, manifest").
+		Where("id > ? AND name <> ?", 1, "thing").
+		Limit(1).
+		GroupBy("id").
+		ToSQL(pop.NewModel(&[]Jet{}, ctx))
+	return sql, args, nil
+}
+
+// BuildInsert is unsupported, pop's dialects build inserts as they run them
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	return "", nil, bench.ErrUnsupported
+}
+
+// BuildUpdate is unsupported, pop's dialects build updates as they run them
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	return "", nil, bench.ErrUnsupported
+}
@@ -0,0 +1,68 @@
+package pops
+
+import (
+	"context"
+	"strings"
+)
+
+// relations are the pilot and the rows the relationship writes work on.
+// pop only loads relationships, the writes are to the join table and the
+// foreign keys themselves. pop can't create rows without an id, insert
+// inserts those of the join table with the args of languages.
+type relations struct {
+	pilot     int
+	ids       []interface{}
+	insert    string
+	languages []interface{}
+}
+
+// Relate sets up a pilot and the rows of n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: 1}
+	rows := make([]string, n)
+	for i := 1; i <= n; i++ {
+		r.ids = append(r.ids, i)
+		r.languages = append(r.languages, r.pilot, i)
+		rows[i-1] = "(?, ?)"
+	}
+	r.insert = "insert into pilot_languages (pilot_id, language_id) values " + strings.Join(rows, ", ")
+	a.rel = r
+}
+
+// AttachLanguages inserts the rows joining the pilot to the languages
+func (a *Adapter) AttachLanguages(context.Context) error {
+	r := &a.rel
+	return a.db.RawQuery(r.insert, r.languages...).Exec()
+}
+
+// ReplaceLanguages deletes every row joining the pilot to a language and
+// inserts those joining it to the languages
+func (a *Adapter) ReplaceLanguages(context.Context) error {
+	r := &a.rel
+	if err := a.db.Where("pilot_id = ?", r.pilot).Delete(&PilotLanguage{}); err != nil {
+		return err
+	}
+	return a.db.RawQuery(r.insert, r.languages...).Exec()
+}
+
+// DetachLanguages deletes the rows joining the pilot to the languages
+func (a *Adapter) DetachLanguages(context.Context) error {
+	r := &a.rel
+	return a.db.Where("pilot_id = ?", r.pilot).Where("language_id in (?)", r.ids...).Delete(&PilotLanguage{})
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(context.Context) error {
+	r := &a.rel
+	return a.db.RawQuery("update jets set pilot_id = ? where id in (?)", r.pilot, r.ids).Exec()
+}
+
+// ReplaceLicenses clears the pilot of its licenses and sets it as the
+// pilot of the licenses
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	r := &a.rel
+	if err := a.db.RawQuery("update licenses set pilot_id = null where pilot_id = ?", r.pilot).Exec(); err != nil {
+		return err
+	}
+	return a.db.RawQuery("update licenses set pilot_id = ? where id in (?)", r.pilot, r.ids).Exec()
+}
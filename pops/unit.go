@@ -0,0 +1,25 @@
+package pops
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/gobuffalo/pop/v6"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(context.Context) error {
+	var jet Jet
+	err := a.db.Transaction(func(tx *pop.Connection) error {
+		if err := tx.Find(&jet, 1); err != nil {
+			return err
+		}
+		if err := tx.Update(&jet); err != nil {
+			return err
+		}
+		return tx.Create(&License{PilotID: null.IntFrom(jet.PilotID)})
+	})
+	a.jets = []Jet{jet}
+	return err
+}
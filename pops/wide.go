@@ -0,0 +1,115 @@
+package pops
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+// Wide returns the operations against the wide table with the given number
+// of columns
+func (a *Adapter) Wide(columns int) bench.Wide {
+	switch columns {
+	case 10:
+		return newWide[Wide10](a, columns)
+	case 25:
+		return newWide[Wide25](a, columns)
+	case 50:
+		return newWide[Wide50](a, columns)
+	case 100:
+		return newWide[Wide100](a, columns)
+	}
+	panic(fmt.Sprintf("no wide table with %d columns", columns))
+}
+
+// wide implements bench.Wide for the model T of a wide table
+type wide[T any] struct {
+	a       *Adapter
+	columns int
+	table   string
+	// rows are the results of the last select, wrote is set when the
+	// last operation wrote row instead
+	row   T
+	rows  []T
+	wrote bool
+}
+
+func newWide[T any](a *Adapter, columns int) *wide[T] {
+	w := &wide[T]{a: a, columns: columns, table: bench.WideTable(columns)}
+	bench.FillRow(&w.row, columns)
+	return w
+}
+
+// SelectAll rows
+func (w *wide[T]) SelectAll(context.Context) error {
+	var store []T
+	err := w.a.db.All(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectSubset of the columns
+func (w *wide[T]) SelectSubset(context.Context) error {
+	var store []T
+	err := w.a.db.Select(strings.Join(bench.WideSubset(w.columns), ", ")).All(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// SelectComplex query on the table, without the offset like the jets query
+func (w *wide[T]) SelectComplex(context.Context) error {
+	var store []T
+	err := w.a.db.Select(strings.Join(bench.WideSubset(w.columns), ", ")).
+		Where("id > ? AND text_003 <> ?", 1, "thing").
+		Limit(1).
+		GroupBy("id").
+		All(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// Insert a row
+func (w *wide[T]) Insert(context.Context) error {
+	w.wrote = true
+	return w.a.db.Create(&w.row)
+}
+
+// Update a row
+func (w *wide[T]) Update(context.Context) error {
+	w.wrote = true
+	return w.a.db.Update(&w.row)
+}
+
+// Delete a row
+func (w *wide[T]) Delete(context.Context) error {
+	w.wrote = true
+	return w.a.db.Destroy(&w.row)
+}
+
+// RawBind a raw query into rows
+func (w *wide[T]) RawBind(context.Context) error {
+	var store []T
+	err := w.a.db.RawQuery("select * from " + w.table).All(&store)
+	w.rows, w.wrote = store, false
+	return err
+}
+
+// EagerLoad is not supported, the pop structs have no associations
+func (w *wide[T]) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last operation
+func (w *wide[T]) Results() []bench.Row {
+	if w.wrote {
+		return []bench.Row{bench.RowOf(w.row, w.columns)}
+	}
+
+	results := make([]bench.Row, len(w.rows))
+	for i, r := range w.rows {
+		results[i] = bench.RowOf(r, w.columns)
+	}
+	return results
+}
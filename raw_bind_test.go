@@ -1,89 +1,26 @@
 package main
 
 import (
-	"context"
-	"database/sql"
-	"github.com/gobuffalo/pop/v6"
 	"testing"
 
-	"github.com/jmoiron/sqlx"
-	"github.com/aarondl/boilbench/gorms"
-	"github.com/aarondl/boilbench/gorps"
+	"github.com/aarondl/boilbench/bench"
 	"github.com/aarondl/boilbench/mimic"
 	"github.com/aarondl/boilbench/models"
-	"github.com/aarondl/boilbench/xorms"
-	"github.com/aarondl/sqlboiler/v4/queries"
-	"gopkg.in/gorp.v1"
-	"gorm.io/gorm"
-	"xorm.io/xorm"
+	"github.com/jmoiron/sqlx"
 )
 
-func BenchmarkGORMRawBind(b *testing.B) {
-	query := jetQuery()
-	mimic.NewQuery(query)
-
-	gormdb, err := gorm.Open(gormMimicDialector, &gorm.Config{})
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("gorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			var store []gorms.Jet
-			err := gormdb.Raw("select * from jets").Scan(&store).Error
-			if err != nil {
-				b.Fatal(err)
-			}
-			store = nil
-		}
-	})
-}
-
-func BenchmarkGORPRawBind(b *testing.B) {
-	query := jetQuery()
-	mimic.NewQuery(query)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	gorpdb := &gorp.DbMap{Db: db, Dialect: gorp.PostgresDialect{}}
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("gorp", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			var store []gorps.Jet
-			_, err = gorpdb.Select(&store, "select * from jets")
-			if err != nil {
-				b.Fatal(err)
-			}
-			store = nil
-		}
-	})
+var rawBindOp = jetOperation{
+	name:    "RawBind",
+	run:     bench.Adapter.RawBind,
+	fixture: fixtures(jetQuery),
+	expect:  func() []bench.Jet { return expectJets(jetQuery()) },
+	override: map[string]func() []mimic.QueryResult{
+		"jet": fixtures(jetQueryAliased),
+	},
 }
 
-func BenchmarkXORMRawBind(b *testing.B) {
-	query := jetQuery()
-	mimic.NewQuery(query)
-
-	xormdb, err := xorm.NewEngine("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("xorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			var store []xorms.Jet
-			err = xormdb.SQL("select * from jets").Find(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-			store = nil
-		}
-	})
+func BenchmarkRawBind(b *testing.B) {
+	runOperation(b, rawBindOp)
 }
 
 func BenchmarkSQLXRawBind(b *testing.B) {
@@ -108,51 +45,3 @@ func BenchmarkSQLXRawBind(b *testing.B) {
 		}
 	})
 }
-
-func BenchmarkBoilRawBind(b *testing.B) {
-	query := jetQuery()
-	mimic.NewQuery(query)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("boil", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			var slice []models.Jet
-			err = queries.Raw("select * from jets").Bind(context.Background(), db, &slice)
-			if err != nil {
-				b.Fatal(err)
-			}
-			slice = nil
-		}
-	})
-}
-
-func BenchmarkPopRawBind(b *testing.B) {
-	dsn := "postgres://BenchmarkPopRawBind"
-	query := jetQuery()
-	mimic.NewQueryDSN(dsn, query)
-
-	popdb, err := pop.NewConnection(&pop.ConnectionDetails{Driver: "mimic", Dialect: "postgres", URL: dsn})
-	if err != nil {
-		panic(err)
-	}
-
-	err = popdb.Open()
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("pop", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			var slice []models.Jet
-			err = popdb.RawQuery("select * from jets").All(&slice)
-			if err != nil {
-				b.Fatal(err)
-			}
-			slice = nil
-		}
-	})
-}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// relationSizes are the numbers of rows the relationship writes work on
+var relationSizes = []int{1, 10, 100}
+
+// relationOp is a relationship write to table, whose rows are identified
+// by key. detach is set when no rows are related to the pilot after it.
+type relationOp struct {
+	name   string
+	run    func(bench.RelationAdapter, context.Context) error
+	table  string
+	key    string
+	detach bool
+
+	// cols are what the ORMs that return the rows they write get back,
+	// rows of the join table have no id
+	cols []string
+}
+
+var (
+	languageCols = []string{"pilot_id", "language_id"}
+	idCols       = []string{"id"}
+)
+
+var relationOps = []relationOp{
+	{
+		name: "AttachLanguages", run: bench.RelationAdapter.AttachLanguages,
+		table: "pilot_languages", key: "language_id", cols: languageCols,
+	},
+	{
+		name: "ReplaceLanguages", run: bench.RelationAdapter.ReplaceLanguages,
+		table: "pilot_languages", key: "language_id", cols: languageCols,
+	},
+	{
+		name: "DetachLanguages", run: bench.RelationAdapter.DetachLanguages,
+		table: "pilot_languages", key: "language_id", detach: true, cols: languageCols,
+	},
+	{
+		name: "AttachJets", run: bench.RelationAdapter.AttachJets,
+		table: "jets", key: "id", cols: idCols,
+	},
+	{
+		name: "ReplaceLicenses", run: bench.RelationAdapter.ReplaceLicenses,
+		table: "licenses", key: "id", cols: idCols,
+	},
+}
+
+// relationResult answers every statement of a relationship write of n
+// rows, the ORMs that return the rows they write get n of them
+func relationResult(op relationOp, n int) mimic.QueryResult {
+	vals := make([][]driver.Value, n)
+	for i := range vals {
+		vals[i] = make([]driver.Value, len(op.cols))
+		for j := range vals[i] {
+			vals[i][j] = int64(i + 1)
+		}
+	}
+	return mimic.QueryResult{
+		Result:   &mimic.Result{NumRows: n},
+		Query:    &mimic.Query{Cols: op.cols, Vals: vals},
+		NumInput: -1,
+	}
+}
+
+// openRelation opens a and sets it up to write n rows of op, returning the
+// dsn its statements are logged under. It's nil when the adapter has no
+// relationship writes.
+func openRelation(a bench.Adapter, op relationOp, n int) (bench.RelationAdapter, string, error) {
+	ra, ok := a.(bench.RelationAdapter)
+	if !ok {
+		return nil, "", nil
+	}
+
+	dsn := fmt.Sprintf("postgres://Relation%s-%d-%s", op.name, n, a.Name())
+	mimic.NewSequenceDSN(dsn, relationResult(op, n))
+	if err := a.Open(dsn); err != nil {
+		return nil, "", err
+	}
+	ra.Relate(n)
+	return ra, dsn, nil
+}
+
+// runRelation runs op once on ra, failing when the statements it issued
+// don't leave the pilot with the rows it should, and returns them
+func runRelation(ra bench.RelationAdapter, op relationOp, n int, dsn string) ([]mimic.Statement, error) {
+	log, err := runLogged(dsn, func() error { return op.run(ra, context.Background()) })
+	if err != nil {
+		return nil, err
+	}
+
+	all := make(map[int64]bool, n)
+	for id := int64(1); id <= int64(n); id++ {
+		all[id] = true
+	}
+	before, want := map[int64]bool{}, all
+	if op.detach {
+		before, want = all, map[int64]bool{}
+	}
+
+	related, err := relatedRows(log, op.table, op.key, before)
+	if err != nil {
+		return nil, err
+	}
+	if !maps.Equal(related, want) {
+		return nil, fmt.Errorf("want %d rows related to the pilot, got %v", len(want), slices.Sorted(maps.Keys(related)))
+	}
+	return log, nil
+}
+
+// runRelationOp creates a size/orm sub-benchmark for every size and
+// adapter, and reports the statements a write issues as stmts/op
+func runRelationOp(b *testing.B, op relationOp) {
+	for _, n := range relationSizes {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for _, a := range adapters() {
+				b.Run(a.Name(), func(b *testing.B) {
+					ra, dsn, err := openRelation(a, op, n)
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					var log []mimic.Statement
+					if ra != nil {
+						log, err = runRelation(ra, op, n, dsn)
+					}
+					if ra == nil || errors.Is(err, bench.ErrUnsupported) {
+						skipUnsupported(b)
+					} else if err != nil {
+						b.Fatal(err)
+					}
+
+					ctx := context.Background()
+					b.ResetTimer()
+					loop(b, func() error { return op.run(ra, ctx) })
+					b.ReportMetric(float64(len(log)), "stmts/op")
+				})
+			}
+		})
+	}
+}
+
+// relationGoldenSize is the number of rows the statements in
+// testdata/sql/Relation<Op> are written with, 2 so that batching shows
+const relationGoldenSize = 2
+
+// TestGoldenRelationSQL is TestGoldenSQL and TestVerify for the Relation
+// benchmarks. Every size they run is verified, the statements of
+// relationGoldenSize rows are in testdata/sql/Relation<Op>.
+func TestGoldenRelationSQL(t *testing.T) {
+	sizes := append([]int{relationGoldenSize}, relationSizes...)
+	for _, op := range relationOps {
+		for _, n := range sizes {
+			for _, a := range adapters() {
+				t.Run(op.name+"/"+strconv.Itoa(n)+"/"+a.Name(), func(t *testing.T) {
+					ra, dsn, err := openRelation(a, op, n)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					var log []mimic.Statement
+					if ra != nil {
+						log, err = runRelation(ra, op, n, dsn)
+					}
+					if ra == nil || errors.Is(err, bench.ErrUnsupported) {
+						t.Skip("unsupported")
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					if n == relationGoldenSize {
+						checkGolden(t, "Relation"+op.name, a.Name(), formatStatements(log))
+					}
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkRelationAttachLanguages(b *testing.B) {
+	runRelationOp(b, relationOps[0])
+}
+
+func BenchmarkRelationReplaceLanguages(b *testing.B) {
+	runRelationOp(b, relationOps[1])
+}
+
+func BenchmarkRelationDetachLanguages(b *testing.B) {
+	runRelationOp(b, relationOps[2])
+}
+
+func BenchmarkRelationAttachJets(b *testing.B) {
+	runRelationOp(b, relationOps[3])
+}
+
+func BenchmarkRelationReplaceLicenses(b *testing.B) {
+	runRelationOp(b, relationOps[4])
+}
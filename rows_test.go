@@ -0,0 +1,82 @@
+package main
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// rowCounts are the numbers of jets the Rows benchmarks read, so that the
+// cost of a query can be told apart from the cost of every row it returns
+var rowCounts = []int{1, 10, 100, 1000, 10000}
+
+// jetRows returns the first n jets of the fixtures, see jetRow
+func jetRows(n int) mimic.QueryResult {
+	vals := make([][]driver.Value, n)
+	for i := range vals {
+		vals[i] = jetRow(int64(i + 1))
+	}
+
+	return mimic.QueryResult{
+		Query: &mimic.Query{Cols: bench.JetColumns, Vals: vals},
+	}
+}
+
+// rowsOp is op reading n jets. Like the 5 jet fixture, the rows are
+// returned whatever the query's limit.
+func rowsOp(op jetOperation, n int) jetOperation {
+	op.name = fmt.Sprintf("Rows%s-%d", op.name, n)
+	op.fixture = fixtures(func() mimic.QueryResult { return jetRows(n) })
+	op.override = map[string]func() []mimic.QueryResult{
+		"jet": fixtures(func() mimic.QueryResult { return aliasJets(jetRows(n)) }),
+	}
+	op.expect = func() []bench.Jet { return expectJets(jetRows(n)) }
+	return op
+}
+
+// rowsOperations are the operations benchmarked at every row count
+var rowsOperations = []jetOperation{
+	selectAllOp,
+	selectSubsetOp,
+	selectComplexOp,
+	rawBindOp,
+}
+
+// runRows creates an op/rows/orm sub-benchmark for every row count and
+// adapter, so that boilbench can fit each ORM's cost per query and per row
+func runRows(b *testing.B, op jetOperation) {
+	for _, n := range rowCounts {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			runOperation(b, rowsOp(op, n))
+		})
+	}
+}
+
+// TestVerifyRows is TestVerify for every row count
+func TestVerifyRows(t *testing.T) {
+	for _, op := range rowsOperations {
+		for _, n := range rowCounts {
+			verifyOperation(t, rowsOp(op, n))
+		}
+	}
+}
+
+func BenchmarkRowsSelectAll(b *testing.B) {
+	runRows(b, selectAllOp)
+}
+
+func BenchmarkRowsSelectSubset(b *testing.B) {
+	runRows(b, selectSubsetOp)
+}
+
+func BenchmarkRowsSelectComplex(b *testing.B) {
+	runRows(b, selectComplexOp)
+}
+
+func BenchmarkRowsRawBind(b *testing.B) {
+	runRows(b, rawBindOp)
+}
@@ -15,6 +15,43 @@ CREATE SEQUENCE airports_id_seq
 ALTER TABLE airports_id_seq OWNER TO postgres;
 ALTER SEQUENCE airports_id_seq OWNED BY airports.id;
 
+CREATE TYPE flight_status AS ENUM (
+    'scheduled',
+    'boarding',
+    'departed',
+    'landed',
+    'cancelled'
+);
+
+ALTER TYPE flight_status OWNER TO postgres;
+
+CREATE TABLE flights (
+    id integer NOT NULL,
+    jet_id integer NOT NULL,
+    number text NOT NULL,
+    status flight_status NOT NULL,
+    departs_at timestamp with time zone NOT NULL,
+    arrives_at timestamp with time zone,
+    fare numeric(10,2) NOT NULL,
+    booking_ref uuid NOT NULL,
+    operator_ip inet NOT NULL,
+    crew text[] NOT NULL,
+    seats integer[] NOT NULL,
+    metadata jsonb NOT NULL,
+    notes jsonb
+);
+
+ALTER TABLE flights OWNER TO postgres;
+CREATE SEQUENCE flights_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE flights_id_seq OWNER TO postgres;
+ALTER SEQUENCE flights_id_seq OWNED BY flights.id;
+
 CREATE TABLE hangars (
     id integer NOT NULL,
     name text NOT NULL
@@ -110,28 +147,284 @@ CREATE SEQUENCE pilots_id_seq
 ALTER TABLE pilots_id_seq OWNER TO postgres;
 ALTER SEQUENCE pilots_id_seq OWNED BY pilots.id;
 
+CREATE TABLE wide10 (
+    id integer NOT NULL,
+    pilot_id integer NOT NULL,
+    text_003 text NOT NULL,
+    integer_004 integer NOT NULL,
+    bigint_005 bigint NOT NULL,
+    boolean_006 boolean NOT NULL,
+    float_007 double precision NOT NULL,
+    timestamp_008 timestamp with time zone NOT NULL,
+    bytes_009 bytea NOT NULL,
+    null_text_010 text
+);
+
+ALTER TABLE wide10 OWNER TO postgres;
+CREATE SEQUENCE wide10_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE wide10_id_seq OWNER TO postgres;
+ALTER SEQUENCE wide10_id_seq OWNED BY wide10.id;
+
+CREATE TABLE wide25 (
+    id integer NOT NULL,
+    pilot_id integer NOT NULL,
+    text_003 text NOT NULL,
+    integer_004 integer NOT NULL,
+    bigint_005 bigint NOT NULL,
+    boolean_006 boolean NOT NULL,
+    float_007 double precision NOT NULL,
+    timestamp_008 timestamp with time zone NOT NULL,
+    bytes_009 bytea NOT NULL,
+    null_text_010 text,
+    null_integer_011 integer,
+    null_timestamp_012 timestamp with time zone,
+    text_013 text NOT NULL,
+    integer_014 integer NOT NULL,
+    bigint_015 bigint NOT NULL,
+    boolean_016 boolean NOT NULL,
+    float_017 double precision NOT NULL,
+    timestamp_018 timestamp with time zone NOT NULL,
+    bytes_019 bytea NOT NULL,
+    null_text_020 text,
+    null_integer_021 integer,
+    null_timestamp_022 timestamp with time zone,
+    text_023 text NOT NULL,
+    integer_024 integer NOT NULL,
+    bigint_025 bigint NOT NULL
+);
+
+ALTER TABLE wide25 OWNER TO postgres;
+CREATE SEQUENCE wide25_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE wide25_id_seq OWNER TO postgres;
+ALTER SEQUENCE wide25_id_seq OWNED BY wide25.id;
+
+CREATE TABLE wide50 (
+    id integer NOT NULL,
+    pilot_id integer NOT NULL,
+    text_003 text NOT NULL,
+    integer_004 integer NOT NULL,
+    bigint_005 bigint NOT NULL,
+    boolean_006 boolean NOT NULL,
+    float_007 double precision NOT NULL,
+    timestamp_008 timestamp with time zone NOT NULL,
+    bytes_009 bytea NOT NULL,
+    null_text_010 text,
+    null_integer_011 integer,
+    null_timestamp_012 timestamp with time zone,
+    text_013 text NOT NULL,
+    integer_014 integer NOT NULL,
+    bigint_015 bigint NOT NULL,
+    boolean_016 boolean NOT NULL,
+    float_017 double precision NOT NULL,
+    timestamp_018 timestamp with time zone NOT NULL,
+    bytes_019 bytea NOT NULL,
+    null_text_020 text,
+    null_integer_021 integer,
+    null_timestamp_022 timestamp with time zone,
+    text_023 text NOT NULL,
+    integer_024 integer NOT NULL,
+    bigint_025 bigint NOT NULL,
+    boolean_026 boolean NOT NULL,
+    float_027 double precision NOT NULL,
+    timestamp_028 timestamp with time zone NOT NULL,
+    bytes_029 bytea NOT NULL,
+    null_text_030 text,
+    null_integer_031 integer,
+    null_timestamp_032 timestamp with time zone,
+    text_033 text NOT NULL,
+    integer_034 integer NOT NULL,
+    bigint_035 bigint NOT NULL,
+    boolean_036 boolean NOT NULL,
+    float_037 double precision NOT NULL,
+    timestamp_038 timestamp with time zone NOT NULL,
+    bytes_039 bytea NOT NULL,
+    null_text_040 text,
+    null_integer_041 integer,
+    null_timestamp_042 timestamp with time zone,
+    text_043 text NOT NULL,
+    integer_044 integer NOT NULL,
+    bigint_045 bigint NOT NULL,
+    boolean_046 boolean NOT NULL,
+    float_047 double precision NOT NULL,
+    timestamp_048 timestamp with time zone NOT NULL,
+    bytes_049 bytea NOT NULL,
+    null_text_050 text
+);
+
+ALTER TABLE wide50 OWNER TO postgres;
+CREATE SEQUENCE wide50_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE wide50_id_seq OWNER TO postgres;
+ALTER SEQUENCE wide50_id_seq OWNED BY wide50.id;
+
+CREATE TABLE wide100 (
+    id integer NOT NULL,
+    pilot_id integer NOT NULL,
+    text_003 text NOT NULL,
+    integer_004 integer NOT NULL,
+    bigint_005 bigint NOT NULL,
+    boolean_006 boolean NOT NULL,
+    float_007 double precision NOT NULL,
+    timestamp_008 timestamp with time zone NOT NULL,
+    bytes_009 bytea NOT NULL,
+    null_text_010 text,
+    null_integer_011 integer,
+    null_timestamp_012 timestamp with time zone,
+    text_013 text NOT NULL,
+    integer_014 integer NOT NULL,
+    bigint_015 bigint NOT NULL,
+    boolean_016 boolean NOT NULL,
+    float_017 double precision NOT NULL,
+    timestamp_018 timestamp with time zone NOT NULL,
+    bytes_019 bytea NOT NULL,
+    null_text_020 text,
+    null_integer_021 integer,
+    null_timestamp_022 timestamp with time zone,
+    text_023 text NOT NULL,
+    integer_024 integer NOT NULL,
+    bigint_025 bigint NOT NULL,
+    boolean_026 boolean NOT NULL,
+    float_027 double precision NOT NULL,
+    timestamp_028 timestamp with time zone NOT NULL,
+    bytes_029 bytea NOT NULL,
+    null_text_030 text,
+    null_integer_031 integer,
+    null_timestamp_032 timestamp with time zone,
+    text_033 text NOT NULL,
+    integer_034 integer NOT NULL,
+    bigint_035 bigint NOT NULL,
+    boolean_036 boolean NOT NULL,
+    float_037 double precision NOT NULL,
+    timestamp_038 timestamp with time zone NOT NULL,
+    bytes_039 bytea NOT NULL,
+    null_text_040 text,
+    null_integer_041 integer,
+    null_timestamp_042 timestamp with time zone,
+    text_043 text NOT NULL,
+    integer_044 integer NOT NULL,
+    bigint_045 bigint NOT NULL,
+    boolean_046 boolean NOT NULL,
+    float_047 double precision NOT NULL,
+    timestamp_048 timestamp with time zone NOT NULL,
+    bytes_049 bytea NOT NULL,
+    null_text_050 text,
+    null_integer_051 integer,
+    null_timestamp_052 timestamp with time zone,
+    text_053 text NOT NULL,
+    integer_054 integer NOT NULL,
+    bigint_055 bigint NOT NULL,
+    boolean_056 boolean NOT NULL,
+    float_057 double precision NOT NULL,
+    timestamp_058 timestamp with time zone NOT NULL,
+    bytes_059 bytea NOT NULL,
+    null_text_060 text,
+    null_integer_061 integer,
+    null_timestamp_062 timestamp with time zone,
+    text_063 text NOT NULL,
+    integer_064 integer NOT NULL,
+    bigint_065 bigint NOT NULL,
+    boolean_066 boolean NOT NULL,
+    float_067 double precision NOT NULL,
+    timestamp_068 timestamp with time zone NOT NULL,
+    bytes_069 bytea NOT NULL,
+    null_text_070 text,
+    null_integer_071 integer,
+    null_timestamp_072 timestamp with time zone,
+    text_073 text NOT NULL,
+    integer_074 integer NOT NULL,
+    bigint_075 bigint NOT NULL,
+    boolean_076 boolean NOT NULL,
+    float_077 double precision NOT NULL,
+    timestamp_078 timestamp with time zone NOT NULL,
+    bytes_079 bytea NOT NULL,
+    null_text_080 text,
+    null_integer_081 integer,
+    null_timestamp_082 timestamp with time zone,
+    text_083 text NOT NULL,
+    integer_084 integer NOT NULL,
+    bigint_085 bigint NOT NULL,
+    boolean_086 boolean NOT NULL,
+    float_087 double precision NOT NULL,
+    timestamp_088 timestamp with time zone NOT NULL,
+    bytes_089 bytea NOT NULL,
+    null_text_090 text,
+    null_integer_091 integer,
+    null_timestamp_092 timestamp with time zone,
+    text_093 text NOT NULL,
+    integer_094 integer NOT NULL,
+    bigint_095 bigint NOT NULL,
+    boolean_096 boolean NOT NULL,
+    float_097 double precision NOT NULL,
+    timestamp_098 timestamp with time zone NOT NULL,
+    bytes_099 bytea NOT NULL,
+    null_text_100 text
+);
+
+ALTER TABLE wide100 OWNER TO postgres;
+CREATE SEQUENCE wide100_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE wide100_id_seq OWNER TO postgres;
+ALTER SEQUENCE wide100_id_seq OWNED BY wide100.id;
+
 ALTER TABLE ONLY airports ALTER COLUMN id SET DEFAULT nextval('airports_id_seq'::regclass);
+ALTER TABLE ONLY flights ALTER COLUMN id SET DEFAULT nextval('flights_id_seq'::regclass);
 ALTER TABLE ONLY hangars ALTER COLUMN id SET DEFAULT nextval('hangars_id_seq'::regclass);
 ALTER TABLE ONLY jets ALTER COLUMN id SET DEFAULT nextval('jets_id_seq'::regclass);
 ALTER TABLE ONLY languages ALTER COLUMN id SET DEFAULT nextval('languages_id_seq'::regclass);
 ALTER TABLE ONLY licenses ALTER COLUMN id SET DEFAULT nextval('licenses_id_seq'::regclass);
 ALTER TABLE ONLY pilots ALTER COLUMN id SET DEFAULT nextval('pilots_id_seq'::regclass);
+ALTER TABLE ONLY wide10 ALTER COLUMN id SET DEFAULT nextval('wide10_id_seq'::regclass);
+ALTER TABLE ONLY wide25 ALTER COLUMN id SET DEFAULT nextval('wide25_id_seq'::regclass);
+ALTER TABLE ONLY wide50 ALTER COLUMN id SET DEFAULT nextval('wide50_id_seq'::regclass);
+ALTER TABLE ONLY wide100 ALTER COLUMN id SET DEFAULT nextval('wide100_id_seq'::regclass);
 
 ALTER TABLE ONLY airports ADD CONSTRAINT airports_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY flights ADD CONSTRAINT flights_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY hangars ADD CONSTRAINT hangars_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY jets ADD CONSTRAINT jets_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY languages ADD CONSTRAINT languages_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY licenses ADD CONSTRAINT licenses_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY pilot_languages ADD CONSTRAINT pilot_languages_pkey PRIMARY KEY (pilot_id, language_id);
 ALTER TABLE ONLY pilots ADD CONSTRAINT pilots_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY wide10 ADD CONSTRAINT wide10_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY wide25 ADD CONSTRAINT wide25_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY wide50 ADD CONSTRAINT wide50_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY wide100 ADD CONSTRAINT wide100_pkey PRIMARY KEY (id);
 
 CREATE INDEX idx_pilot_languages ON languages USING btree (language);
 
+ALTER TABLE ONLY flights ADD CONSTRAINT flights_jet_id_jets_id_foreign FOREIGN KEY (jet_id) REFERENCES jets(id);
 ALTER TABLE ONLY jets ADD CONSTRAINT jets_airport_id_airports_id_foreign FOREIGN KEY (airport_id) REFERENCES airports(id);
 ALTER TABLE ONLY jets ADD CONSTRAINT jets_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
 ALTER TABLE ONLY pilot_languages ADD CONSTRAINT languages_fkey FOREIGN KEY (language_id) REFERENCES languages(id);
 ALTER TABLE ONLY licenses ADD CONSTRAINT licenses_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
 ALTER TABLE ONLY pilot_languages ADD CONSTRAINT pilots_fkey FOREIGN KEY (pilot_id) REFERENCES pilots(id);
+ALTER TABLE ONLY wide10 ADD CONSTRAINT wide10_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
+ALTER TABLE ONLY wide25 ADD CONSTRAINT wide25_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
+ALTER TABLE ONLY wide50 ADD CONSTRAINT wide50_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
+ALTER TABLE ONLY wide100 ADD CONSTRAINT wide100_pilot_id_pilots_id_foreign FOREIGN KEY (pilot_id) REFERENCES pilots(id);
 
 REVOKE ALL ON SCHEMA public FROM PUBLIC;
 REVOKE ALL ON SCHEMA public FROM postgres;
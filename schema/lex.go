@@ -0,0 +1,161 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokIdent tokenKind = iota
+	tokQuoted
+	tokString
+	tokNumber
+	tokPunct
+)
+
+// token is a lexed token, start and end are its offsets in the source so
+// that expressions can be kept as they were written
+type token struct {
+	kind       tokenKind
+	text       string
+	start, end int
+}
+
+// is reports whether the token is the keyword or punctuation s, keywords
+// are matched case insensitively and never match quoted identifiers
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokIdent:
+		return strings.EqualFold(t.text, s)
+	case tokPunct:
+		return t.text == s
+	}
+	return false
+}
+
+// name returns the identifier, folded to lower case unless quoted as
+// Postgres does
+func (t token) name() string {
+	if t.kind == tokIdent {
+		return strings.ToLower(t.text)
+	}
+	return t.text
+}
+
+// lex splits src into statements of tokens, dropping comments and the
+// semicolons between statements
+func lex(src string) ([][]token, error) {
+	var statements [][]token
+	var current []token
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+
+		case strings.HasPrefix(src[i:], "--"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			i += end + 4
+
+		case c == ';':
+			if len(current) != 0 {
+				statements = append(statements, current)
+				current = nil
+			}
+			i++
+
+		case c == '\'' || c == '"':
+			text, end, err := lexQuoted(src, i)
+			if err != nil {
+				return nil, err
+			}
+			kind := tokString
+			if c == '"' {
+				kind = tokQuoted
+			}
+			current = append(current, token{kind: kind, text: text, start: i, end: end})
+			i = end
+
+		case c == '$':
+			// Dollar quoted strings show up in function bodies
+			tagEnd := strings.IndexByte(src[i+1:], '$')
+			if tagEnd < 0 {
+				return nil, fmt.Errorf("unterminated dollar quote at offset %d", i)
+			}
+			tag := src[i : i+tagEnd+2]
+			end := strings.Index(src[i+len(tag):], tag)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated dollar quote at offset %d", i)
+			}
+			stop := i + len(tag) + end + len(tag)
+			current = append(current, token{kind: tokString, text: src[i+len(tag) : stop-len(tag)], start: i, end: stop})
+			i = stop
+
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdentPart(src[i]) {
+				i++
+			}
+			current = append(current, token{kind: tokIdent, text: src[start:i], start: start, end: i})
+
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			current = append(current, token{kind: tokNumber, text: src[start:i], start: start, end: i})
+
+		default:
+			n := 1
+			if strings.HasPrefix(src[i:], "::") {
+				n = 2
+			}
+			current = append(current, token{kind: tokPunct, text: src[i : i+n], start: i, end: i + n})
+			i += n
+		}
+	}
+
+	if len(current) != 0 {
+		statements = append(statements, current)
+	}
+	return statements, nil
+}
+
+// lexQuoted reads a string or quoted identifier starting at i, doubled
+// quotes escape themselves
+func lexQuoted(src string, i int) (string, int, error) {
+	quote := src[i]
+	var b strings.Builder
+	for j := i + 1; j < len(src); j++ {
+		if src[j] != quote {
+			b.WriteByte(src[j])
+			continue
+		}
+		if j+1 < len(src) && src[j+1] == quote {
+			b.WriteByte(quote)
+			j++
+			continue
+		}
+		return b.String(), j + 1, nil
+	}
+	return "", 0, fmt.Errorf("unterminated quote at offset %d", i)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9' || c == '$'
+}
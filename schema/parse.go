@@ -0,0 +1,736 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parser walks the tokens of one statement
+type parser struct {
+	src    string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return token{kind: tokPunct, start: len(p.src), end: len(p.src)}
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// accept consumes the keywords or punctuation in order when they all match
+func (p *parser) accept(words ...string) bool {
+	if p.pos+len(words) > len(p.tokens) {
+		return false
+	}
+	for i, w := range words {
+		if !p.tokens[p.pos+i].is(w) {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+func (p *parser) expect(words ...string) error {
+	if !p.accept(words...) {
+		return fmt.Errorf("want %s, got %q", strings.Join(words, " "), p.peek().text)
+	}
+	return nil
+}
+
+// ident reads an identifier, qualified names are read whole and the schema
+// is dropped
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if t.kind != tokIdent && t.kind != tokQuoted {
+		return "", fmt.Errorf("want a name, got %q", t.text)
+	}
+
+	name := t.name()
+	for p.peek().is(".") {
+		p.next()
+		t = p.next()
+		if t.kind != tokIdent && t.kind != tokQuoted {
+			return "", fmt.Errorf("want a name after %s., got %q", name, t.text)
+		}
+		name = t.name()
+	}
+	return name, nil
+}
+
+// identList reads a parenthesized list of names
+func (p *parser) identList() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+
+		if p.accept(")") {
+			return names, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// skipParens skips a parenthesized group, the opening paren is the next
+// token
+func (p *parser) skipParens() error {
+	depth := 0
+	for !p.done() {
+		t := p.next()
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unbalanced parentheses")
+}
+
+// expression reads tokens up to one of the stop words or a comma or closing
+// paren at the top level and returns them as written
+func (p *parser) expression(stop ...string) string {
+	start, end := p.peek().start, p.peek().start
+	depth := 0
+
+	for !p.done() {
+		t := p.peek()
+		if depth == 0 {
+			if t.is(",") || t.is(")") {
+				break
+			}
+			stopped := false
+			for _, s := range stop {
+				stopped = stopped || t.is(s)
+			}
+			if stopped {
+				break
+			}
+		}
+
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		}
+		end = t.end
+		p.next()
+	}
+	return p.src[start:end]
+}
+
+// columnConstraints are the words that end a column's type or default
+var columnConstraints = []string{
+	"constraint", "not", "null", "default", "primary", "unique", "references",
+	"check", "generated", "collate",
+}
+
+// Parse parses DDL, statements it doesn't describe tables with are ignored
+func Parse(src string) (*Schema, error) {
+	statements, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schema{}
+	for _, tokens := range statements {
+		p := &parser{src: src, tokens: tokens}
+
+		switch {
+		case p.accept("create", "table"), p.accept("create", "unlogged", "table"):
+			err = s.createTable(p)
+		case p.accept("create", "type"):
+			err = s.createType(p)
+		case p.accept("create", "unique", "index"):
+			err = s.createIndex(p, true)
+		case p.accept("create", "index"):
+			err = s.createIndex(p, false)
+		case p.accept("alter", "table"):
+			err = s.alterTable(p)
+		}
+
+		if err != nil {
+			line := 1 + strings.Count(src[:tokens[0].start], "\n")
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if err := s.resolveReferences(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Schema) createTable(p *parser) error {
+	p.accept("if", "not", "exists")
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if s.Table(name) != nil {
+		return fmt.Errorf("table %s is created twice", name)
+	}
+
+	t := &Table{Name: name}
+	s.Tables = append(s.Tables, t)
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	if p.accept(")") {
+		return nil
+	}
+
+	for {
+		if isTableConstraint(p) {
+			err = s.tableConstraint(p, t)
+		} else {
+			err = s.column(p, t)
+		}
+		if err != nil {
+			return err
+		}
+
+		if p.accept(")") {
+			return nil
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+	}
+}
+
+// isTableConstraint reports whether the next element of a table is a
+// constraint rather than a column
+func isTableConstraint(p *parser) bool {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return false
+	}
+	for _, w := range []string{"constraint", "primary", "unique", "foreign", "check", "exclude"} {
+		if t.is(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// column reads a column definition with its constraints
+func (s *Schema) column(p *parser, t *Table) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if t.Column(name) != nil {
+		return fmt.Errorf("%s.%s is defined twice", t.Name, name)
+	}
+
+	c := &Column{Name: name, Nullable: true}
+	t.Columns = append(t.Columns, c)
+
+	written, err := columnType(p, c)
+	if err != nil {
+		return fmt.Errorf("%s.%s: %w", t.Name, name, err)
+	}
+
+	for {
+		constraintName := ""
+		if p.accept("constraint") {
+			if constraintName, err = p.ident(); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case p.accept("not", "null"):
+			c.Nullable = false
+		case p.accept("null"):
+			c.Nullable = true
+		case p.accept("default"):
+			c.Default = p.expression(columnConstraints...)
+		case p.accept("primary", "key"):
+			c.Nullable = false
+			if len(constraintName) == 0 {
+				constraintName = t.Name + "_pkey"
+			}
+			t.PrimaryKey = &Constraint{Name: constraintName, Columns: []string{name}}
+		case p.accept("unique"):
+			if len(constraintName) == 0 {
+				constraintName = t.Name + "_" + name + "_key"
+			}
+			t.Uniques = append(t.Uniques, Constraint{Name: constraintName, Columns: []string{name}})
+		case p.accept("references"):
+			if len(constraintName) == 0 {
+				constraintName = t.Name + "_" + name + "_fkey"
+			}
+			fk, err := references(p, constraintName, []string{name})
+			if err != nil {
+				return err
+			}
+			t.ForeignKeys = append(t.ForeignKeys, fk)
+		case p.accept("check"):
+			if err := p.skipParens(); err != nil {
+				return err
+			}
+		case p.accept("collate"):
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+		case p.accept("generated"):
+			if err := generated(p, c); err != nil {
+				return fmt.Errorf("%s.%s: %w", t.Name, name, err)
+			}
+		default:
+			if len(constraintName) != 0 {
+				return fmt.Errorf("%s.%s: unknown constraint %q", t.Name, name, p.peek().text)
+			}
+			if !p.done() && !p.peek().is(",") && !p.peek().is(")") {
+				return fmt.Errorf("%s.%s: unexpected %q", t.Name, name, p.peek().text)
+			}
+			return s.resolveType(t.Name, c, written)
+		}
+	}
+}
+
+// columnType reads a type with its modifier and array bounds and returns
+// the type as written, normalized to lower case with single spaces
+func columnType(p *parser, c *Column) (string, error) {
+	var words []string
+	for !p.done() {
+		t := p.peek()
+		if t.kind == tokQuoted {
+			// "char" is the only quoted type name that matters
+			p.next()
+			words = append(words, `"`+t.text+`"`)
+			continue
+		}
+		if t.kind != tokIdent {
+			break
+		}
+
+		isConstraint := false
+		for _, w := range columnConstraints {
+			isConstraint = isConstraint || t.is(w)
+		}
+		if isConstraint || t.is("array") {
+			break
+		}
+		p.next()
+		words = append(words, strings.ToLower(t.text))
+
+		// Drop schema qualifiers such as public.mood
+		if p.peek().is(".") {
+			p.next()
+			words = words[:len(words)-1]
+		}
+	}
+	if len(words) == 0 {
+		return "", fmt.Errorf("missing type")
+	}
+
+	if p.peek().is("(") {
+		start := p.peek().start
+		if err := p.skipParens(); err != nil {
+			return "", err
+		}
+		c.Modifier = strings.Join(strings.Fields(p.src[start:p.tokens[p.pos-1].end]), "")
+	}
+
+	// with time zone follows the modifier in timestamp(3) with time zone
+	for _, w := range []string{"with", "without"} {
+		if p.accept(w, "time", "zone") {
+			words = append(words, w, "time", "zone")
+		}
+	}
+
+	for {
+		switch {
+		case p.accept("[", "]"):
+			c.Array = true
+			continue
+		case p.peek().is("["):
+			p.next()
+			p.next()
+			if err := p.expect("]"); err != nil {
+				return "", err
+			}
+			c.Array = true
+			continue
+		case p.accept("array"):
+			c.Array = true
+			continue
+		}
+		break
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// generated reads the rest of GENERATED ALWAYS AS (expr) STORED or
+// GENERATED ALWAYS|BY DEFAULT AS IDENTITY [(options)]
+func generated(p *parser, c *Column) error {
+	always := p.accept("always")
+	if !always {
+		if err := p.expect("by", "default"); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("as"); err != nil {
+		return err
+	}
+
+	if p.accept("identity") {
+		c.Identity, c.IdentityAlways = true, always
+		c.Nullable = false
+		if p.peek().is("(") {
+			return p.skipParens()
+		}
+		return nil
+	}
+
+	if !p.peek().is("(") {
+		return fmt.Errorf("want IDENTITY or an expression, got %q", p.peek().text)
+	}
+	p.next()
+	c.Default = p.expression()
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+	c.Generated = true
+	p.accept("stored")
+	return nil
+}
+
+// references reads the rest of REFERENCES table [(columns)] and the actions
+// that may follow it. Omitted columns are the primary key of the table and
+// filled in once every table has been read.
+func references(p *parser, name string, columns []string) (ForeignKey, error) {
+	fk := ForeignKey{Name: name, Columns: columns}
+
+	var err error
+	if fk.ForeignTable, err = p.ident(); err != nil {
+		return fk, err
+	}
+	if p.peek().is("(") {
+		if fk.ForeignColumns, err = p.identList(); err != nil {
+			return fk, err
+		}
+		if len(fk.ForeignColumns) != len(columns) {
+			return fk, fmt.Errorf("foreign key %s has %d columns referencing %d", name, len(columns), len(fk.ForeignColumns))
+		}
+	}
+
+	for {
+		switch {
+		case p.accept("match", "full"), p.accept("match", "partial"), p.accept("match", "simple"):
+		case p.accept("on", "delete"), p.accept("on", "update"):
+			switch {
+			case p.accept("no", "action"), p.accept("set", "null"), p.accept("set", "default"),
+				p.accept("cascade"), p.accept("restrict"):
+			default:
+				return fk, fmt.Errorf("unknown action %q", p.peek().text)
+			}
+		case p.accept("deferrable"), p.accept("not", "deferrable"),
+			p.accept("initially", "deferred"), p.accept("initially", "immediate"),
+			p.accept("not", "valid"):
+		default:
+			return fk, nil
+		}
+	}
+}
+
+// tableConstraint reads a constraint of a table, in CREATE TABLE or after
+// ALTER TABLE ... ADD
+func (s *Schema) tableConstraint(p *parser, t *Table) error {
+	name := ""
+	if p.accept("constraint") {
+		var err error
+		if name, err = p.ident(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.accept("primary", "key"):
+		columns, err := p.identList()
+		if err != nil {
+			return err
+		}
+		if t.PrimaryKey != nil {
+			return fmt.Errorf("table %s has two primary keys", t.Name)
+		}
+		if len(name) == 0 {
+			name = t.Name + "_pkey"
+		}
+		t.PrimaryKey = &Constraint{Name: name, Columns: columns}
+		for _, column := range columns {
+			c := t.Column(column)
+			if c == nil {
+				return fmt.Errorf("primary key %s has unknown column %s", name, column)
+			}
+			c.Nullable = false
+		}
+
+	case p.accept("unique"):
+		columns, err := p.identList()
+		if err != nil {
+			return err
+		}
+		if len(name) == 0 {
+			name = t.Name + "_" + strings.Join(columns, "_") + "_key"
+		}
+		t.Uniques = append(t.Uniques, Constraint{Name: name, Columns: columns})
+
+	case p.accept("foreign", "key"):
+		columns, err := p.identList()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("references"); err != nil {
+			return err
+		}
+		if len(name) == 0 {
+			name = t.Name + "_" + strings.Join(columns, "_") + "_fkey"
+		}
+		fk, err := references(p, name, columns)
+		if err != nil {
+			return err
+		}
+		t.ForeignKeys = append(t.ForeignKeys, fk)
+
+	case p.accept("check"), p.accept("exclude"):
+		p.expression()
+
+	default:
+		return fmt.Errorf("unknown constraint %q", p.peek().text)
+	}
+	return nil
+}
+
+// createType reads CREATE TYPE name AS ENUM (...), other types are ignored
+func (s *Schema) createType(p *parser) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if !p.accept("as", "enum") {
+		return nil
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	e := &Enum{Name: name}
+	for !p.accept(")") {
+		t := p.next()
+		if t.kind != tokString {
+			return fmt.Errorf("enum %s: want a label, got %q", name, t.text)
+		}
+		e.Values = append(e.Values, t.text)
+		if !p.peek().is(")") {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.Enums = append(s.Enums, e)
+	return nil
+}
+
+// createIndex reads CREATE [UNIQUE] INDEX, indexes on expressions or with
+// a WHERE clause are ignored since they make nothing unique on its own
+func (s *Schema) createIndex(p *parser, unique bool) error {
+	p.accept("concurrently")
+	p.accept("if", "not", "exists")
+
+	name := ""
+	if !p.peek().is("on") {
+		var err error
+		if name, err = p.ident(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("on"); err != nil {
+		return err
+	}
+	p.accept("only")
+
+	tableName, err := p.ident()
+	if err != nil {
+		return err
+	}
+	t := s.Table(tableName)
+	if t == nil {
+		return fmt.Errorf("index %s on unknown table %s", name, tableName)
+	}
+
+	if p.accept("using") {
+		p.next()
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	var columns []string
+	for {
+		// A column may be followed by an opclass or ordering, anything
+		// else is an expression
+		first := p.peek()
+		if first.kind != tokIdent && first.kind != tokQuoted || t.Column(first.name()) == nil {
+			return nil
+		}
+		p.next()
+		if p.peek().is("(") || p.peek().is(".") || p.peek().is("::") {
+			return nil
+		}
+		p.expression()
+		columns = append(columns, first.name())
+
+		if p.accept(")") {
+			break
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+	}
+
+	for !p.done() {
+		if p.next().is("where") {
+			return nil
+		}
+	}
+
+	t.Indexes = append(t.Indexes, Index{Name: name, Unique: unique, Columns: columns})
+	return nil
+}
+
+// alterTable reads the actions of ALTER TABLE that add constraints, columns
+// and defaults, any other action is ignored
+func (s *Schema) alterTable(p *parser) error {
+	p.accept("if", "exists")
+	p.accept("only")
+
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	t := s.Table(name)
+	if t == nil {
+		// Sequences and views are altered as tables too
+		return nil
+	}
+
+	for !p.done() {
+		switch {
+		case p.accept("add", "column"):
+			p.accept("if", "not", "exists")
+			err = s.column(p, t)
+		case p.accept("add"):
+			if isTableConstraint(p) {
+				err = s.tableConstraint(p, t)
+			} else {
+				err = s.column(p, t)
+			}
+		case p.accept("alter", "column"), p.accept("alter"):
+			err = alterColumn(p, t)
+		default:
+			p.expression()
+		}
+		if err != nil {
+			return err
+		}
+
+		if !p.done() {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// alterColumn reads the SET and DROP forms of ALTER COLUMN
+func alterColumn(p *parser, t *Table) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	c := t.Column(name)
+	if c == nil {
+		return fmt.Errorf("alter of unknown column %s.%s", t.Name, name)
+	}
+
+	switch {
+	case p.accept("set", "default"):
+		c.Default = p.expression()
+	case p.accept("drop", "default"):
+		c.Default = ""
+	case p.accept("set", "not", "null"):
+		c.Nullable = false
+	case p.accept("drop", "not", "null"):
+		c.Nullable = true
+	case p.accept("add", "generated"):
+		return generated(p, c)
+	default:
+		p.expression()
+	}
+	return nil
+}
+
+// resolveReferences fills in the columns of foreign keys that reference a
+// primary key implicitly and checks that every reference exists
+func (s *Schema) resolveReferences() error {
+	for _, t := range s.Tables {
+		for i := range t.ForeignKeys {
+			fk := &t.ForeignKeys[i]
+			foreign := s.Table(fk.ForeignTable)
+			if foreign == nil {
+				return fmt.Errorf("foreign key %s references unknown table %s", fk.Name, fk.ForeignTable)
+			}
+
+			if fk.ForeignColumns == nil {
+				if foreign.PrimaryKey == nil || len(foreign.PrimaryKey.Columns) != len(fk.Columns) {
+					return fmt.Errorf("foreign key %s references %s which has no matching primary key", fk.Name, fk.ForeignTable)
+				}
+				fk.ForeignColumns = foreign.PrimaryKey.Columns
+			}
+
+			for j, column := range fk.Columns {
+				if t.Column(column) == nil {
+					return fmt.Errorf("foreign key %s has unknown column %s", fk.Name, column)
+				}
+				if foreign.Column(fk.ForeignColumns[j]) == nil {
+					return fmt.Errorf("foreign key %s references unknown column %s.%s", fk.Name, fk.ForeignTable, fk.ForeignColumns[j])
+				}
+			}
+		}
+	}
+	return nil
+}
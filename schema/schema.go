@@ -0,0 +1,256 @@
+// Package schema parses the Postgres DDL in schema.sql, as written by
+// pg_dump, so that tools can work from it without a running database.
+//
+// It understands the statements that describe tables: CREATE TABLE, CREATE
+// TYPE ... AS ENUM, CREATE [UNIQUE] INDEX and the ALTER TABLE forms that add
+// constraints and defaults. Every other statement is ignored.
+package schema
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Schema is a parsed database schema
+type Schema struct {
+	Tables []*Table
+	Enums  []*Enum
+}
+
+// Table is a parsed table
+type Table struct {
+	Name        string
+	Columns     []*Column
+	PrimaryKey  *Constraint
+	Uniques     []Constraint
+	ForeignKeys []ForeignKey
+	Indexes     []Index
+}
+
+// Column is a parsed column. Types are normalized to the names Postgres
+// reports in information_schema, so "int4" and "serial" are both an
+// "integer" with the "int4" UDTName.
+type Column struct {
+	Name string
+	// DataType is the type of the column, or of its elements for arrays,
+	// for example "character varying". For enums it's "USER-DEFINED".
+	DataType string
+	// UDTName is the name of the type in pg_type, for example "varchar" or
+	// the name of an enum. For arrays it's that of the element type.
+	UDTName string
+	// Modifier is the type modifier as written, for example "(255)"
+	Modifier string
+	Array    bool
+
+	Nullable bool
+	// Default is the default expression as written, empty for none
+	Default string
+	// Generated is set for GENERATED ALWAYS AS (...) STORED columns
+	Generated bool
+	// Identity is set for GENERATED ... AS IDENTITY columns, IdentityAlways
+	// when they are GENERATED ALWAYS
+	Identity       bool
+	IdentityAlways bool
+}
+
+// Constraint is a named set of columns, a primary key or unique constraint
+type Constraint struct {
+	Name    string
+	Columns []string
+}
+
+// ForeignKey is a foreign key constraint
+type ForeignKey struct {
+	Name           string
+	Columns        []string
+	ForeignTable   string
+	ForeignColumns []string
+}
+
+// Index is an index, only those on plain columns are recorded
+type Index struct {
+	Name    string
+	Unique  bool
+	Columns []string
+}
+
+// Enum is an enum type
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+// ParseFile parses the DDL in a file
+func ParseFile(name string) (*Schema, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := Parse(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return s, nil
+}
+
+// Table returns the named table or nil
+func (s *Schema) Table(name string) *Table {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// Enum returns the named enum or nil
+func (s *Schema) Enum(name string) *Enum {
+	for _, e := range s.Enums {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// TableNames returns the names of the tables, sorted
+func (s *Schema) TableNames() []string {
+	names := make([]string, len(s.Tables))
+	for i, t := range s.Tables {
+		names[i] = t.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Column returns the named column or nil
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// ColumnNames returns the names of the columns in order
+func (t *Table) ColumnNames() []string {
+	names := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// IsUnique reports whether column is unique on its own, because it is the
+// primary key or has a unique constraint or index of its own
+func (t *Table) IsUnique(column string) bool {
+	single := func(columns []string) bool {
+		return len(columns) == 1 && columns[0] == column
+	}
+
+	if t.PrimaryKey != nil && single(t.PrimaryKey.Columns) {
+		return true
+	}
+	for _, u := range t.Uniques {
+		if single(u.Columns) {
+			return true
+		}
+	}
+	for _, i := range t.Indexes {
+		if i.Unique && single(i.Columns) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEnum reports whether the column's type, or element type, is an enum
+func (c *Column) IsEnum() bool {
+	return c.DataType == "USER-DEFINED"
+}
+
+// FullType is the type as Postgres would print it, for example
+// "character varying(255)" or "text[]"
+func (c *Column) FullType() string {
+	t := c.DataType
+	if c.IsEnum() {
+		t = c.UDTName
+	}
+
+	t += c.Modifier
+	if c.Array {
+		t += "[]"
+	}
+	return t
+}
+
+// types maps the spellings of built in types to their information_schema
+// data type and pg_type name
+var types = map[string][2]string{
+	"smallint": {"smallint", "int2"}, "int2": {"smallint", "int2"},
+	"integer": {"integer", "int4"}, "int": {"integer", "int4"}, "int4": {"integer", "int4"},
+	"bigint": {"bigint", "int8"}, "int8": {"bigint", "int8"},
+	"smallserial": {"smallint", "int2"}, "serial2": {"smallint", "int2"},
+	"serial": {"integer", "int4"}, "serial4": {"integer", "int4"},
+	"bigserial": {"bigint", "int8"}, "serial8": {"bigint", "int8"},
+	"real": {"real", "float4"}, "float4": {"real", "float4"},
+	"double precision": {"double precision", "float8"}, "float8": {"double precision", "float8"},
+	"numeric": {"numeric", "numeric"}, "decimal": {"numeric", "numeric"},
+	"money":   {"money", "money"},
+	"boolean": {"boolean", "bool"}, "bool": {"boolean", "bool"},
+	"text":              {"text", "text"},
+	"character varying": {"character varying", "varchar"}, "varchar": {"character varying", "varchar"},
+	"character": {"character", "bpchar"}, "char": {"character", "bpchar"}, "bpchar": {"character", "bpchar"},
+	`"char"`: {`"char"`, "char"},
+	"bytea":  {"bytea", "bytea"},
+	"uuid":   {"uuid", "uuid"},
+	"json":   {"json", "json"}, "jsonb": {"jsonb", "jsonb"},
+	"xml":  {"xml", "xml"},
+	"inet": {"inet", "inet"}, "cidr": {"cidr", "cidr"}, "macaddr": {"macaddr", "macaddr"},
+	"bit": {"bit", "bit"}, "bit varying": {"bit varying", "varbit"}, "varbit": {"bit varying", "varbit"},
+	"interval": {"interval", "interval"},
+	"date":     {"date", "date"},
+	"time":     {"time without time zone", "time"}, "time without time zone": {"time without time zone", "time"},
+	"time with time zone": {"time with time zone", "timetz"}, "timetz": {"time with time zone", "timetz"},
+	"timestamp": {"timestamp without time zone", "timestamp"}, "timestamp without time zone": {"timestamp without time zone", "timestamp"},
+	"timestamp with time zone": {"timestamp with time zone", "timestamptz"}, "timestamptz": {"timestamp with time zone", "timestamptz"},
+	"point": {"point", "point"}, "line": {"line", "line"}, "lseg": {"lseg", "lseg"}, "box": {"box", "box"},
+	"path": {"path", "path"}, "polygon": {"polygon", "polygon"}, "circle": {"circle", "circle"},
+	"oid": {"oid", "oid"}, "hstore": {"USER-DEFINED", "hstore"}, "citext": {"USER-DEFINED", "citext"},
+}
+
+// serials are the types that imply a sequence default
+var serials = map[string]bool{
+	"smallserial": true, "serial2": true, "serial": true, "serial4": true, "bigserial": true, "serial8": true,
+}
+
+// resolveType sets the data type of a column from its type as written,
+// which may name an enum
+func (s *Schema) resolveType(table string, c *Column, written string) error {
+	if t, ok := types[written]; ok {
+		c.DataType, c.UDTName = t[0], t[1]
+		if serials[written] && len(c.Default) == 0 {
+			c.Default = fmt.Sprintf("nextval('%s_%s_seq'::regclass)", table, c.Name)
+		}
+		return nil
+	}
+
+	if s.Enum(written) != nil {
+		c.DataType, c.UDTName = "USER-DEFINED", written
+		return nil
+	}
+
+	return fmt.Errorf("%s.%s: unknown type %s", table, c.Name, written)
+}
+
+// unqualify strips the schema from a name
+func unqualify(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+const ddl = `
+-- Comments and statements that don't describe tables are skipped
+SET client_encoding = 'UTF8';
+CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'it''s fine');
+
+CREATE TABLE public.people (
+    id bigint GENERATED ALWAYS AS IDENTITY (START WITH 10),
+    "Name" character varying(255) NOT NULL DEFAULT 'nobody'::character varying,
+    mood public.mood,
+    moods mood[] NOT NULL,
+    scores numeric(10, 2)[],
+    born timestamp with time zone DEFAULT now() NOT NULL,
+    email text CONSTRAINT people_email_uniq UNIQUE,
+    twice integer GENERATED ALWAYS AS (id * 2) STORED,
+    CONSTRAINT people_pkey PRIMARY KEY (id)
+);
+
+CREATE TABLE pets (
+    id serial PRIMARY KEY,
+    owner_id bigint REFERENCES people ON DELETE CASCADE,
+    tag text,
+    kind char
+);
+
+ALTER TABLE ONLY pets ALTER COLUMN tag SET DEFAULT 'none';
+ALTER TABLE pets ADD CONSTRAINT pets_owner_tag_key UNIQUE (owner_id, tag);
+CREATE UNIQUE INDEX pets_tag_idx ON public.pets USING btree (tag DESC);
+CREATE UNIQUE INDEX pets_lower_tag_idx ON pets (lower(tag));
+CREATE UNIQUE INDEX pets_kind_idx ON pets (kind) WHERE kind IS NOT NULL;
+`
+
+func TestParse(t *testing.T) {
+	s, err := Parse(ddl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.TableNames(); !reflect.DeepEqual(got, []string{"people", "pets"}) {
+		t.Fatal("tables", got)
+	}
+	if got := s.Enum("mood"); got == nil || !reflect.DeepEqual(got.Values, []string{"sad", "ok", "it's fine"}) {
+		t.Error("mood", got)
+	}
+
+	people := s.Table("people")
+	want := []Column{
+		{Name: "id", DataType: "bigint", UDTName: "int8", Identity: true, IdentityAlways: true},
+		{Name: "Name", DataType: "character varying", UDTName: "varchar", Modifier: "(255)", Default: "'nobody'::character varying"},
+		{Name: "mood", DataType: "USER-DEFINED", UDTName: "mood", Nullable: true},
+		{Name: "moods", DataType: "USER-DEFINED", UDTName: "mood", Array: true},
+		{Name: "scores", DataType: "numeric", UDTName: "numeric", Modifier: "(10,2)", Array: true, Nullable: true},
+		{Name: "born", DataType: "timestamp with time zone", UDTName: "timestamptz", Default: "now()"},
+		{Name: "email", DataType: "text", UDTName: "text", Nullable: true},
+		{Name: "twice", DataType: "integer", UDTName: "int4", Nullable: true, Default: "id * 2", Generated: true},
+	}
+	if len(people.Columns) != len(want) {
+		t.Fatal("columns", people.ColumnNames())
+	}
+	for i, c := range people.Columns {
+		if !reflect.DeepEqual(*c, want[i]) {
+			t.Errorf("column %d\nwant: %+v\ngot:  %+v", i, want[i], *c)
+		}
+	}
+	if got := people.Column("scores").FullType(); got != "numeric(10,2)[]" {
+		t.Error("full type", got)
+	}
+	if !people.IsUnique("id") || !people.IsUnique("email") || people.IsUnique("mood") {
+		t.Error("unique columns of people")
+	}
+
+	pets := s.Table("pets")
+	if got := pets.Column("id").Default; got != "nextval('pets_id_seq'::regclass)" {
+		t.Error("serial default", got)
+	}
+	if got := pets.Column("tag").Default; got != "'none'" {
+		t.Error("altered default", got)
+	}
+	if got := pets.Column("kind").DataType; got != "character" {
+		t.Error("char", got)
+	}
+	if want := (&Constraint{Name: "pets_pkey", Columns: []string{"id"}}); !reflect.DeepEqual(pets.PrimaryKey, want) {
+		t.Error("primary key", pets.PrimaryKey)
+	}
+
+	fk := []ForeignKey{{Name: "pets_owner_id_fkey", Columns: []string{"owner_id"}, ForeignTable: "people", ForeignColumns: []string{"id"}}}
+	if !reflect.DeepEqual(pets.ForeignKeys, fk) {
+		t.Error("foreign keys", pets.ForeignKeys)
+	}
+
+	// The composite constraint and the expression and partial indexes don't
+	// make a column unique on its own
+	if !pets.IsUnique("tag") || pets.IsUnique("owner_id") || pets.IsUnique("kind") {
+		t.Error("unique columns of pets")
+	}
+	if len(pets.Indexes) != 1 {
+		t.Error("indexes", pets.Indexes)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown type":      "CREATE TABLE a (id widget);",
+		"unknown reference": "CREATE TABLE a (id integer REFERENCES b);",
+		"unterminated":      "CREATE TABLE a (name text DEFAULT 'x);",
+	}
+
+	for name, src := range tests {
+		if _, err := Parse(src); err == nil {
+			t.Error(name, "parsed")
+		}
+	}
+}
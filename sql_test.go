@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden sql files in testdata/sql")
+
+// TestGoldenSQL runs every operation once against every adapter and
+// compares the statements they ran with testdata/sql/<op>/<orm>.sql, so
+// that dependency upgrades which change the generated SQL show up in
+// review. Regenerate the files with: go test -run TestGoldenSQL -update
+func TestGoldenSQL(t *testing.T) {
+	for _, op := range operations {
+		for _, a := range adapters() {
+			op, a := op, a
+			t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+				dsn := "postgres://sql-" + op.name + "-" + a.Name()
+				mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+
+				if err := a.Open(dsn); err != nil {
+					t.Fatal(err)
+				}
+
+				mimic.StartLog(dsn)
+				err := op.run(a, context.Background())
+				log := mimic.StopLog(dsn)
+				if errors.Is(err, bench.ErrUnsupported) {
+					t.Skip("unsupported")
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				checkGolden(t, op.name, a.Name(), formatStatements(log))
+			})
+		}
+	}
+}
+
+// checkGolden compares got with testdata/sql/<op>/<orm>.sql, or writes it
+// there with -update
+func checkGolden(t *testing.T, op, orm string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", "sql", op, orm+".sql")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v, run with -update to create it", err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("%s is out of date, run with -update if the change is expected\n--- want\n%s--- got\n%s",
+			golden, want, got)
+	}
+}
+
+// formatStatements normalizes whitespace in each statement and replaces
+// its args with their types, values are fixture data and not interesting.
+func formatStatements(log []mimic.Statement) []byte {
+	var buf bytes.Buffer
+	for i, s := range log {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+
+		buf.WriteString(strings.Join(strings.Fields(s.Query), " "))
+		buf.WriteByte('\n')
+		if len(s.Args) != 0 {
+			types := make([]string, len(s.Args))
+			for i, arg := range s.Args {
+				types[i] = argType(arg)
+			}
+			fmt.Fprintf(&buf, "-- args: %s\n", strings.Join(types, ", "))
+		}
+	}
+	return buf.Bytes()
+}
+
+func argType(arg driver.Value) string {
+	switch arg.(type) {
+	case nil:
+		return "null"
+	case []byte:
+		return "[]byte"
+	default:
+		return fmt.Sprintf("%T", arg)
+	}
+}
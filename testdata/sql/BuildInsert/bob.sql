@@ -0,0 +1,2 @@
+INSERT INTO "jets" AS "jets" ("id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest") VALUES (DEFAULT, $1, $2, $3, $4, $5, $6, $7, $8) RETURNING "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest"
+-- args: omit.Val[int32], omit.Val[int32], omit.Val[string], omitnull.Val[string], omit.Val[string], omit.Val[string], omit.Val[[]uint8], omit.Val[[]uint8]
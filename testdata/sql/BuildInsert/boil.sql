@@ -0,0 +1,2 @@
+INSERT INTO "jets" ("id","pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+-- args: int, int, int, string, null.String, string, string, []byte, []byte
@@ -0,0 +1,2 @@
+INSERT INTO "jets" ("pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"
+-- args: int, int, string, null.String, string, string, []byte, []byte, int
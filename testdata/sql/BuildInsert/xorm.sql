@@ -0,0 +1,2 @@
+INSERT INTO jets (airport_id,cargo,color,identifier,manifest,name,pilot_id,uuid) Values ($1,$2,$3,$4,$5,$6,$7,$8)
+-- args: int, []byte, null.String, string, []byte, string, int, string
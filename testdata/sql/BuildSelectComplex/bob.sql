@@ -0,0 +1,2 @@
+SELECT id, name, color, uuid, identifier, cargo, manifest FROM "jets" AS "jets" WHERE ("jets"."id" > $1) AND ("jets"."name" <> $2) GROUP BY "jets"."id" LIMIT 1 OFFSET 1
+-- args: int, string
@@ -0,0 +1 @@
+SELECT "id", "name", "color", "uuid", "identifier", "cargo", "manifest" FROM "jets" WHERE (("id" > 1) AND ("name" != 'thing')) GROUP BY "id" LIMIT 1 OFFSET 1
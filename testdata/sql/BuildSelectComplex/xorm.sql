@@ -0,0 +1,2 @@
+SELECT id,name,color,uuid,identifier,cargo,manifest FROM jets WHERE id>$1 AND name<>$2 GROUP BY id LIMIT 1 OFFSET 1
+-- args: int, string
@@ -0,0 +1,2 @@
+UPDATE "jets" AS "jets" SET "pilot_id" = $1, "airport_id" = $2, "name" = $3, "color" = $4, "uuid" = $5, "identifier" = $6, "cargo" = $7, "manifest" = $8 WHERE ("jets"."id" = $9) RETURNING "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest"
+-- args: omit.Val[int32], omit.Val[int32], omit.Val[string], omitnull.Val[string], omit.Val[string], omit.Val[string], omit.Val[[]uint8], omit.Val[[]uint8], int32
@@ -0,0 +1 @@
+UPDATE "jets" SET "airport_id"=11,"cargo"='cargo-1',"color"='color-1',"identifier"='identifier-1',"manifest"='manifest-1',"name"='name-1',"pilot_id"=2,"uuid"='uuid-1' WHERE ("id" = 1)
@@ -0,0 +1,2 @@
+UPDATE public.jets SET (pilot_id, airport_id, name, color, uuid, identifier, cargo, manifest) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE jets.id = $9;
+-- args: int32, int32, string, string, string, string, []byte, []byte, int64
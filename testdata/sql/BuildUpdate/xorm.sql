@@ -0,0 +1,2 @@
+UPDATE jets SET airport_id=$1,cargo=$2,color=$3,identifier=$4,manifest=$5,name=$6,pilot_id=$7,uuid=$8 WHERE id=$9
+-- args: int, []byte, null.String, string, []byte, string, int, string, int
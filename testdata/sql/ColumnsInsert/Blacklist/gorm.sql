@@ -0,0 +1,6 @@
+BEGIN
+
+INSERT INTO "jets" ("pilot_id","airport_id","name","color","uuid","cargo","manifest","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"
+-- args: int64, int64, string, string, string, []byte, []byte, int64
+
+COMMIT
@@ -0,0 +1,2 @@
+INSERT INTO "jets" ("id","pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"
+-- args: int64, int64, int64, string, string, string, string, []byte, []byte
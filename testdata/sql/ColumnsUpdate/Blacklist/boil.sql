@@ -0,0 +1,2 @@
+UPDATE "jets" SET "pilot_id"=$1,"airport_id"=$2,"name"=$3,"color"=$4,"uuid"=$5,"identifier"=$6 WHERE "id"=$7
+-- args: int64, int64, string, string, string, string, int64
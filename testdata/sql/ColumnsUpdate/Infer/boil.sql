@@ -0,0 +1,2 @@
+UPDATE "jets" SET "pilot_id"=$1,"airport_id"=$2,"name"=$3,"color"=$4,"uuid"=$5,"identifier"=$6,"cargo"=$7,"manifest"=$8 WHERE "id"=$9
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
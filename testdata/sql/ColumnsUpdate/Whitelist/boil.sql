@@ -0,0 +1,2 @@
+UPDATE "jets" SET "name"=$1,"color"=$2 WHERE "id"=$3
+-- args: string, string, int64
@@ -0,0 +1,2 @@
+DELETE FROM "jets" AS "jets" WHERE ("jets"."id" = $1) RETURNING "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest"
+-- args: int64
@@ -0,0 +1,2 @@
+DELETE FROM "jets" WHERE "id"=$1
+-- args: int64
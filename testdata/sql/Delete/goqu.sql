@@ -0,0 +1 @@
+DELETE FROM "jets" WHERE ("id" = 1)
@@ -0,0 +1,6 @@
+BEGIN
+
+DELETE FROM "jets" WHERE "jets"."id" = $1
+-- args: int64
+
+COMMIT
@@ -0,0 +1,2 @@
+delete from "jets" where "id"=$1;
+-- args: int64
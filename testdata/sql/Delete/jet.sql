@@ -0,0 +1,2 @@
+DELETE FROM public.jets WHERE jets.id = $1;
+-- args: int64
@@ -0,0 +1,2 @@
+DELETE FROM "jets" AS jets WHERE jets.id = $1
+-- args: int64
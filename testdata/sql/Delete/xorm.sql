@@ -0,0 +1,2 @@
+DELETE FROM "jets" WHERE "id"=$1 AND "pilot_id"=$2 AND "airport_id"=$3 AND "name"=$4 AND "color"=$5 AND "uuid"=$6 AND "identifier"=$7 AND "cargo"=$8 AND "manifest"=$9
+-- args: int64, int64, int64, string, string, string, string, []byte, []byte
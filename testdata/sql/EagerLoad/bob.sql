@@ -0,0 +1,4 @@
+SELECT "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest" FROM "jets" AS "jets"
+
+SELECT "pilots"."id" AS "id", "pilots"."name" AS "name" FROM "pilots" AS "pilots" WHERE (("pilots"."id") IN (($1), ($2), ($3), ($4), ($5)))
+-- args: int64, int64, int64, int64, int64
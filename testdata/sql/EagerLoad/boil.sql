@@ -0,0 +1,4 @@
+SELECT "jets".* FROM "jets";
+
+SELECT * FROM "pilots" WHERE ("pilots"."id" IN ($1,$2,$3,$4,$5));
+-- args: int64, int64, int64, int64, int64
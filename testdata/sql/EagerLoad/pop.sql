@@ -0,0 +1,4 @@
+SELECT jets.airport_id, jets.cargo, jets.color, jets.id, jets.identifier, jets.manifest, jets.name, jets.pilot_id, jets.uuid FROM jets AS jets
+
+SELECT pilots.id, pilots.name FROM pilots AS pilots WHERE id in ($1, $2, $3, $4, $5)
+-- args: int64, int64, int64, int64, int64
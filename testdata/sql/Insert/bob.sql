@@ -0,0 +1,2 @@
+INSERT INTO "jets" AS "jets" ("id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest") VALUES (DEFAULT, $1, $2, $3, $4, $5, $6, $7, $8) RETURNING "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest"
+-- args: int64, int64, string, string, string, string, []byte, []byte
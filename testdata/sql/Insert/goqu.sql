@@ -0,0 +1 @@
+INSERT INTO "jets" ("airport_id", "cargo", "color", "identifier", "manifest", "name", "pilot_id", "uuid") VALUES (11, 'cargo-1', 'color-1', 'identifier-1', 'manifest-1', 'name-1', 2, 'uuid-1')
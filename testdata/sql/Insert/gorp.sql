@@ -0,0 +1,2 @@
+insert into "jets" ("id","pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest") values (default,$1,$2,$3,$4,$5,$6,$7,$8) returning id;
+-- args: int64, int64, string, string, string, string, []byte, []byte
@@ -0,0 +1,2 @@
+INSERT INTO "jets" ("airport_id", "cargo", "color", "identifier", "manifest", "name", "pilot_id", "uuid") VALUES (?, ?, ?, ?, ?, ?, ?, ?) returning id
+-- args: int64, []byte, string, string, []byte, string, int64, string
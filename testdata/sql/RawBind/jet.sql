@@ -0,0 +1 @@
+select id as "jets.id", pilot_id as "jets.pilot_id", airport_id as "jets.airport_id", name as "jets.name", color as "jets.color", uuid as "jets.uuid", identifier as "jets.identifier", cargo as "jets.cargo", manifest as "jets.manifest" from jets;
@@ -0,0 +1,5 @@
+UPDATE "jets" SET "pilot_id"=$1 WHERE "id"=$2
+-- args: int64, int64
+
+UPDATE "jets" SET "pilot_id"=$1 WHERE "id"=$2
+-- args: int64, int64
@@ -0,0 +1 @@
+UPDATE "jets" SET "pilot_id"=1 WHERE ("id" IN (1, 2))
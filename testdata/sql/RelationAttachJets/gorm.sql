@@ -0,0 +1,6 @@
+BEGIN
+
+INSERT INTO "jets" ("pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT ("id") DO UPDATE SET "pilot_id"="excluded"."pilot_id" RETURNING "id"
+-- args: int64, int64, string, null, string, string, []byte, []byte, int64, int64, int64, string, null, string, string, []byte, []byte, int64
+
+COMMIT
@@ -0,0 +1,2 @@
+update jets set pilot_id = $1 where id in ($2,$3)
+-- args: int64, int64, int64
@@ -0,0 +1,2 @@
+UPDATE public.jets SET pilot_id = $1 WHERE jets.id IN ($2, $3);
+-- args: int64, int64, int64
@@ -0,0 +1,2 @@
+INSERT INTO "pilot_languages" AS "pilot_languages" ("pilot_id", "language_id") VALUES ($1, $2), ($3, $4) RETURNING "pilot_languages"."pilot_id" AS "pilot_id", "pilot_languages"."language_id" AS "language_id"
+-- args: int64, int64, int64, int64
@@ -0,0 +1 @@
+INSERT INTO "pilot_languages" ("language_id", "pilot_id") VALUES (1, 1), (2, 1)
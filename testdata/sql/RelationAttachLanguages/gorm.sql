@@ -0,0 +1,6 @@
+BEGIN
+
+INSERT INTO "pilot_languages" ("pilot_id","language_id") VALUES ($1,$2),($3,$4) ON CONFLICT DO NOTHING
+-- args: int64, int64, int64, int64
+
+COMMIT
@@ -0,0 +1,5 @@
+insert into "pilot_languages" ("pilot_id","language_id") values ($1,$2);
+-- args: int64, int64
+
+insert into "pilot_languages" ("pilot_id","language_id") values ($1,$2);
+-- args: int64, int64
@@ -0,0 +1,2 @@
+INSERT INTO public.pilot_languages (pilot_id, language_id) VALUES ($1, $2), ($3, $4);
+-- args: int64, int64, int64, int64
@@ -0,0 +1,2 @@
+insert into pilot_languages (pilot_id, language_id) values ($1, $2), ($3, $4)
+-- args: int64, int64, int64, int64
@@ -0,0 +1 @@
+DELETE FROM "pilot_languages" WHERE (("pilot_id" = 1) AND ("language_id" IN (1, 2)))
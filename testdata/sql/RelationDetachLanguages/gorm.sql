@@ -0,0 +1,6 @@
+BEGIN
+
+DELETE FROM "pilot_languages" WHERE "pilot_languages"."pilot_id" = $1 AND "pilot_languages"."language_id" IN ($2,$3)
+-- args: int64, int64, int64
+
+COMMIT
@@ -0,0 +1,2 @@
+delete from pilot_languages where pilot_id = $1 and language_id in ($2,$3)
+-- args: int64, int64, int64
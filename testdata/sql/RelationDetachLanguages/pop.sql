@@ -0,0 +1,2 @@
+DELETE FROM pilot_languages AS pilot_languages WHERE pilot_id = $1 AND language_id in ($2,$3)
+-- args: int64, int64, int64
@@ -0,0 +1,2 @@
+DELETE FROM "pilot_languages" WHERE (pilot_id = $1) AND "language_id" IN ($2,$3)
+-- args: int64, int64, int64
@@ -0,0 +1,8 @@
+delete from "pilot_languages" where "pilot_id" = $1
+-- args: int64
+
+insert into "pilot_languages" ("pilot_id", "language_id") values ($1, $2)
+-- args: int64, int64
+
+insert into "pilot_languages" ("pilot_id", "language_id") values ($1, $2)
+-- args: int64, int64
@@ -0,0 +1,3 @@
+DELETE FROM "pilot_languages" WHERE ("pilot_id" = 1)
+
+INSERT INTO "pilot_languages" ("language_id", "pilot_id") VALUES (1, 1), (2, 1)
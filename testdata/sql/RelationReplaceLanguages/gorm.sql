@@ -0,0 +1,13 @@
+BEGIN
+
+INSERT INTO "pilot_languages" ("pilot_id","language_id") VALUES ($1,$2),($3,$4) ON CONFLICT DO NOTHING
+-- args: int64, int64, int64, int64
+
+COMMIT
+
+BEGIN
+
+DELETE FROM "pilot_languages" WHERE "pilot_languages"."pilot_id" = $1 AND "pilot_languages"."language_id" NOT IN ($2,$3)
+-- args: int64, int64, int64
+
+COMMIT
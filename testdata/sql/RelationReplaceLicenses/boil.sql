@@ -0,0 +1,8 @@
+update "licenses" set "pilot_id" = null where "pilot_id" = $1
+-- args: int64
+
+UPDATE "licenses" SET "pilot_id"=$1 WHERE "id"=$2
+-- args: int64, int64
+
+UPDATE "licenses" SET "pilot_id"=$1 WHERE "id"=$2
+-- args: int64, int64
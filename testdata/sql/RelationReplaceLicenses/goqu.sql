@@ -0,0 +1,3 @@
+UPDATE "licenses" SET "pilot_id"=NULL WHERE ("pilot_id" = 1)
+
+UPDATE "licenses" SET "pilot_id"=1 WHERE ("id" IN (1, 2))
@@ -0,0 +1,13 @@
+BEGIN
+
+INSERT INTO "licenses" ("pilot_id","id") VALUES ($1,$2),($3,$4) ON CONFLICT ("id") DO UPDATE SET "pilot_id"="excluded"."pilot_id" RETURNING "id"
+-- args: int64, int64, int64, int64
+
+COMMIT
+
+BEGIN
+
+UPDATE "licenses" SET "pilot_id"=$1 WHERE "licenses"."id" NOT IN ($2,$3) AND "licenses"."pilot_id" = $4
+-- args: null, int64, int64, int64
+
+COMMIT
@@ -0,0 +1,5 @@
+UPDATE public.licenses SET pilot_id = NULL WHERE licenses.pilot_id = $1;
+-- args: int64
+
+UPDATE public.licenses SET pilot_id = $1 WHERE licenses.id IN ($2, $3);
+-- args: int64, int64, int64
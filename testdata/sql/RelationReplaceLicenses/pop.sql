@@ -0,0 +1,5 @@
+update licenses set pilot_id = null where pilot_id = $1
+-- args: int64
+
+update licenses set pilot_id = $1 where id in ($2, $3)
+-- args: int64, int64, int64
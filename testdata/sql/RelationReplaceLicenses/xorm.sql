@@ -0,0 +1,5 @@
+UPDATE "licenses" SET "pilot_id" = $1 WHERE (pilot_id = $2)
+-- args: null, int64
+
+UPDATE "licenses" SET "pilot_id" = $1 WHERE "id" IN ($2,$3)
+-- args: int64, int64, int64
@@ -0,0 +1 @@
+SELECT "jets".* FROM "jets";
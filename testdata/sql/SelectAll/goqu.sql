@@ -0,0 +1 @@
+SELECT "airport_id", "cargo", "color", "id", "identifier", "manifest", "name", "pilot_id", "uuid" FROM "jets"
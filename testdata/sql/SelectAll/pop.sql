@@ -0,0 +1 @@
+SELECT jets.airport_id, jets.cargo, jets.color, jets.id, jets.identifier, jets.manifest, jets.name, jets.pilot_id, jets.uuid FROM jets AS jets
@@ -0,0 +1 @@
+SELECT "id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest" FROM "jets"
@@ -0,0 +1,2 @@
+select id, name, color, uuid, identifier, cargo, manifest from "jets" where id > $1 and name <> $2 group by "id" offset $3 limit $4
+-- args: int64, string, int64, int64
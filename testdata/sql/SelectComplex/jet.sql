@@ -0,0 +1,2 @@
+SELECT jets.id AS "jets.id", jets.name AS "jets.name", jets.color AS "jets.color", jets.uuid AS "jets.uuid", jets.identifier AS "jets.identifier", jets.cargo AS "jets.cargo", jets.manifest AS "jets.manifest" FROM public.jets WHERE (jets.id > $1) AND (jets.name != $2::text) GROUP BY jets.id LIMIT $3 OFFSET $4;
+-- args: int64, string, int64, int64
@@ -0,0 +1 @@
+select id, name, color, uuid, identifier, cargo, manifest from "jets"
@@ -0,0 +1 @@
+SELECT jets.id AS "jets.id", jets.name AS "jets.name", jets.color AS "jets.color", jets.uuid AS "jets.uuid", jets.identifier AS "jets.identifier", jets.cargo AS "jets.cargo", jets.manifest AS "jets.manifest" FROM public.jets;
@@ -0,0 +1 @@
+SELECT id, name, color, uuid, identifier, cargo, manifest FROM jets AS jets
@@ -0,0 +1,12 @@
+BEGIN
+
+SELECT "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest" FROM "jets" AS "jets" WHERE ("jets"."id" = $1)
+-- args: int64
+
+UPDATE "jets" AS "jets" SET "pilot_id" = $1, "airport_id" = $2, "name" = $3, "color" = $4, "uuid" = $5, "identifier" = $6, "cargo" = $7, "manifest" = $8 WHERE ("jets"."id" = $9) RETURNING "jets"."id" AS "id", "jets"."pilot_id" AS "pilot_id", "jets"."airport_id" AS "airport_id", "jets"."name" AS "name", "jets"."color" AS "color", "jets"."uuid" AS "uuid", "jets"."identifier" AS "identifier", "jets"."cargo" AS "cargo", "jets"."manifest" AS "manifest"
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
+
+INSERT INTO "licenses" AS "licenses" ("id", "pilot_id") VALUES (DEFAULT, $1) RETURNING "licenses"."id" AS "id", "licenses"."pilot_id" AS "pilot_id"
+-- args: int64
+
+COMMIT
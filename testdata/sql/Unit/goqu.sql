@@ -0,0 +1,9 @@
+BEGIN
+
+SELECT "airport_id", "cargo", "color", "id", "identifier", "manifest", "name", "pilot_id", "uuid" FROM "jets" WHERE ("id" = 1) LIMIT 1
+
+UPDATE "jets" SET "airport_id"=11,"cargo"='cargo-1',"color"='color-1',"identifier"='identifier-1',"manifest"='manifest-1',"name"='name-1',"pilot_id"=2,"uuid"='uuid-1' WHERE ("id" = 1)
+
+INSERT INTO "licenses" ("pilot_id") VALUES (2)
+
+COMMIT
@@ -0,0 +1,12 @@
+BEGIN
+
+SELECT * FROM "jets" WHERE "jets"."id" = $1 ORDER BY "jets"."id" LIMIT 1
+-- args: int64
+
+UPDATE "jets" SET "id"=$1,"pilot_id"=$2,"airport_id"=$3,"name"=$4,"color"=$5,"uuid"=$6,"identifier"=$7,"cargo"=$8,"manifest"=$9 WHERE "id" = $10
+-- args: int64, int64, int64, string, string, string, string, []byte, []byte, int64
+
+INSERT INTO "licenses" ("pilot_id") VALUES ($1) RETURNING "id"
+-- args: int64
+
+COMMIT
@@ -0,0 +1,12 @@
+BEGIN
+
+select "id","pilot_id","airport_id","name","color","uuid","identifier","cargo","manifest" from "jets" where "id"=$1;
+-- args: int64
+
+update "jets" set "pilot_id"=$1, "airport_id"=$2, "name"=$3, "color"=$4, "uuid"=$5, "identifier"=$6, "cargo"=$7, "manifest"=$8 where "id"=$9;
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
+
+insert into "licenses" ("id","pilot_id") values (default,$1) returning id;
+-- args: int64
+
+COMMIT
@@ -0,0 +1,12 @@
+BEGIN
+
+SELECT jets.id AS "jets.id", jets.pilot_id AS "jets.pilot_id", jets.airport_id AS "jets.airport_id", jets.name AS "jets.name", jets.color AS "jets.color", jets.uuid AS "jets.uuid", jets.identifier AS "jets.identifier", jets.cargo AS "jets.cargo", jets.manifest AS "jets.manifest" FROM public.jets WHERE jets.id = $1;
+-- args: int64
+
+UPDATE public.jets SET (pilot_id, airport_id, name, color, uuid, identifier, cargo, manifest) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE jets.id = $9;
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
+
+INSERT INTO public.licenses (pilot_id) VALUES ($1);
+-- args: int64
+
+COMMIT
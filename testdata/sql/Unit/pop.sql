@@ -0,0 +1,12 @@
+BEGIN
+
+SELECT jets.airport_id, jets.cargo, jets.color, jets.id, jets.identifier, jets.manifest, jets.name, jets.pilot_id, jets.uuid FROM jets AS jets WHERE jets.id = $1 LIMIT 1
+-- args: int64
+
+UPDATE "jets" AS jets SET "airport_id" = ?, "cargo" = ?, "color" = ?, "identifier" = ?, "manifest" = ?, "name" = ?, "pilot_id" = ?, "uuid" = ? WHERE jets.id = ?
+-- args: int64, []byte, string, string, []byte, string, int64, string, int64
+
+INSERT INTO "licenses" ("pilot_id") VALUES (?) returning id
+-- args: int64
+
+COMMIT
@@ -0,0 +1,12 @@
+BEGIN
+
+SELECT "id", "pilot_id", "airport_id", "name", "color", "uuid", "identifier", "cargo", "manifest" FROM "jets" WHERE "id"=$1 LIMIT 1
+-- args: int64
+
+UPDATE "jets" SET "pilot_id" = $1, "airport_id" = $2, "name" = $3, "color" = $4, "uuid" = $5, "identifier" = $6, "cargo" = $7, "manifest" = $8 WHERE "id"=$9
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
+
+INSERT INTO "licenses" ("pilot_id") VALUES ($1) RETURNING "id"
+-- args: int64
+
+COMMIT
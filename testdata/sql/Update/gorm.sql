@@ -0,0 +1,6 @@
+BEGIN
+
+UPDATE "jets" SET "id"=$1,"pilot_id"=$2,"airport_id"=$3,"name"=$4,"color"=$5,"uuid"=$6,"identifier"=$7,"cargo"=$8,"manifest"=$9 WHERE "id" = $10
+-- args: int64, int64, int64, string, string, string, string, []byte, []byte, int64
+
+COMMIT
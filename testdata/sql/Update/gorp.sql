@@ -0,0 +1,2 @@
+update "jets" set "pilot_id"=$1, "airport_id"=$2, "name"=$3, "color"=$4, "uuid"=$5, "identifier"=$6, "cargo"=$7, "manifest"=$8 where "id"=$9;
+-- args: int64, int64, string, string, string, string, []byte, []byte, int64
@@ -0,0 +1,2 @@
+UPDATE "jets" AS jets SET "airport_id" = ?, "cargo" = ?, "color" = ?, "identifier" = ?, "manifest" = ?, "name" = ?, "pilot_id" = ?, "uuid" = ? WHERE jets.id = ?
+-- args: int64, []byte, string, string, []byte, string, int64, string, int64
@@ -8,4 +8,6 @@ package tools
 import (
 	_ "github.com/aarondl/sqlboiler/v4"
 	_ "github.com/aarondl/sqlboiler/v4/drivers/sqlboiler-psql"
+	_ "github.com/go-jet/jet/v2/cmd/jet"
+	_ "github.com/stephenafamo/bob/gen/bobgen-psql"
 )
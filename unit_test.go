@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// licenseInsert answers the insert of a license, with its id for the ORMs
+// that return it
+func licenseInsert() mimic.QueryResult {
+	return mimic.QueryResult{
+		Result: &mimic.Result{NumRows: 1},
+		Query: &mimic.Query{
+			Cols: []string{"id"},
+			Vals: [][]driver.Value{{int64(1)}},
+		},
+	}
+}
+
+// unitOp is the unit of work of a request handler: it finds a jet, updates
+// it and inserts a license in a transaction
+var unitOp = jetOperation{
+	name: "Unit",
+	run: func(a bench.Adapter, ctx context.Context) error {
+		u, ok := a.(bench.UnitAdapter)
+		if !ok {
+			return bench.ErrUnsupported
+		}
+		return u.Unit(ctx)
+	},
+	fixture: fixtures(jetQueryUpdate, jetExecUpdate, licenseInsert),
+	override: map[string]func() []mimic.QueryResult{
+		"jet": fixtures(jetQueryUpdateAliased, jetExecUpdate, licenseInsert),
+		"bob": fixtures(jetQueryUpdate, jetQueryUpdate, licenseInsert),
+	},
+	expect: func() []bench.Jet { return expectJets(jetQueryUpdate()) },
+	check:  checkUnit,
+}
+
+// jetQueryUpdateAliased is jetQueryUpdate with go-jet's projection aliases
+func jetQueryUpdateAliased() mimic.QueryResult {
+	return aliasJets(jetQueryUpdate())
+}
+
+// checkUnit checks that a unit begins a transaction, updates the jet it
+// found, inserts one license for the jet's pilot and commits
+func checkUnit(log []mimic.Statement) error {
+	if len(log) < 2 || log[0].Query != "BEGIN" || log[len(log)-1].Query != "COMMIT" {
+		return fmt.Errorf("statements aren't between BEGIN and COMMIT:\n%s", formatStatements(log))
+	}
+
+	jet := expectJets(jetQueryUpdate())[0]
+	if err := verifyWrites(log, jet, append([]string{"id"}, jetUpdatable...), nil); err != nil {
+		return err
+	}
+
+	licenses := 0
+	for _, s := range log {
+		cols, ok, err := written(s, "licenses")
+		if err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+
+		licenses++
+		if pilot, ok := cols["pilot_id"]; !ok || !sameValue(pilot, jet.PilotID) {
+			return fmt.Errorf("license isn't for pilot %d\n%s", jet.PilotID, s.Query)
+		}
+	}
+	if licenses != 1 {
+		return fmt.Errorf("%d writes to licenses, want 1", licenses)
+	}
+	return nil
+}
+
+// runUnit creates an op/orm sub-benchmark for every adapter like
+// runOperation, and reports the statements a unit issues, BEGIN and
+// COMMIT included, as stmts/op
+func runUnit(b *testing.B, op jetOperation) {
+	for _, a := range adapters() {
+		dsn := "postgres://" + op.name + "-" + a.Name()
+		mimic.NewSequenceDSN(dsn, op.script(a.Name())...)
+
+		if err := a.Open(dsn); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			ctx := context.Background()
+			checkOperation(b, op, a.Name(), a, dsn)
+
+			log, err := runLogged(dsn, func() error { return op.run(a, ctx) })
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+			loop(b, func() error { return op.run(a, ctx) })
+			b.ReportMetric(float64(len(log)), "stmts/op")
+		})
+	}
+}
+
+// TestGoldenUnitSQL is TestGoldenSQL and TestVerify for the Unit benchmark
+func TestGoldenUnitSQL(t *testing.T) {
+	for _, a := range adapters() {
+		t.Run(a.Name(), func(t *testing.T) {
+			dsn := "postgres://sql-" + unitOp.name + "-" + a.Name()
+			runChecked(t, unitOp, a, dsn, unitOp.script(a.Name()), unitOp.name)
+		})
+	}
+}
+
+func BenchmarkUnit(b *testing.B) {
+	runUnit(b, unitOp)
+}
@@ -1,152 +1,23 @@
 package main
 
 import (
-	"context"
-	"database/sql"
-	"github.com/gobuffalo/pop/v6"
-	"github.com/aarondl/boilbench/pops"
 	"testing"
 
-	"github.com/aarondl/boilbench/gorms"
-	"github.com/aarondl/boilbench/gorps"
+	"github.com/aarondl/boilbench/bench"
 	"github.com/aarondl/boilbench/mimic"
-	"github.com/aarondl/boilbench/models"
-	"github.com/aarondl/boilbench/xorms"
-	"github.com/aarondl/sqlboiler/v4/boil"
-	gorp "gopkg.in/gorp.v1"
-	"gorm.io/gorm"
-	"xorm.io/xorm"
 )
 
-func BenchmarkGORMUpdate(b *testing.B) {
-	store := gorms.Jet{
-		ID: 1,
-	}
-
-	exec := jetExecUpdate()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	gormdb, err := gorm.Open(gormMimicDialector, &gorm.Config{})
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("gorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			err := gormdb.Model(&store).Updates(store).Error
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkGORPUpdate(b *testing.B) {
-	store := gorps.Jet{
-		ID: 1,
-	}
-
-	exec := jetExecUpdate()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	gorpdb := &gorp.DbMap{Db: db, Dialect: gorp.PostgresDialect{}}
-	if err != nil {
-		panic(err)
-	}
-	gorpdb.AddTable(gorps.Jet{}).SetKeys(true, "ID")
-
-	b.Run("gorp", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_, err := gorpdb.Update(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
+var updateOp = jetOperation{
+	name:    "Update",
+	run:     bench.Adapter.Update,
+	fixture: fixtures(jetExecUpdate),
+	expect:  func() []bench.Jet { return expectJets(jetQueryUpdate()) },
+	sends:   append([]string{"id"}, jetUpdatable...),
+	override: map[string]func() []mimic.QueryResult{
+		"bob": fixtures(jetQueryUpdate),
+	},
 }
 
-func BenchmarkXORMUpdate(b *testing.B) {
-	store := xorms.Jet{
-		Id: 1,
-	}
-
-	exec := jetExecUpdate()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	xormdb, err := xorm.NewEngine("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("xorm", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_, err := xormdb.ID(store.Id).Update(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkBoilUpdate(b *testing.B) {
-	store := models.Jet{
-		ID: 1,
-	}
-
-	exec := jetExecUpdate()
-	exec.NumInput = -1
-	mimic.NewResult(exec)
-
-	db, err := sql.Open("mimic", "")
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("boil", func(b *testing.B) {
-		ctx := context.Background()
-		for i := 0; i < b.N; i++ {
-			_, err := store.Update(ctx, db, boil.Infer())
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
-}
-
-func BenchmarkPopUpdate(b *testing.B) {
-	dsn := "postgres://BenchmarkPopUpdate"
-	store := pops.Jet{
-		ID: 1,
-	}
-
-	exec := jetExecUpdate()
-	exec.NumInput = -1
-	mimic.NewResultDSN(dsn, exec)
-
-	popdb, err := pop.NewConnection(&pop.ConnectionDetails{Driver: "mimic", Dialect: "postgres", URL: dsn})
-	if err != nil {
-		panic(err)
-	}
-
-	err = popdb.Open()
-	if err != nil {
-		panic(err)
-	}
-
-	b.Run("pop", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			err := popdb.Update(&store)
-			if err != nil {
-				b.Fatal(err)
-			}
-		}
-	})
+func BenchmarkUpdate(b *testing.B) {
+	runOperation(b, updateOp)
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+	"github.com/aarondl/boilbench/models"
+	"github.com/aarondl/boilbench/models_camelcase"
+	"github.com/aarondl/boilbench/models_noautotimestamps"
+	"github.com/aarondl/boilbench/models_nocontext"
+	"github.com/aarondl/boilbench/models_nohooks"
+	"github.com/aarondl/boilbench/models_norelationgetters"
+	"github.com/aarondl/boilbench/models_norowsaffected"
+	"github.com/aarondl/boilbench/models_softdelete"
+)
+
+// variantAdapters returns the sqlboiler adapter and one for every variant
+// of its models generated by scripts/gen-variants, named boil-<variant>
+func variantAdapters() []bench.Adapter {
+	return []bench.Adapter{
+		&models.Adapter{},
+		&models_nohooks.Adapter{},
+		&models_nocontext.Adapter{},
+		&models_norowsaffected.Adapter{},
+		&models_noautotimestamps.Adapter{},
+		&models_norelationgetters.Adapter{},
+		&models_camelcase.Adapter{},
+		&models_softdelete.Adapter{},
+	}
+}
+
+// variantOperations are the operations the variants are compared on
+var variantOperations = append(append([]jetOperation(nil), operations...), upsertOp)
+
+// variantScript is the fixture of op for a variant, that of sqlboiler unless
+// op overrides it for the variant
+func variantScript(op jetOperation, name string) []mimic.QueryResult {
+	if _, ok := op.override[name]; ok {
+		return op.script(name)
+	}
+	return op.script("boil")
+}
+
+// runVariant creates an op/orm sub-benchmark for every variant, answered
+// with the fixture of sqlboiler unless op overrides it
+func runVariant(b *testing.B, op jetOperation) {
+	for _, a := range variantAdapters() {
+		dsn := "postgres://Variant" + op.name + "-" + a.Name()
+		mimic.NewSequenceDSN(dsn, variantScript(op, a.Name())...)
+
+		if err := a.Open(dsn); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(a.Name(), func(b *testing.B) {
+			ctx := context.Background()
+			checkOperation(b, op, a.Name(), a, dsn)
+
+			b.ResetTimer()
+			loop(b, func() error { return op.run(a, ctx) })
+		})
+	}
+}
+
+// TestVerifyVariants is TestVerify for the variants of the models
+func TestVerifyVariants(t *testing.T) {
+	for _, op := range variantOperations {
+		for _, a := range variantAdapters() {
+			t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+				dsn := "postgres://verify-variant-" + op.name + "-" + a.Name()
+				runChecked(t, op, a, dsn, variantScript(op, a.Name()), "")
+			})
+		}
+	}
+}
+
+func BenchmarkVariantSelectAll(b *testing.B) {
+	runVariant(b, selectAllOp)
+}
+
+func BenchmarkVariantSelectSubset(b *testing.B) {
+	runVariant(b, selectSubsetOp)
+}
+
+func BenchmarkVariantSelectComplex(b *testing.B) {
+	runVariant(b, selectComplexOp)
+}
+
+func BenchmarkVariantInsert(b *testing.B) {
+	runVariant(b, insertOp)
+}
+
+func BenchmarkVariantUpdate(b *testing.B) {
+	runVariant(b, updateOp)
+}
+
+func BenchmarkVariantDelete(b *testing.B) {
+	runVariant(b, deleteOp)
+}
+
+func BenchmarkVariantRawBind(b *testing.B) {
+	runVariant(b, rawBindOp)
+}
+
+func BenchmarkVariantEagerLoad(b *testing.B) {
+	runVariant(b, eagerLoadOp)
+}
+
+func BenchmarkVariantUpsert(b *testing.B) {
+	runVariant(b, upsertOp)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+var operations = []jetOperation{
+	selectAllOp,
+	selectSubsetOp,
+	selectComplexOp,
+	insertOp,
+	updateOp,
+	deleteOp,
+	rawBindOp,
+	eagerLoadOp,
+}
+
+// TestVerify runs every operation once against every adapter and checks
+// that they all decoded the same jets, so that no ORM is benchmarked doing
+// less work than the others.
+func TestVerify(t *testing.T) {
+	for _, op := range operations {
+		verifyOperation(t, op)
+	}
+}
+
+// verifyOperation runs op once against every adapter in a subtest each and
+// checks their results
+func verifyOperation[S subject[R], R result[R]](t *testing.T, op operation[S, R]) {
+	for _, a := range adapters() {
+		a := a
+		t.Run(op.name+"/"+a.Name(), func(t *testing.T) {
+			dsn := "postgres://verify-" + op.name + "-" + a.Name()
+			s, err := op.connect(a, dsn)
+			var log []mimic.Statement
+			if err == nil {
+				log, err = runLogged(dsn, func() error { return op.run(s, context.Background()) })
+			}
+			if errors.Is(err, bench.ErrUnsupported) {
+				t.Skip("unsupported")
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.verify(a.Name(), s, log); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
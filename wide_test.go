@@ -0,0 +1,272 @@
+package main
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+// wideQuery returns five rows of the wide table with the given number of
+// columns, with the values of bench.WideValue. pilot_id is the row number so
+// that every row has a pilot of its own in pilotQuery.
+func wideQuery(columns int) mimic.QueryResult {
+	cols := bench.WideColumns(columns)
+	vals := make([][]driver.Value, 5)
+	for i := range vals {
+		vals[i] = make([]driver.Value, len(cols))
+		for j, c := range cols {
+			vals[i][j] = bench.WideValue(c, int64(i+1))
+		}
+	}
+
+	return mimic.QueryResult{
+		Query: &mimic.Query{Cols: cols, Vals: vals},
+	}
+}
+
+// wideQueryAliased returns the wide fixture with go-jet's "table.column"
+// projection aliases
+func wideQueryAliased(columns int) mimic.QueryResult {
+	query := wideQuery(columns)
+	cols := make([]string, len(query.Cols))
+	for i, c := range query.Cols {
+		cols[i] = bench.WideTable(columns) + "." + c
+	}
+	query.Query = &mimic.Query{Cols: cols, Vals: query.Vals}
+	return query
+}
+
+// wideQueryUpdate is the row the adapters write, bob reads it back
+func wideQueryUpdate(columns int) mimic.QueryResult {
+	query := wideQuery(columns)
+	query.Vals = query.Vals[:1]
+	return query
+}
+
+// wideExecReturning answers sqlboiler's insert, which returns the nullable
+// columns it left unset
+func wideExecReturning(columns int) mimic.QueryResult {
+	var cols []string
+	var vals []driver.Value
+	for _, c := range bench.WideColumns(columns) {
+		if strings.HasPrefix(c, "null_") {
+			cols = append(cols, c)
+			vals = append(vals, nil)
+		}
+	}
+
+	return mimic.QueryResult{
+		Result: &mimic.Result{
+			NumRows: 1,
+		},
+		Query: &mimic.Query{
+			Cols: cols,
+			Vals: [][]driver.Value{vals},
+		},
+	}
+}
+
+func wideQueryInsert(int) mimic.QueryResult { return jetQueryInsert() }
+
+func wideExec(int) mimic.QueryResult { return jetExecUpdate() }
+
+func widePilotQuery(int) mimic.QueryResult { return pilotQuery() }
+
+// expectRows converts the rows of a wide fixture to the rows that adapters
+// are expected to decode them into
+func expectRows(query mimic.QueryResult) []bench.Row {
+	rows := make([]bench.Row, len(query.Vals))
+	for i, vals := range query.Vals {
+		rows[i].Values = make([]interface{}, len(vals))
+		for j, v := range vals {
+			rows[i].Values[j] = v
+		}
+	}
+	return rows
+}
+
+// expectRowPilots attaches the pilots of a pilots fixture to rows by pilot_id
+func expectRowPilots(rows []bench.Row, query mimic.QueryResult) []bench.Row {
+	for _, vals := range query.Vals {
+		pilot := bench.Pilot{ID: vals[0].(int64), Name: vals[1].(string)}
+		for i := range rows {
+			if rows[i].Values[1] == pilot.ID {
+				p := pilot
+				rows[i].Pilot = &p
+			}
+		}
+	}
+	return rows
+}
+
+// wideOperation is an operation on the wide table of an adapter
+type wideOperation = operation[bench.Wide, bench.Row]
+
+// wideFixtures wraps mimic results of a width into a fixture function
+func wideFixtures(columns int, results ...func(int) mimic.QueryResult) func() []mimic.QueryResult {
+	return func() []mimic.QueryResult {
+		script := make([]mimic.QueryResult, len(results))
+		for i, r := range results {
+			script[i] = r(columns)
+		}
+		return script
+	}
+}
+
+// wideOperations returns the operations on the wide table of a width, named
+// like WideSelectAll/20. Adapters without the wide operations return
+// bench.ErrUnsupported.
+func wideOperations(columns int) []wideOperation {
+	open := func(a bench.Adapter) (bench.Wide, error) {
+		wa, ok := a.(bench.WideAdapter)
+		if !ok {
+			return nil, bench.ErrUnsupported
+		}
+		return wa.Wide(columns), nil
+	}
+	name := func(name string) string { return name + "/" + strconv.Itoa(columns) }
+	aliased := map[string]func() []mimic.QueryResult{"jet": wideFixtures(columns, wideQueryAliased)}
+	rows := func() []bench.Row { return expectRows(wideQuery(columns)) }
+	written := func() []bench.Row { return expectRows(wideQueryUpdate(columns)) }
+
+	return []wideOperation{
+		{
+			name:     name("WideSelectAll"),
+			run:      bench.Wide.SelectAll,
+			open:     open,
+			fixture:  wideFixtures(columns, wideQuery),
+			override: aliased,
+			expect:   rows,
+		},
+		{
+			name:     name("WideSelectSubset"),
+			run:      bench.Wide.SelectSubset,
+			open:     open,
+			fixture:  wideFixtures(columns, wideQuery),
+			override: aliased,
+			expect:   rows,
+			columns:  bench.WideSubset(columns),
+		},
+		{
+			name:     name("WideSelectComplex"),
+			run:      bench.Wide.SelectComplex,
+			open:     open,
+			fixture:  wideFixtures(columns, wideQuery),
+			override: aliased,
+			expect:   rows,
+			columns:  bench.WideSubset(columns),
+		},
+		{
+			name:    name("WideInsert"),
+			run:     bench.Wide.Insert,
+			open:    open,
+			fixture: wideFixtures(columns, wideExec),
+			expect:  written,
+			override: map[string]func() []mimic.QueryResult{
+				"boil": wideFixtures(columns, wideExecReturning),
+				"gorm": wideFixtures(columns, wideQueryInsert),
+				"gorp": wideFixtures(columns, wideQueryInsert),
+				"pop":  wideFixtures(columns, wideQueryInsert),
+				"xorm": wideFixtures(columns, wideQueryInsert),
+				"bob":  wideFixtures(columns, wideQueryUpdate),
+			},
+		},
+		{
+			name:     name("WideUpdate"),
+			run:      bench.Wide.Update,
+			open:     open,
+			fixture:  wideFixtures(columns, wideExec),
+			override: map[string]func() []mimic.QueryResult{"bob": wideFixtures(columns, wideQueryUpdate)},
+			expect:   written,
+		},
+		{
+			name:    name("WideDelete"),
+			run:     bench.Wide.Delete,
+			open:    open,
+			fixture: wideFixtures(columns, wideExec),
+			expect:  written,
+		},
+		{
+			name:     name("WideRawBind"),
+			run:      bench.Wide.RawBind,
+			open:     open,
+			fixture:  wideFixtures(columns, wideQuery),
+			override: aliased,
+			expect:   rows,
+		},
+		{
+			name:    name("WideEagerLoad"),
+			run:     bench.Wide.EagerLoad,
+			open:    open,
+			fixture: wideFixtures(columns, wideQuery, widePilotQuery),
+			expect: func() []bench.Row {
+				return expectRowPilots(expectRows(wideQuery(columns)), pilotQuery())
+			},
+		},
+	}
+}
+
+// runWide creates an op/width/orm sub-benchmark for every width and
+// adapter, so that boilbench can report each ORM as a function of the
+// number of columns
+func runWide(b *testing.B, name string) {
+	for _, columns := range bench.WideWidths {
+		b.Run(strconv.Itoa(columns), func(b *testing.B) {
+			runOperation(b, wideOp(name, columns))
+		})
+	}
+}
+
+func wideOp(name string, columns int) wideOperation {
+	for _, op := range wideOperations(columns) {
+		if op.name == name+"/"+strconv.Itoa(columns) {
+			return op
+		}
+	}
+	panic("no wide operation " + name)
+}
+
+// TestVerifyWide is TestVerify for the wide tables
+func TestVerifyWide(t *testing.T) {
+	for _, columns := range bench.WideWidths {
+		for _, op := range wideOperations(columns) {
+			verifyOperation(t, op)
+		}
+	}
+}
+
+func BenchmarkWideSelectAll(b *testing.B) {
+	runWide(b, "WideSelectAll")
+}
+
+func BenchmarkWideSelectSubset(b *testing.B) {
+	runWide(b, "WideSelectSubset")
+}
+
+func BenchmarkWideSelectComplex(b *testing.B) {
+	runWide(b, "WideSelectComplex")
+}
+
+func BenchmarkWideInsert(b *testing.B) {
+	runWide(b, "WideInsert")
+}
+
+func BenchmarkWideUpdate(b *testing.B) {
+	runWide(b, "WideUpdate")
+}
+
+func BenchmarkWideDelete(b *testing.B) {
+	runWide(b, "WideDelete")
+}
+
+func BenchmarkWideRawBind(b *testing.B) {
+	runWide(b, "WideRawBind")
+}
+
+func BenchmarkWideEagerLoad(b *testing.B) {
+	runWide(b, "WideEagerLoad")
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/boilbench/mimic"
+)
+
+var (
+	insertRE = regexp.MustCompile(`(?i)^insert into ([^\s(]+)(?: as \S+)? ?\(([^)]*)\) values ?\(([^)]*)\)`)
+	updateRE = regexp.MustCompile(`(?i)^update (\S+)(?: as \S+)? set (.*?) where (.*?)(?: returning .*)?;?$`)
+	deleteRE = regexp.MustCompile(`(?i)^delete from (\S+)(?: as \S+)? where (.*?)(?: returning .*)?;?$`)
+	andRE    = regexp.MustCompile(`(?i) and `)
+)
+
+// written parses the columns a write to table sends and their values, those
+// it filters on included. Values are the args of placeholders or the
+// literals ORMs inline, columns set to DEFAULT are left out. ok is false
+// when s isn't a write to table.
+func written(s mimic.Statement, table string) (cols map[string]driver.Value, ok bool, err error) {
+	query := strings.Join(strings.Fields(s.Query), " ")
+
+	var names, values []string
+	var pairs string
+	var where string
+	if m := insertRE.FindStringSubmatch(query); m != nil {
+		if unquote(m[1]) != table {
+			return nil, false, nil
+		}
+		names, values = splitList(m[2]), splitList(m[3])
+	} else if m := updateRE.FindStringSubmatch(query); m != nil {
+		if unquote(m[1]) != table {
+			return nil, false, nil
+		}
+		if set := m[2]; strings.HasPrefix(set, "(") {
+			// go-jet sets every column at once: (a, b) = ($1, $2)
+			lists := strings.SplitN(set, "=", 2)
+			names = splitList(strings.Trim(strings.TrimSpace(lists[0]), "()"))
+			values = splitList(strings.Trim(strings.TrimSpace(lists[1]), "()"))
+		} else {
+			pairs = m[2]
+		}
+		where = m[3]
+	} else if m := deleteRE.FindStringSubmatch(query); m != nil {
+		if unquote(m[1]) != table {
+			return nil, false, nil
+		}
+		where = m[2]
+	} else {
+		return nil, false, nil
+	}
+
+	for _, pair := range splitList(pairs) {
+		name, value, _ := strings.Cut(pair, "=")
+		names, values = append(names, name), append(values, value)
+	}
+	for _, cond := range andRE.Split(strings.Trim(where, "()"), -1) {
+		if name, value, found := strings.Cut(strings.Trim(cond, "()"), "="); found {
+			names, values = append(names, name), append(values, value)
+		}
+	}
+	if len(names) != len(values) {
+		return nil, true, fmt.Errorf("%d columns but %d values in: %s", len(names), len(values), query)
+	}
+
+	cols = make(map[string]driver.Value, len(names))
+	next := 0
+	for i, name := range names {
+		value := strings.TrimSpace(values[i])
+		switch {
+		case strings.EqualFold(value, "default"):
+			continue
+		case value == "?":
+			if next >= len(s.Args) {
+				return nil, true, fmt.Errorf("placeholder %d has no arg in: %s", next+1, query)
+			}
+			cols[unquote(name)] = s.Args[next]
+			next++
+		case strings.HasPrefix(value, "$"):
+			n, err := strconv.Atoi(value[1:])
+			if err != nil || n < 1 || n > len(s.Args) {
+				return nil, true, fmt.Errorf("placeholder %s has no arg in: %s", value, query)
+			}
+			cols[unquote(name)] = s.Args[n-1]
+		default:
+			cols[unquote(name)] = literal(value)
+		}
+	}
+	return cols, true, nil
+}
+
+// splitList splits a comma separated list, the values of the fixtures
+// have no commas
+func splitList(list string) []string {
+	if len(strings.TrimSpace(list)) == 0 {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+// unquote strips the quotes and the table or schema from an identifier
+func unquote(ident string) string {
+	ident = strings.TrimSpace(ident)
+	if i := strings.LastIndexByte(ident, '.'); i >= 0 {
+		ident = ident[i+1:]
+	}
+	return strings.Trim(ident, `"`)
+}
+
+// literal is the value of a literal inlined in a statement
+func literal(value string) driver.Value {
+	switch {
+	case strings.EqualFold(value, "null"):
+		return nil
+	case strings.HasPrefix(value, "'"):
+		return strings.ReplaceAll(strings.Trim(value, "'"), "''", "'")
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n
+	}
+	return value
+}
+
+// jetColumns are the values of the columns of j
+func jetColumns(j bench.Jet) map[string]driver.Value {
+	var color driver.Value
+	if j.Color.Valid {
+		color = j.Color.String
+	}
+
+	return map[string]driver.Value{
+		"id": j.ID, "pilot_id": j.PilotID, "airport_id": j.AirportID, "name": j.Name, "color": color,
+		"uuid": j.UUID, "identifier": j.Identifier, "cargo": j.Cargo, "manifest": j.Manifest,
+	}
+}
+
+// sameValue compares a value sent with the one expected, inlined literals
+// are text whatever the type of the column
+func sameValue(got, want driver.Value) bool {
+	text := func(v driver.Value) string {
+		switch v := v.(type) {
+		case nil:
+			return "NULL"
+		case []byte:
+			return string(v)
+		}
+		return fmt.Sprint(v)
+	}
+	return text(got) == text(want)
+}
+
+// verifyWrites checks that the log has a write to jets and that every one
+// sends the columns of sends with the values of want and none of omits
+func verifyWrites(log []mimic.Statement, want bench.Jet, sends, omits []string) error {
+	values := jetColumns(want)
+
+	found := false
+	for _, s := range log {
+		cols, ok, err := written(s, "jets")
+		if err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		found = true
+
+		var diffs []string
+		for _, c := range sends {
+			if _, ok := cols[c]; !ok {
+				diffs = append(diffs, c+" isn't sent")
+			}
+		}
+		for _, c := range omits {
+			if _, ok := cols[c]; ok {
+				diffs = append(diffs, c+" is sent")
+			}
+		}
+		for c, got := range cols {
+			if want, ok := values[c]; ok && !sameValue(got, want) {
+				diffs = append(diffs, fmt.Sprintf("%s: %v != %v", c, want, got))
+			}
+		}
+		if len(diffs) != 0 {
+			sort.Strings(diffs)
+			return fmt.Errorf("%s\n%s", strings.Join(diffs, ", "), s.Query)
+		}
+	}
+	if !found {
+		return fmt.Errorf("no write to jets in %d statements", len(log))
+	}
+	return nil
+}
+
+var (
+	placeholderRE = regexp.MustCompile(`\$\d+|\?`)
+	insertRowsRE  = regexp.MustCompile(`(?i)^insert into (\S+)(?: as \S+)? ?\(([^)]*)\) values ?(.*?)(?: on conflict .*| returning .*)?;?$`)
+	tupleRE       = regexp.MustCompile(`\(([^()]*)\)`)
+	conditionRE   = regexp.MustCompile(`(?i)^\(*\s*([^\s=<>!()]+)\s*(=|<>|!=|not in|in)\s*\(?([^()]*?)\)?\s*\)*$`)
+)
+
+// bind inlines the args of the placeholders of s, integers as they are and
+// every other value as a string the relationship writes don't look at
+func bind(s mimic.Statement) (string, error) {
+	var err error
+	next := 0
+	query := placeholderRE.ReplaceAllStringFunc(strings.Join(strings.Fields(s.Query), " "), func(p string) string {
+		i := next
+		if p == "?" {
+			next++
+		} else if n, convErr := strconv.Atoi(p[1:]); convErr == nil {
+			i = n - 1
+		}
+		if i < 0 || i >= len(s.Args) {
+			err = fmt.Errorf("placeholder %s has no arg in: %s", p, s.Query)
+			return p
+		}
+
+		switch v := s.Args[i].(type) {
+		case nil:
+			return "NULL"
+		case int64:
+			return strconv.FormatInt(v, 10)
+		}
+		return "'arg'"
+	})
+	return query, err
+}
+
+// condition is a condition of a where clause on a column, it holds for the
+// values of in, or those not in it when not is set
+type condition struct {
+	column string
+	in     []int64
+	not    bool
+}
+
+// conditions parses the conditions of a where clause, joined by and. <> and
+// != are a not in of one value, which is how gorm writes those.
+func conditions(where string) ([]condition, error) {
+	var conds []condition
+	for _, cond := range andRE.Split(where, -1) {
+		m := conditionRE.FindStringSubmatch(strings.TrimSpace(cond))
+		if m == nil {
+			return nil, fmt.Errorf("unknown condition: %s", cond)
+		}
+
+		op := strings.ToLower(m[2])
+		c := condition{column: unquote(m[1]), not: op == "not in" || op == "<>" || op == "!="}
+		for _, v := range splitList(m[3]) {
+			n, ok := literal(strings.TrimSpace(v)).(int64)
+			if !ok {
+				return nil, fmt.Errorf("condition on a value that isn't an id: %s", cond)
+			}
+			c.in = append(c.in, n)
+		}
+		conds = append(conds, c)
+	}
+	return conds, nil
+}
+
+// relatedRows replays the writes to table in log and returns the rows, by
+// their key column, related to the pilot with id 1 after them. related are
+// the rows related to it before, rows are identified by key and belong to
+// the pilot when their pilot_id is 1.
+func relatedRows(log []mimic.Statement, table, key string, related map[int64]bool) (map[int64]bool, error) {
+	for _, s := range log {
+		query, err := bind(s)
+		if err != nil {
+			return nil, err
+		}
+
+		// matches are the rows of ids the conditions hold for
+		matches := func(conds []condition, ids map[int64]bool) []int64 {
+			var rows []int64
+			for id := range ids {
+				holds := true
+				for _, c := range conds {
+					switch c.column {
+					case key:
+						holds = holds && slices.Contains(c.in, id) != c.not
+					case "pilot_id":
+						holds = holds && related[id] && slices.Contains(c.in, 1) != c.not
+					}
+				}
+				if holds {
+					rows = append(rows, id)
+				}
+			}
+			return rows
+		}
+
+		if m := insertRowsRE.FindStringSubmatch(query); m != nil {
+			if unquote(m[1]) != table {
+				continue
+			}
+			cols := splitList(m[2])
+			for _, tuple := range tupleRE.FindAllStringSubmatch(m[3], -1) {
+				vals := splitList(tuple[1])
+				if len(vals) != len(cols) {
+					return nil, fmt.Errorf("%d columns but %d values in: %s", len(cols), len(vals), query)
+				}
+				row := map[string]driver.Value{}
+				for i, c := range cols {
+					row[unquote(c)] = literal(strings.TrimSpace(vals[i]))
+				}
+				if id, ok := row[key].(int64); ok && row["pilot_id"] == int64(1) {
+					related[id] = true
+				}
+			}
+		} else if m := updateRE.FindStringSubmatch(query); m != nil {
+			if unquote(m[1]) != table {
+				continue
+			}
+			column, value, _ := strings.Cut(m[2], "=")
+			if unquote(strings.Trim(column, "() ")) != "pilot_id" || strings.Contains(m[2], ",") {
+				return nil, fmt.Errorf("update of more than pilot_id: %s", query)
+			}
+			conds, err := conditions(m[3])
+			if err != nil {
+				return nil, err
+			}
+
+			// every row of conditions on the key, those related to the
+			// pilot otherwise
+			ids := maps.Clone(related)
+			for _, c := range conds {
+				for _, id := range c.in {
+					if c.column == key && !c.not {
+						ids[id] = true
+					}
+				}
+			}
+			attach := literal(strings.Trim(strings.TrimSpace(value), "()")) == int64(1)
+			for _, id := range matches(conds, ids) {
+				if attach {
+					related[id] = true
+				} else {
+					delete(related, id)
+				}
+			}
+		} else if m := deleteRE.FindStringSubmatch(query); m != nil {
+			if unquote(m[1]) != table {
+				continue
+			}
+			conds, err := conditions(m[2])
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range matches(conds, related) {
+				delete(related, id)
+			}
+		}
+	}
+	return related, nil
+}
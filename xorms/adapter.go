@@ -0,0 +1,141 @@
+package xorms
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"github.com/aarondl/null/v8"
+	"xorm.io/xorm"
+)
+
+// Adapter implements bench.Adapter for xorm
+type Adapter struct {
+	db  *xorm.Engine
+	jet Jet
+	rel relations
+
+	// jets are the results of the last select
+	jets []Jet
+}
+
+// Name of the ORM
+func (a *Adapter) Name() string { return "xorm" }
+
+// Clone the adapter for another goroutine, sharing its connection
+func (a *Adapter) Clone() bench.Adapter {
+	return &Adapter{db: a.db, jet: a.jet}
+}
+
+// Reset nothing, xorm caches the tables it parses on the engine
+func (a *Adapter) Reset() {}
+
+// Close the connection
+func (a *Adapter) Close() error {
+	return a.db.Close()
+}
+
+// Open an xorm engine on the mimic driver
+func (a *Adapter) Open(dsn string) error {
+	db, err := xorm.NewEngine("mimic", dsn)
+	if err != nil {
+		return err
+	}
+
+	a.db = db
+	a.jet = Jet{
+		Id:         1,
+		PilotId:    2,
+		AirportId:  11,
+		Name:       "name-1",
+		Color:      null.StringFrom("color-1"),
+		Uuid:       "uuid-1",
+		Identifier: "identifier-1",
+		Cargo:      []byte("cargo-1"),
+		Manifest:   []byte("manifest-1"),
+	}
+	return nil
+}
+
+// SelectAll jets
+func (a *Adapter) SelectAll(context.Context) error {
+	var store []Jet
+	err := a.db.Find(&store)
+	a.jets = store
+	return err
+}
+
+// SelectSubset of jet columns
+func (a *Adapter) SelectSubset(context.Context) error {
+	var store []Jet
+	err := a.db.Select("id, name, color, uuid, identifier, cargo, manifest").Find(&store)
+	a.jets = store
+	return err
+}
+
+// SelectComplex query on jets
+func (a *Adapter) SelectComplex(context.Context) error {
+	var store []Jet
+	err := a.db.
+		Select("id, name, color, uuid, identifier, cargo, manifest").
+		Where("id > ?", 1).
+		Where("name <> ?", "thing").
+		Limit(1, 1).
+		GroupBy("id").
+		Find(&store)
+	a.jets = store
+	return err
+}
+
+// Insert a jet
+func (a *Adapter) Insert(context.Context) error {
+	_, err := a.db.Insert(&a.jet)
+	return err
+}
+
+// Update a jet
+func (a *Adapter) Update(context.Context) error {
+	_, err := a.db.ID(a.jet.Id).Update(&a.jet)
+	return err
+}
+
+// Delete a jet
+func (a *Adapter) Delete(context.Context) error {
+	_, err := a.db.Delete(&a.jet)
+	return err
+}
+
+// RawBind a raw query into jets
+func (a *Adapter) RawBind(context.Context) error {
+	var store []Jet
+	err := a.db.SQL("select * from jets").Find(&store)
+	a.jets = store
+	return err
+}
+
+// EagerLoad is not supported, xorm has no relationship loading
+func (a *Adapter) EagerLoad(context.Context) error {
+	return bench.ErrUnsupported
+}
+
+// Results of the last select
+func (a *Adapter) Results() []bench.Jet {
+	results := make([]bench.Jet, len(a.jets))
+	for i, j := range a.jets {
+		results[i] = canonical(j)
+	}
+	return results
+}
+
+func canonical(j Jet) bench.Jet {
+	return bench.Jet{
+		ID:         int64(j.Id),
+		PilotID:    int64(j.PilotId),
+		AirportID:  int64(j.AirportId),
+		Name:       j.Name,
+		Color:      j.Color,
+		UUID:       j.Uuid,
+		Identifier: j.Identifier,
+		Cargo:      j.Cargo,
+		Manifest:   j.Manifest,
+	}
+}
@@ -0,0 +1,36 @@
+package xorms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/boilbench/bench"
+)
+
+// Bind the rows of a query into jets with ScanStructByName, xorm's own
+// rows only scan the tables it queried. ScanStructByName panics on columns
+// the model doesn't have, those results are unsupported.
+func (a *Adapter) Bind(ctx context.Context) (err error) {
+	rows, err := a.db.DB().QueryContext(ctx, "select * from jets")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", bench.ErrUnsupported, r)
+		}
+	}()
+
+	var store []Jet
+	for rows.Next() {
+		var j Jet
+		if err := rows.ScanStructByName(&j); err != nil {
+			return err
+		}
+		store = append(store, j)
+	}
+	a.jets = store
+	return rows.Err()
+}
@@ -0,0 +1,51 @@
+package xorms
+
+import (
+	"context"
+
+	"xorm.io/builder"
+)
+
+// BuildSelectComplex builds the query of SelectComplex with xorm's query
+// builder. xorm builds the statements of its engine methods as it runs
+// them, which can't be timed apart.
+func (a *Adapter) BuildSelectComplex(context.Context) (string, []interface{}, error) {
+	return builder.Dialect(builder.POSTGRES).
+		Select("id", "name", "color", "uuid", "identifier", "cargo", "manifest").
+		From("jets").
+		Where(builder.Gt{"id": 1}.And(builder.Neq{"name": "thing"})).
+		GroupBy("id").
+		Limit(1, 1).
+		ToSQL()
+}
+
+// BuildInsert builds the statement of Insert with xorm's query builder
+func (a *Adapter) BuildInsert(context.Context) (string, []interface{}, error) {
+	return builder.Dialect(builder.POSTGRES).
+		Insert(a.columns()).
+		Into("jets").
+		ToSQL()
+}
+
+// BuildUpdate builds the statement of Update with xorm's query builder
+func (a *Adapter) BuildUpdate(context.Context) (string, []interface{}, error) {
+	return builder.Dialect(builder.POSTGRES).
+		Update(a.columns()).
+		From("jets").
+		Where(builder.Eq{"id": a.jet.Id}).
+		ToSQL()
+}
+
+// columns are the values of every column of the jet but its key
+func (a *Adapter) columns() builder.Eq {
+	return builder.Eq{
+		"pilot_id":   a.jet.PilotId,
+		"airport_id": a.jet.AirportId,
+		"name":       a.jet.Name,
+		"color":      a.jet.Color,
+		"uuid":       a.jet.Uuid,
+		"identifier": a.jet.Identifier,
+		"cargo":      a.jet.Cargo,
+		"manifest":   a.jet.Manifest,
+	}
+}
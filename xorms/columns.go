@@ -0,0 +1,40 @@
+package xorms
+
+import (
+	"context"
+
+	"github.com/aarondl/boilbench/bench"
+	"xorm.io/xorm"
+)
+
+// InsertColumns inserts a jet, writing the columns of cols
+func (a *Adapter) InsertColumns(_ context.Context, cols bench.Columns) error {
+	s := a.db.NewSession()
+	defer s.Close()
+
+	_, err := columns(s, cols).Insert(&a.jet)
+	return err
+}
+
+// UpdateColumns updates a jet, writing the columns of cols
+func (a *Adapter) UpdateColumns(_ context.Context, cols bench.Columns) error {
+	s := a.db.NewSession()
+	defer s.Close()
+
+	_, err := columns(s.ID(a.jet.Id), cols).Update(&a.jet)
+	return err
+}
+
+// columns picks the columns of cols on s. A greylist is MustCols, which
+// writes the columns xorm would otherwise skip for being zero.
+func columns(s *xorm.Session, cols bench.Columns) *xorm.Session {
+	switch cols.Kind {
+	case bench.Whitelist:
+		return s.Cols(cols.Cols...)
+	case bench.Blacklist:
+		return s.Omit(cols.Cols...)
+	case bench.Greylist:
+		return s.MustCols(cols.Cols...)
+	}
+	return s
+}
@@ -0,0 +1,68 @@
+package xorms
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+)
+
+// relations are the pilot and the rows the relationship writes work on.
+// xorm has no relationships, the writes are to the join table and the
+// foreign keys themselves.
+type relations struct {
+	pilot     int
+	languages []PilotLanguage
+	ids       []int
+}
+
+// Relate sets up a pilot and the rows of n languages, jets and licenses
+func (a *Adapter) Relate(n int) {
+	r := relations{pilot: 1}
+	for i := 1; i <= n; i++ {
+		r.languages = append(r.languages, PilotLanguage{PilotId: r.pilot, LanguageId: i})
+		r.ids = append(r.ids, i)
+	}
+	a.rel = r
+}
+
+// AttachLanguages inserts the rows joining the pilot to the languages
+func (a *Adapter) AttachLanguages(context.Context) error {
+	_, err := a.db.Insert(&a.rel.languages)
+	return err
+}
+
+// ReplaceLanguages deletes every row joining the pilot to a language and
+// inserts those joining it to the languages
+func (a *Adapter) ReplaceLanguages(context.Context) error {
+	r := &a.rel
+	if _, err := a.db.Where("pilot_id = ?", r.pilot).Delete(&PilotLanguage{}); err != nil {
+		return err
+	}
+	_, err := a.db.Insert(&r.languages)
+	return err
+}
+
+// DetachLanguages deletes the rows joining the pilot to the languages
+func (a *Adapter) DetachLanguages(context.Context) error {
+	r := &a.rel
+	_, err := a.db.Where("pilot_id = ?", r.pilot).In("language_id", r.ids).Delete(&PilotLanguage{})
+	return err
+}
+
+// AttachJets sets the pilot of the jets
+func (a *Adapter) AttachJets(context.Context) error {
+	r := &a.rel
+	_, err := a.db.In("id", r.ids).Cols("pilot_id").Update(&Jet{PilotId: r.pilot})
+	return err
+}
+
+// ReplaceLicenses clears the pilot of its licenses and sets it as the
+// pilot of the licenses
+func (a *Adapter) ReplaceLicenses(context.Context) error {
+	r := &a.rel
+	if _, err := a.db.Where("pilot_id = ?", r.pilot).Cols("pilot_id").Update(&License{}); err != nil {
+		return err
+	}
+	_, err := a.db.In("id", r.ids).Cols("pilot_id").Update(&License{PilotId: null.IntFrom(r.pilot)})
+	return err
+}
@@ -0,0 +1,35 @@
+package xorms
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Unit finds a jet, updates it and gives its pilot a license in a
+// transaction
+func (a *Adapter) Unit(context.Context) error {
+	s := a.db.NewSession()
+	defer s.Close()
+
+	if err := s.Begin(); err != nil {
+		return err
+	}
+
+	var jet Jet
+	if ok, err := s.ID(1).Get(&jet); err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrNoRows
+	}
+	if _, err := s.ID(jet.Id).Update(&jet); err != nil {
+		return err
+	}
+	if _, err := s.Insert(&License{PilotId: null.IntFrom(jet.PilotId)}); err != nil {
+		return err
+	}
+
+	a.jets = []Jet{jet}
+	return s.Commit()
+}